@@ -26,6 +26,7 @@ const (
 	RoleTreeItem    Role = "treeitem"
 	RoleMenu        Role = "menu"
 	RoleMenuItem    Role = "menuitem"
+	RoleOption      Role = "option"
 	RoleTab         Role = "tab"
 	RoleTabPanel    Role = "tabpanel"
 	RoleDialog      Role = "dialog"
@@ -118,6 +119,12 @@ type FocusStyle struct {
 	HighContrast backend.Style
 }
 
+// HighContrastAdapter is implemented by widgets that restyle themselves when
+// high-contrast mode changes.
+type HighContrastAdapter interface {
+	ApplyHighContrast(enabled bool)
+}
+
 // Base is a helper implementation of Accessible.
 type Base struct {
 	Role        Role
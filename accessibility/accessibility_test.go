@@ -44,3 +44,25 @@ func containsAll(message string, parts []string) bool {
 	}
 	return true
 }
+
+func TestLiveRegion(t *testing.T) {
+	region := NewLiveRegion(Assertive)
+	region.Announce("dropped")
+
+	a := &SimpleAnnouncer{}
+	region.SetAnnouncer(a)
+	region.Announce("saved")
+	region.SetPoliteness(Polite)
+	region.Announce("50%")
+
+	history := a.History()
+	if len(history) != 2 {
+		t.Fatalf("expected 2 announcements, got %+v", history)
+	}
+	if history[0] != (Announcement{Message: "saved", Priority: PriorityAssertive}) {
+		t.Fatalf("unexpected first announcement %+v", history[0])
+	}
+	if history[1] != (Announcement{Message: "50%", Priority: PriorityPolite}) {
+		t.Fatalf("unexpected second announcement %+v", history[1])
+	}
+}
@@ -0,0 +1,81 @@
+package accessibility
+
+import "sync"
+
+// Politeness controls how a LiveRegion's announcements interrupt speech.
+type Politeness int
+
+const (
+	// Polite announcements wait for current speech to finish.
+	Polite Politeness = iota
+	// Assertive announcements interrupt current speech.
+	Assertive
+)
+
+// Priority returns the announcement priority for p.
+func (p Politeness) Priority() Priority {
+	if p == Assertive {
+		return PriorityAssertive
+	}
+	return PriorityPolite
+}
+
+// LiveRegion announces status changes, such as progress or notifications,
+// without moving focus. It is silent until an Announcer is attached, which
+// an app does when the region is registered with its services.
+type LiveRegion struct {
+	mu         sync.Mutex
+	politeness Politeness
+	announcer  Announcer
+}
+
+// NewLiveRegion creates a live region with the given politeness.
+func NewLiveRegion(politeness Politeness) *LiveRegion {
+	return &LiveRegion{politeness: politeness}
+}
+
+// Politeness returns the region's politeness.
+func (r *LiveRegion) Politeness() Politeness {
+	if r == nil {
+		return Polite
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.politeness
+}
+
+// SetPoliteness changes the region's politeness.
+func (r *LiveRegion) SetPoliteness(politeness Politeness) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.politeness = politeness
+	r.mu.Unlock()
+}
+
+// SetAnnouncer attaches the announcer messages are sent to; nil detaches it.
+func (r *LiveRegion) SetAnnouncer(announcer Announcer) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.announcer = announcer
+	r.mu.Unlock()
+}
+
+// Announce sends message to the attached announcer at the region's
+// politeness. It does nothing when no announcer is attached.
+func (r *LiveRegion) Announce(message string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	announcer := r.announcer
+	priority := r.politeness.Priority()
+	r.mu.Unlock()
+	if announcer == nil {
+		return
+	}
+	announcer.Announce(message, priority)
+}
@@ -0,0 +1,142 @@
+package accessibility
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// ErrNotAvailable is returned when no text-to-speech program is installed.
+var ErrNotAvailable = errors.New("accessibility: native speech not available")
+
+// nativeQueueSize bounds pending announcements; older polite messages are
+// dropped once it fills.
+const nativeQueueSize = 16
+
+// speakFunc speaks one message and returns when speech has finished.
+type speakFunc func(text string) error
+
+// NativeAnnouncer speaks announcements through the operating system's
+// text-to-speech program: say on macOS, spd-say on Linux, and SAPI through
+// PowerShell on Windows. Announcements are queued and spoken one at a time
+// by a single goroutine, so Announce never blocks.
+type NativeAnnouncer struct {
+	mu     sync.Mutex
+	queue  chan string
+	done   chan struct{}
+	closed bool
+	speak  speakFunc
+}
+
+// NewNativeAnnouncer creates an announcer backed by the platform speech
+// program. It returns ErrNotAvailable when that program cannot be found.
+func NewNativeAnnouncer() (Announcer, error) {
+	speak, err := nativeSpeaker()
+	if err != nil {
+		return nil, err
+	}
+	return newNativeAnnouncer(speak), nil
+}
+
+func newNativeAnnouncer(speak speakFunc) *NativeAnnouncer {
+	a := &NativeAnnouncer{
+		queue: make(chan string, nativeQueueSize),
+		done:  make(chan struct{}),
+		speak: speak,
+	}
+	go a.loop()
+	return a
+}
+
+// Announce queues message for speech. Assertive messages discard any
+// pending polite messages so they are spoken next.
+func (a *NativeAnnouncer) Announce(message string, priority Priority) {
+	if a == nil {
+		return
+	}
+	msg := strings.TrimSpace(message)
+	if msg == "" {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.closed {
+		return
+	}
+	if priority == PriorityAssertive {
+		a.drain()
+	}
+	for {
+		select {
+		case a.queue <- msg:
+			return
+		default:
+		}
+		// Full: drop the oldest pending message.
+		select {
+		case <-a.queue:
+		default:
+		}
+	}
+}
+
+// AnnounceChange speaks the widget state.
+func (a *NativeAnnouncer) AnnounceChange(widget Accessible) {
+	message := FormatChange(widget)
+	if message == "" {
+		return
+	}
+	a.Announce(message, PriorityPolite)
+}
+
+// Close discards pending announcements and stops the speech goroutine
+// after the current message finishes.
+func (a *NativeAnnouncer) Close() error {
+	if a == nil {
+		return nil
+	}
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+		return nil
+	}
+	a.closed = true
+	a.drain()
+	close(a.queue)
+	a.mu.Unlock()
+	<-a.done
+	return nil
+}
+
+// drain removes pending messages. Callers hold a.mu.
+func (a *NativeAnnouncer) drain() {
+	for {
+		select {
+		case <-a.queue:
+		default:
+			return
+		}
+	}
+}
+
+func (a *NativeAnnouncer) loop() {
+	defer close(a.done)
+	for msg := range a.queue {
+		_ = a.speak(msg)
+	}
+}
+
+// commandSpeaker runs name with args, writing the text to stdin so it is
+// never parsed as a flag or shell syntax.
+func commandSpeaker(name string, args ...string) (speakFunc, error) {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return nil, ErrNotAvailable
+	}
+	return func(text string) error {
+		cmd := exec.Command(path, args...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}, nil
+}
@@ -0,0 +1,9 @@
+//go:build darwin
+
+package accessibility
+
+// nativeSpeaker uses say, which reads the text from stdin when no text
+// arguments are given.
+func nativeSpeaker() (speakFunc, error) {
+	return commandSpeaker("say", "-v", "Alex")
+}
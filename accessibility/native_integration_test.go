@@ -0,0 +1,81 @@
+//go:build integration
+
+package accessibility
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNativeAnnouncer_DoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	spoken := make(chan string, nativeQueueSize+4)
+	a := newNativeAnnouncer(func(text string) error {
+		spoken <- text
+		<-release
+		return nil
+	})
+
+	start := time.Now()
+	for i := 0; i < nativeQueueSize*2; i++ {
+		a.Announce("hello", PriorityPolite)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("Announce blocked for %v", elapsed)
+	}
+
+	select {
+	case got := <-spoken:
+		if got != "hello" {
+			t.Fatalf("spoken = %q, want hello", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("speech was not started asynchronously")
+	}
+	close(release)
+	_ = a.Close()
+}
+
+func TestNativeAnnouncer_AssertiveSkipsQueue(t *testing.T) {
+	release := make(chan struct{})
+	spoken := make(chan string, 8)
+	a := newNativeAnnouncer(func(text string) error {
+		spoken <- text
+		<-release
+		return nil
+	})
+
+	a.Announce("first", PriorityPolite)
+	<-spoken // first is now speaking
+	a.Announce("second", PriorityPolite)
+	a.Announce("urgent", PriorityAssertive)
+	release <- struct{}{}
+
+	select {
+	case got := <-spoken:
+		if got != "urgent" {
+			t.Fatalf("next spoken = %q, want urgent", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("assertive message was not spoken")
+	}
+	close(release)
+	_ = a.Close()
+}
+
+func TestNewNativeAnnouncer_System(t *testing.T) {
+	a, err := NewNativeAnnouncer()
+	if errors.Is(err, ErrNotAvailable) {
+		t.Skip("no speech program installed")
+	}
+	if err != nil {
+		t.Fatalf("NewNativeAnnouncer: %v", err)
+	}
+	start := time.Now()
+	a.Announce("FluffyUI integration test", PriorityPolite)
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("Announce blocked for %v", elapsed)
+	}
+	_ = a.(*NativeAnnouncer).Close()
+}
@@ -0,0 +1,9 @@
+//go:build linux
+
+package accessibility
+
+// nativeSpeaker uses speech-dispatcher's spd-say in pipe mode, waiting for
+// each message to finish before the next is spoken.
+func nativeSpeaker() (speakFunc, error) {
+	return commandSpeaker("spd-say", "--wait", "--pipe-mode")
+}
@@ -0,0 +1,7 @@
+//go:build !darwin && !linux && !windows
+
+package accessibility
+
+func nativeSpeaker() (speakFunc, error) {
+	return nil, ErrNotAvailable
+}
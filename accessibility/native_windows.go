@@ -0,0 +1,12 @@
+//go:build windows
+
+package accessibility
+
+// sapiScript speaks stdin with the System.Speech synthesizer.
+const sapiScript = "Add-Type -AssemblyName System.Speech; " +
+	"(New-Object System.Speech.Synthesis.SpeechSynthesizer).Speak([Console]::In.ReadToEnd())"
+
+// nativeSpeaker uses PowerShell to drive SAPI.
+func nativeSpeaker() (speakFunc, error) {
+	return commandSpeaker("powershell", "-NoProfile", "-NonInteractive", "-Command", sapiScript)
+}
@@ -7,13 +7,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/recording"
 	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/state"
 	"github.com/odvcencio/fluffy-ui/terminal"
 )
 
@@ -25,6 +28,8 @@ var (
 	ErrNotInteractive = errors.New("widget is not interactive")
 	ErrTimeout        = errors.New("operation timed out")
 	ErrNoApp          = errors.New("no app configured")
+	ErrColumnNotFound = errors.New("column not found")
+	ErrRowNotFound    = errors.New("row not found")
 )
 
 // Agent provides AI-friendly interaction with a FluffyUI application.
@@ -36,6 +41,7 @@ type Agent struct {
 	sim      *sim.Backend
 	screen   *runtime.Screen
 	tickRate time.Duration
+	recorder *Recorder
 }
 
 // Config configures an Agent.
@@ -274,7 +280,7 @@ func actionsForRole(role accessibility.Role, state accessibility.StateSet) []str
 		return []string{"toggle", "focus"}
 	case accessibility.RoleTextbox:
 		return []string{"type", "clear", "focus"}
-	case accessibility.RoleList, accessibility.RoleTree:
+	case accessibility.RoleList, accessibility.RoleTree, accessibility.RoleTable, accessibility.RoleMenu:
 		return []string{"select", "focus", "scroll"}
 	case accessibility.RoleMenuItem:
 		return []string{"activate"}
@@ -402,7 +408,11 @@ func (a *Agent) Focus(label string) error {
 	if info.State.Disabled {
 		return ErrWidgetDisabled
 	}
-	return a.focusByID(info.ID)
+	if err := a.focusByID(info.ID); err != nil {
+		return err
+	}
+	a.record(AgentOp{Op: OpFocus, Label: label})
+	return nil
 }
 
 // ActivateWidget activates the widget with the given label.
@@ -425,6 +435,7 @@ func (a *Agent) Activate(label string) error {
 	if err := a.sendKey(terminal.KeyEnter, 0); err != nil {
 		return err
 	}
+	a.record(AgentOp{Op: OpActivate, Label: label})
 	a.Tick()
 	return nil
 }
@@ -449,6 +460,7 @@ func (a *Agent) Type(label, text string) error {
 	if err := a.sendText(text); err != nil {
 		return err
 	}
+	a.record(AgentOp{Op: OpType, Label: label, Text: text})
 	a.Tick()
 	return nil
 }
@@ -473,6 +485,7 @@ func (a *Agent) Select(label, option string) error {
 
 	current := acc.AccessibleLabel()
 	if strings.EqualFold(current, option) {
+		a.record(AgentOp{Op: OpSelect, Label: label, Value: option})
 		return nil
 	}
 
@@ -485,6 +498,7 @@ func (a *Agent) Select(label, option string) error {
 		current = acc.AccessibleLabel()
 		if strings.EqualFold(current, option) {
 			_ = w
+			a.record(AgentOp{Op: OpSelect, Label: label, Value: option})
 			return nil
 		}
 		if seen[current] {
@@ -500,6 +514,7 @@ func (a *Agent) SendKey(key terminal.Key) error {
 	if err := a.sendKey(key, 0); err != nil {
 		return err
 	}
+	a.record(AgentOp{Op: OpSendKey, Key: key})
 	a.Tick()
 	return nil
 }
@@ -509,6 +524,7 @@ func (a *Agent) SendKeyRune(key terminal.Key, r rune) error {
 	if err := a.sendKey(key, r); err != nil {
 		return err
 	}
+	a.record(AgentOp{Op: OpSendKeyRune, Key: key, Rune: r})
 	a.Tick()
 	return nil
 }
@@ -518,6 +534,57 @@ func (a *Agent) SendKeyString(text string) error {
 	if err := a.sendText(text); err != nil {
 		return err
 	}
+	a.record(AgentOp{Op: OpSendKeyString, Text: text})
+	a.Tick()
+	return nil
+}
+
+// DoubleClickInterval is the pause between the two clicks of
+// DoubleClickWidget, short enough to read as a double click.
+const DoubleClickInterval = 10 * time.Millisecond
+
+// ClickAt sends a left-button press and release at (x, y).
+func (a *Agent) ClickAt(x, y int) error {
+	if err := a.click(x, y, terminal.MouseLeft); err != nil {
+		return err
+	}
+	a.record(AgentOp{Op: OpClickAt, X: x, Y: y})
+	a.Tick()
+	return nil
+}
+
+// ClickWidget left-clicks the center of the widget with the given label.
+func (a *Agent) ClickWidget(label string) error {
+	return a.clickWidget(OpClick, label, terminal.MouseLeft, 1)
+}
+
+// RightClickWidget right-clicks the center of the widget with the given label.
+func (a *Agent) RightClickWidget(label string) error {
+	return a.clickWidget(OpRightClick, label, terminal.MouseRight, 1)
+}
+
+// DoubleClickWidget left-clicks the center of the widget with the given label
+// twice, DoubleClickInterval apart.
+func (a *Agent) DoubleClickWidget(label string) error {
+	return a.clickWidget(OpDoubleClick, label, terminal.MouseLeft, 2)
+}
+
+func (a *Agent) clickWidget(op, label string, button terminal.MouseButton, count int) error {
+	info := a.FindByLabel(label)
+	if info == nil {
+		return ErrWidgetNotFound
+	}
+	x := info.Bounds.X + info.Bounds.Width/2
+	y := info.Bounds.Y + info.Bounds.Height/2
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			time.Sleep(DoubleClickInterval)
+		}
+		if err := a.click(x, y, button); err != nil {
+			return err
+		}
+	}
+	a.record(AgentOp{Op: op, Label: label})
 	a.Tick()
 	return nil
 }
@@ -591,6 +658,57 @@ func (a *Agent) CaptureText() string {
 	return a.captureText()
 }
 
+// SavePNG renders the simulated screen to a PNG file.
+func (a *Agent) SavePNG(path string, opts recording.PNGOptions) error {
+	if a == nil {
+		return ErrNoApp
+	}
+	a.mu.Lock()
+	simBackend := a.sim
+	a.mu.Unlock()
+	if simBackend == nil {
+		return ErrNoApp
+	}
+	cells, width, height := simBackend.Cells()
+	buf := runtime.NewBuffer(width, height)
+	for i, cell := range cells {
+		buf.Set(i%width, i/width, cell.Rune, cell.Style)
+	}
+	data, err := recording.BufferToPNG(buf, opts.FontPath, opts.CellWidth, opts.CellHeight)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// onLoop runs fn on the app's event loop and waits for it to finish, so fn
+// may read live widgets while Run is updating them. Without an app, fn runs
+// on the calling goroutine.
+func (a *Agent) onLoop(fn func()) error {
+	a.mu.Lock()
+	app := a.app
+	a.mu.Unlock()
+	var scheduler state.Scheduler
+	if app != nil {
+		scheduler = app.StateScheduler()
+	}
+	if scheduler == nil {
+		fn()
+		return nil
+	}
+	done := make(chan struct{})
+	scheduler.Schedule(func() {
+		fn()
+		close(done)
+	})
+	select {
+	case <-done:
+		return nil
+	case <-time.After(time.Second):
+		return ErrTimeout
+	}
+}
+
 func (a *Agent) sendKey(key terminal.Key, r rune) error {
 	if a == nil {
 		return ErrNoApp
@@ -611,6 +729,33 @@ func (a *Agent) sendKey(key terminal.Key, r rune) error {
 	return ErrNoApp
 }
 
+func (a *Agent) sendMouse(x, y int, button terminal.MouseButton, action terminal.MouseAction) error {
+	if a == nil {
+		return ErrNoApp
+	}
+	a.mu.Lock()
+	simBackend := a.sim
+	app := a.app
+	a.mu.Unlock()
+
+	if simBackend != nil {
+		return simBackend.PostEvent(terminal.MouseEvent{X: x, Y: y, Button: button, Action: action})
+	}
+	if app != nil {
+		app.Post(runtime.MouseMsg{X: x, Y: y, Button: runtime.MouseButton(button), Action: runtime.MouseAction(action)})
+		return nil
+	}
+	return ErrNoApp
+}
+
+// click sends a press and release of button at (x, y).
+func (a *Agent) click(x, y int, button terminal.MouseButton) error {
+	if err := a.sendMouse(x, y, button, terminal.MousePress); err != nil {
+		return err
+	}
+	return a.sendMouse(x, y, button, terminal.MouseRelease)
+}
+
 func (a *Agent) sendText(text string) error {
 	if a == nil {
 		return ErrNoApp
@@ -690,6 +835,9 @@ func (a *Agent) focusWidgetByID(id string) (runtime.Widget, accessibility.Access
 		return w, accessibleFromWidget(w), ErrNotFocusable
 	}
 
+	if scope.Current() == focusable {
+		return w, accessibleFromWidget(w), nil
+	}
 	if !scope.SetFocus(focusable) {
 		scope.Reset()
 		runtime.RegisterFocusables(scope, layer.Root)
@@ -2,15 +2,21 @@ package agent
 
 import (
 	"context"
+	"image/png"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/recording"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/widgets"
 )
 
 type testInput struct {
@@ -152,7 +158,7 @@ func TestAgentSnapshotAndActions(t *testing.T) {
 		TickRate:          time.Second / 60,
 	})
 
-	agt := New(Config{App: app})
+	agt := New(Config{App: app, Sim: simBackend})
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -162,7 +168,7 @@ func TestAgentSnapshotAndActions(t *testing.T) {
 		done <- app.Run(ctx)
 	}()
 	defer func() {
-		app.Post(runtime.Quit{})
+		cancel()
 		<-done
 	}()
 
@@ -201,3 +207,225 @@ func TestAgentSnapshotAndActions(t *testing.T) {
 		t.Fatalf("snapshot json missing widgets: %s", string(raw))
 	}
 }
+
+func TestAgentFocusCurrentWidget(t *testing.T) {
+	input := &testInput{label: "Name"}
+	button := &testButton{label: "Submit"}
+	root := runtime.VBox(runtime.Fixed(input), runtime.Fixed(button)).WithGap(1)
+
+	simBackend := sim.New(40, 10)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:           simBackend,
+		Root:              root,
+		FocusRegistration: runtime.FocusRegistrationAuto,
+		TickRate:          time.Second / 60,
+	})
+	agt := New(Config{App: app})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	if err := agt.WaitForWidget("Name", time.Second); err != nil {
+		t.Fatalf("wait for widget: %v", err)
+	}
+	// Name is focused by auto-registration; focusing it again must succeed.
+	if err := agt.Focus("Name"); err != nil {
+		t.Fatalf("focus current widget: %v", err)
+	}
+	if err := agt.Focus("Submit"); err != nil {
+		t.Fatalf("focus submit: %v", err)
+	}
+	if err := agt.Focus("Submit"); err != nil {
+		t.Fatalf("focus submit again: %v", err)
+	}
+	if input.focused || !button.focused {
+		t.Fatalf("focus moved: name=%v submit=%v", input.focused, button.focused)
+	}
+}
+
+// clickTarget is a testButton that records mouse presses.
+type clickTarget struct {
+	testButton
+	mu      sync.Mutex
+	presses []runtime.MouseMsg
+}
+
+func (c *clickTarget) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.Constrain(runtime.Size{Width: 10, Height: 3})
+}
+
+func (c *clickTarget) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	mouse, ok := msg.(runtime.MouseMsg)
+	if !ok || mouse.Action != runtime.MousePress {
+		return runtime.Unhandled()
+	}
+	c.mu.Lock()
+	c.presses = append(c.presses, mouse)
+	c.mu.Unlock()
+	return runtime.Handled()
+}
+
+func (c *clickTarget) takePresses() []runtime.MouseMsg {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	presses := c.presses
+	c.presses = nil
+	return presses
+}
+
+func TestAgentClickWidget(t *testing.T) {
+	target := &clickTarget{testButton: testButton{label: "Target"}}
+	root := runtime.VBox(runtime.Fixed(&testInput{label: "Name"}), runtime.Fixed(target))
+
+	simBackend := sim.New(40, 10)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:  simBackend,
+		Root:     root,
+		Update:   runtime.DefaultUpdate,
+		TickRate: time.Second / 60,
+	})
+	agt := New(Config{App: app, Sim: simBackend})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	if err := agt.WaitForWidget("Target", time.Second); err != nil {
+		t.Fatalf("wait for widget: %v", err)
+	}
+	bounds := agt.FindByLabel("Target").Bounds
+	cx, cy := bounds.X+bounds.Width/2, bounds.Y+bounds.Height/2
+	if cy != 2 {
+		t.Fatalf("target center row = %d, want 2 (bounds %+v)", cy, bounds)
+	}
+	if err := agt.ClickWidget("Target"); err != nil {
+		t.Fatalf("click: %v", err)
+	}
+	presses := target.takePresses()
+	if len(presses) != 1 || presses[0].X != cx || presses[0].Y != cy || presses[0].Button != runtime.MouseLeft {
+		t.Fatalf("click presses = %+v, want one left press at (%d,%d)", presses, cx, cy)
+	}
+
+	if err := agt.RightClickWidget("Target"); err != nil {
+		t.Fatalf("right click: %v", err)
+	}
+	if presses := target.takePresses(); len(presses) != 1 || presses[0].Button != runtime.MouseRight {
+		t.Fatalf("right click presses = %+v, want one right press", presses)
+	}
+
+	if err := agt.DoubleClickWidget("Target"); err != nil {
+		t.Fatalf("double click: %v", err)
+	}
+	if presses := target.takePresses(); len(presses) != 2 {
+		t.Fatalf("double click presses = %d, want 2", len(presses))
+	}
+
+	if err := agt.ClickAt(1, 1); err != nil {
+		t.Fatalf("click at: %v", err)
+	}
+	if presses := target.takePresses(); len(presses) != 1 || presses[0].X != 1 || presses[0].Y != 1 {
+		t.Fatalf("click at presses = %+v, want one press at (1,1)", presses)
+	}
+
+	if err := agt.ClickWidget("Missing"); err != ErrWidgetNotFound {
+		t.Fatalf("missing widget err = %v, want ErrWidgetNotFound", err)
+	}
+}
+
+func TestAgentSavePNG(t *testing.T) {
+	simBackend := sim.New(4, 2)
+	if err := simBackend.Init(); err != nil {
+		t.Fatalf("init sim: %v", err)
+	}
+	defer simBackend.Fini()
+	simBackend.Resize(4, 2)
+	simBackend.SetContent(0, 0, 'X', nil, backend.DefaultStyle().Background(backend.ColorRed))
+	simBackend.Show()
+
+	agt := New(Config{Sim: simBackend})
+	path := filepath.Join(t.TempDir(), "screen.png")
+	if err := agt.SavePNG(path, recording.PNGOptions{CellWidth: 8, CellHeight: 16}); err != nil {
+		t.Fatalf("save png: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open png: %v", err)
+	}
+	defer file.Close()
+	img, err := png.Decode(file)
+	if err != nil {
+		t.Fatalf("decode png: %v", err)
+	}
+	if got := img.Bounds().Size(); got.X != 32 || got.Y != 32 {
+		t.Fatalf("image size = %v, want 32x32", got)
+	}
+	if r, g, _, _ := img.At(0, 0).RGBA(); r>>8 < 200 || g>>8 > 20 {
+		t.Fatalf("expected red background pixel, got r=%d g=%d", r>>8, g>>8)
+	}
+}
+
+func TestAgentFindByRole_DataWidgets(t *testing.T) {
+	table := widgets.NewTable(widgets.TableColumn{Title: "Name"}, widgets.TableColumn{Title: "Size"})
+	table.SetRows([][]string{{"a.txt", "1K"}, {"b.txt", "2K"}})
+	list := widgets.NewList(widgets.NewSliceAdapter([]string{"one", "two"}, nil))
+	tree := widgets.NewTree(&widgets.TreeNode{Label: "root"})
+	menu := widgets.NewMenu(&widgets.MenuItem{Title: "Open"})
+	root := runtime.VBox(runtime.Fixed(table), runtime.Fixed(list), runtime.Fixed(tree), runtime.Fixed(menu))
+
+	simBackend := sim.New(40, 20)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:  simBackend,
+		Root:     root,
+		Update:   runtime.DefaultUpdate,
+		TickRate: time.Second / 60,
+	})
+	agt := New(Config{App: app, Sim: simBackend})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	want := map[accessibility.Role]string{
+		accessibility.RoleTable: "a.txt\t1K",
+		accessibility.RoleList:  "one",
+		accessibility.RoleTree:  "root",
+		accessibility.RoleMenu:  "Open",
+	}
+	deadline := time.Now().Add(time.Second)
+	for role, value := range want {
+		var found []WidgetInfo
+		for {
+			found = agt.FindByRole(role)
+			if len(found) > 0 || time.Now().After(deadline) {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if len(found) != 1 {
+			t.Fatalf("FindByRole(%s) = %d widgets, want 1", role, len(found))
+		}
+		if found[0].Value != value {
+			t.Fatalf("%s value = %q, want %q", role, found[0].Value, value)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package agent
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Assertions checks the live UI state of an Agent and fails the test on a
+// mismatch. Each check takes a fresh Snapshot, so it sees what is currently
+// rendered.
+type Assertions struct {
+	t   testing.TB
+	agt *Agent
+}
+
+// Assert returns assertions for agt that report failures to t.
+func Assert(t testing.TB, agt *Agent) Assertions {
+	return Assertions{t: t, agt: agt}
+}
+
+// ContainsText fails unless text appears on screen.
+func (a Assertions) ContainsText(text string) {
+	a.t.Helper()
+	snap := a.agt.Snapshot()
+	if !strings.Contains(snap.Text, text) {
+		a.t.Fatalf("expected screen to contain %q; screen:\n%s", text, snap.Text)
+	}
+}
+
+// WidgetExists fails unless a widget matches label.
+func (a Assertions) WidgetExists(label string) {
+	a.t.Helper()
+	a.widget(label)
+}
+
+// WidgetFocused fails unless the widget matching label has focus.
+func (a Assertions) WidgetFocused(label string) {
+	a.t.Helper()
+	snap := a.agt.Snapshot()
+	w := a.widgetIn(snap, label)
+	if !w.Focused {
+		focused := "nothing"
+		if snap.Focused != nil {
+			focused = describeWidget(snap.Focused)
+		}
+		a.t.Fatalf("expected %s to be focused; focus is on %s", describeWidget(w), focused)
+	}
+}
+
+// WidgetValue fails unless the widget matching label has value want.
+func (a Assertions) WidgetValue(label, want string) {
+	a.t.Helper()
+	w := a.widget(label)
+	if w.Value != want {
+		a.t.Fatalf("expected %s to have value %q, got %q", describeWidget(w), want, w.Value)
+	}
+}
+
+// WidgetDisabled fails unless the widget matching label is disabled.
+func (a Assertions) WidgetDisabled(label string) {
+	a.t.Helper()
+	w := a.widget(label)
+	if !w.State.Disabled {
+		a.t.Fatalf("expected %s to be disabled", describeWidget(w))
+	}
+}
+
+// WidgetEnabled fails unless the widget matching label is enabled.
+func (a Assertions) WidgetEnabled(label string) {
+	a.t.Helper()
+	w := a.widget(label)
+	if w.State.Disabled {
+		a.t.Fatalf("expected %s to be enabled", describeWidget(w))
+	}
+}
+
+func (a Assertions) widget(label string) *WidgetInfo {
+	a.t.Helper()
+	return a.widgetIn(a.agt.Snapshot(), label)
+}
+
+func (a Assertions) widgetIn(snap Snapshot, label string) *WidgetInfo {
+	a.t.Helper()
+	w := findByLabelIn(snap.Widgets, label)
+	if w == nil {
+		a.t.Fatalf("expected a widget labelled %q; found %s", label, describeLabels(snap.Widgets))
+	}
+	return w
+}
+
+// WaitAssertions polls the UI until a condition holds and fails the test if
+// it does not hold before the timeout.
+type WaitAssertions struct {
+	t   testing.TB
+	agt *Agent
+}
+
+// WaitAssert returns polling assertions for agt that report failures to t.
+func WaitAssert(t testing.TB, agt *Agent) WaitAssertions {
+	return WaitAssertions{t: t, agt: agt}
+}
+
+// ContainsText waits up to timeout for text to appear on screen.
+func (w WaitAssertions) ContainsText(text string, timeout time.Duration) {
+	w.t.Helper()
+	deadline := time.Now().Add(timeout)
+	for {
+		snap := w.agt.Snapshot()
+		if strings.Contains(snap.Text, text) {
+			return
+		}
+		if !time.Now().Before(deadline) {
+			w.t.Fatalf("expected screen to contain %q within %v; screen:\n%s", text, timeout, snap.Text)
+		}
+		w.agt.Tick()
+	}
+}
+
+func describeWidget(w *WidgetInfo) string {
+	return string(w.Role) + " " + strconv.Quote(w.Label)
+}
+
+// describeLabels lists the labels in widgets for failure messages.
+func describeLabels(widgets []WidgetInfo) string {
+	var labels []string
+	var walk func([]WidgetInfo)
+	walk = func(list []WidgetInfo) {
+		for _, w := range list {
+			if w.Label != "" {
+				labels = append(labels, strconv.Quote(w.Label))
+			}
+			walk(w.Children)
+		}
+	}
+	walk(widgets)
+	if len(labels) == 0 {
+		return "no labelled widgets"
+	}
+	return strings.Join(labels, ", ")
+}
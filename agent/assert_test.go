@@ -0,0 +1,97 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	goruntime "runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// fatalRecorder captures Fatalf and stops the calling goroutine like a real
+// test would.
+type fatalRecorder struct {
+	testing.TB
+	msg string
+}
+
+func (f *fatalRecorder) Helper() {}
+
+func (f *fatalRecorder) Fatalf(format string, args ...any) {
+	f.msg = fmt.Sprintf(format, args...)
+	goruntime.Goexit()
+}
+
+// expectFatal runs check against a recorder and returns the failure message.
+func expectFatal(t *testing.T, check func(tb testing.TB)) string {
+	t.Helper()
+	rec := &fatalRecorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		check(rec)
+	}()
+	<-done
+	if rec.msg == "" {
+		t.Fatal("expected assertion to fail")
+	}
+	return rec.msg
+}
+
+func TestAssertions(t *testing.T) {
+	input := &testInput{label: "Name", value: "Alice"}
+	button := &testButton{label: "Submit", disabled: true}
+	root := runtime.VBox(runtime.Fixed(input), runtime.Fixed(button))
+
+	simBackend := sim.New(40, 10)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:           simBackend,
+		Root:              root,
+		Update:            runtime.DefaultUpdate,
+		FocusRegistration: runtime.FocusRegistrationAuto,
+		TickRate:          time.Second / 60,
+	})
+	agt := New(Config{App: app, Sim: simBackend, TickRate: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	WaitAssert(t, agt).ContainsText("[Submit]", time.Second)
+	if err := agt.Focus("Name"); err != nil {
+		t.Fatalf("focus: %v", err)
+	}
+
+	check := Assert(t, agt)
+	check.ContainsText("Alice")
+	check.WidgetExists("Submit")
+	check.WidgetFocused("Name")
+	check.WidgetValue("Name", "Alice")
+	check.WidgetEnabled("Name")
+	check.WidgetDisabled("Submit")
+
+	cases := map[string]func(tb testing.TB){
+		`"Missing"`:          func(tb testing.TB) { Assert(tb, agt).WidgetExists("Missing") },
+		`value "Bob"`:        func(tb testing.TB) { Assert(tb, agt).WidgetValue("Name", "Bob") },
+		`to be enabled`:      func(tb testing.TB) { Assert(tb, agt).WidgetEnabled("Submit") },
+		`to be disabled`:     func(tb testing.TB) { Assert(tb, agt).WidgetDisabled("Name") },
+		`focus is on`:        func(tb testing.TB) { Assert(tb, agt).WidgetFocused("Submit") },
+		`contain "nope"`:     func(tb testing.TB) { Assert(tb, agt).ContainsText("nope") },
+		`"nope" within 30ms`: func(tb testing.TB) { WaitAssert(tb, agt).ContainsText("nope", 30*time.Millisecond) },
+	}
+	for want, fn := range cases {
+		if msg := expectFatal(t, fn); !strings.Contains(msg, want) {
+			t.Errorf("failure message %q does not mention %q", msg, want)
+		}
+	}
+}
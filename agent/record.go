@@ -0,0 +1,179 @@
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// AgentOpVersion is the AgentOp format written by Recorder. Replay accepts
+// this version and every earlier one.
+const AgentOpVersion = 1
+
+// Recorded operation names.
+const (
+	OpFocus          = "focus"
+	OpType           = "type"
+	OpActivate       = "activate"
+	OpSelect         = "select"
+	OpSendKey        = "send_key"
+	OpSendKeyRune    = "send_key_rune"
+	OpSendKeyString  = "send_key_string"
+	OpClickAt        = "click_at"
+	OpClick          = "click"
+	OpRightClick     = "right_click"
+	OpDoubleClick    = "double_click"
+	OpTableSelectRow = "table_select_row"
+)
+
+// ErrRecorderStopped is returned by Recorder.Stop after the first call.
+var ErrRecorderStopped = errors.New("recorder already stopped")
+
+// AgentOp is one recorded Agent call. Fields unused by an operation are
+// left empty so fixtures stay small.
+type AgentOp struct {
+	Version int          `json:"version"`
+	Op      string       `json:"op"`
+	Label   string       `json:"label,omitempty"`
+	Text    string       `json:"text,omitempty"`
+	Column  string       `json:"column,omitempty"`
+	Value   string       `json:"value,omitempty"`
+	X       int          `json:"x,omitempty"`
+	Y       int          `json:"y,omitempty"`
+	Key     terminal.Key `json:"key,omitempty"`
+	Rune    rune         `json:"rune,omitempty"`
+}
+
+// Recorder collects the operations an Agent performs after StartRecording.
+type Recorder struct {
+	agt     *Agent
+	mu      sync.Mutex
+	ops     []AgentOp
+	stopped bool
+}
+
+// StartRecording starts recording successful interactions such as Focus,
+// Type, Activate, and ClickAt. It replaces any recording already running.
+func (a *Agent) StartRecording() *Recorder {
+	r := &Recorder{agt: a}
+	if a == nil {
+		return r
+	}
+	a.mu.Lock()
+	a.recorder = r
+	a.mu.Unlock()
+	return r
+}
+
+// Stop ends the recording and returns the operations in call order.
+func (r *Recorder) Stop() ([]AgentOp, error) {
+	if r == nil {
+		return nil, ErrRecorderStopped
+	}
+	if a := r.agt; a != nil {
+		a.mu.Lock()
+		if a.recorder == r {
+			a.recorder = nil
+		}
+		a.mu.Unlock()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopped {
+		return nil, ErrRecorderStopped
+	}
+	r.stopped = true
+	ops := r.ops
+	r.ops = nil
+	return ops, nil
+}
+
+func (r *Recorder) add(op AgentOp) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.stopped {
+		r.ops = append(r.ops, op)
+	}
+}
+
+// record appends op to the active recording, if any.
+func (a *Agent) record(op AgentOp) {
+	a.mu.Lock()
+	r := a.recorder
+	a.mu.Unlock()
+	if r == nil {
+		return
+	}
+	op.Version = AgentOpVersion
+	r.add(op)
+}
+
+// Replay performs ops against agt in order. Operations that target a widget
+// first wait up to timeout for it to appear. The first failure is returned
+// with the index of the operation.
+func Replay(agt *Agent, ops []AgentOp, timeout time.Duration) error {
+	if agt == nil {
+		return ErrNoApp
+	}
+	for i, op := range ops {
+		if err := replayOp(agt, op, timeout); err != nil {
+			return fmt.Errorf("op %d (%s): %w", i+1, op.Op, err)
+		}
+	}
+	return nil
+}
+
+func replayOp(agt *Agent, op AgentOp, timeout time.Duration) error {
+	op, err := upgradeOp(op)
+	if err != nil {
+		return err
+	}
+	if op.Label != "" {
+		if err := agt.WaitForWidget(op.Label, timeout); err != nil {
+			return fmt.Errorf("waiting for %q: %w", op.Label, err)
+		}
+	}
+	switch op.Op {
+	case OpFocus:
+		return agt.Focus(op.Label)
+	case OpType:
+		return agt.Type(op.Label, op.Text)
+	case OpActivate:
+		return agt.Activate(op.Label)
+	case OpSelect:
+		return agt.Select(op.Label, op.Value)
+	case OpSendKey:
+		return agt.SendKey(op.Key)
+	case OpSendKeyRune:
+		return agt.SendKeyRune(op.Key, op.Rune)
+	case OpSendKeyString:
+		return agt.SendKeyString(op.Text)
+	case OpClickAt:
+		return agt.ClickAt(op.X, op.Y)
+	case OpClick:
+		return agt.ClickWidget(op.Label)
+	case OpRightClick:
+		return agt.RightClickWidget(op.Label)
+	case OpDoubleClick:
+		return agt.DoubleClickWidget(op.Label)
+	case OpTableSelectRow:
+		return agt.TableSelectRow(op.Label, op.Column, op.Value)
+	default:
+		return fmt.Errorf("unknown op %q", op.Op)
+	}
+}
+
+// upgradeOp converts an operation from an older fixture to the current
+// format. Changes to AgentOp bump AgentOpVersion and add a step here.
+func upgradeOp(op AgentOp) (AgentOp, error) {
+	switch {
+	case op.Version < 1:
+		return op, errors.New("missing op version")
+	case op.Version > AgentOpVersion:
+		return op, fmt.Errorf("op version %d is newer than supported version %d", op.Version, AgentOpVersion)
+	}
+	return op, nil
+}
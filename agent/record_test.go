@@ -0,0 +1,122 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// startFormApp runs an app with a Name input and a Submit button until the
+// test ends.
+func startFormApp(t *testing.T) (*Agent, *testInput, *testButton) {
+	t.Helper()
+	input := &testInput{label: "Name"}
+	button := &testButton{label: "Submit"}
+	root := runtime.VBox(runtime.Fixed(input), runtime.Fixed(button))
+
+	simBackend := sim.New(40, 10)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:           simBackend,
+		Root:              root,
+		Update:            runtime.DefaultUpdate,
+		FocusRegistration: runtime.FocusRegistrationAuto,
+		TickRate:          time.Second / 60,
+	})
+	agt := New(Config{App: app, Sim: simBackend, TickRate: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	if err := agt.WaitForWidget("Submit", time.Second); err != nil {
+		t.Fatalf("wait for widget: %v", err)
+	}
+	return agt, input, button
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	agt, _, _ := startFormApp(t)
+
+	rec := agt.StartRecording()
+	if err := agt.Type("Name", "Ada"); err != nil {
+		t.Fatalf("type: %v", err)
+	}
+	if err := agt.SendKey(terminal.KeyBackspace); err != nil {
+		t.Fatalf("send key: %v", err)
+	}
+	if err := agt.Focus("Missing"); err == nil {
+		t.Fatal("expected focus on a missing widget to fail")
+	}
+	if err := agt.Activate("Submit"); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	ops, err := rec.Stop()
+	if err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+	if _, err := rec.Stop(); !errors.Is(err, ErrRecorderStopped) {
+		t.Fatalf("second stop err = %v, want ErrRecorderStopped", err)
+	}
+	if err := agt.ClickAt(0, 0); err != nil {
+		t.Fatalf("click after stop: %v", err)
+	}
+
+	var names []string
+	for _, op := range ops {
+		if op.Version != AgentOpVersion {
+			t.Fatalf("op %+v has version %d, want %d", op, op.Version, AgentOpVersion)
+		}
+		names = append(names, op.Op)
+	}
+	if got := strings.Join(names, ","); got != "type,send_key,activate" {
+		t.Fatalf("recorded ops = %s, want type,send_key,activate", got)
+	}
+
+	fixture, err := json.Marshal(ops)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var loaded []AgentOp
+	if err := json.Unmarshal(fixture, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	replayAgt, input, button := startFormApp(t)
+	if err := Replay(replayAgt, loaded, time.Second); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if input.value != "Ad" {
+		t.Fatalf("replayed input = %q, want %q", input.value, "Ad")
+	}
+	if !button.clicked {
+		t.Fatal("replay did not activate the button")
+	}
+}
+
+func TestReplayRejectsUnsupportedOps(t *testing.T) {
+	agt, _, _ := startFormApp(t)
+	cases := map[string]AgentOp{
+		"missing op version":    {Op: OpFocus, Label: "Name"},
+		"newer than supported":  {Version: AgentOpVersion + 1, Op: OpFocus, Label: "Name"},
+		`unknown op "teleport"`: {Version: AgentOpVersion, Op: "teleport"},
+		"op 1 (focus): waiting": {Version: AgentOpVersion, Op: OpFocus, Label: "Missing"},
+	}
+	for want, op := range cases {
+		err := Replay(agt, []AgentOp{op}, 30*time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Replay(%+v) = %v, want error containing %q", op, err, want)
+		}
+	}
+}
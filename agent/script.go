@@ -0,0 +1,102 @@
+package agent
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultScriptWait is the timeout for wait steps that do not set one.
+const DefaultScriptWait = 5 * time.Second
+
+// ScriptStep is a single step in an agent test script.
+//
+// Supported types:
+//   - focus: focus the widget labelled Target
+//   - type: type Text into Target, or into the focused widget if Target is empty
+//   - activate: activate the widget labelled Target
+//   - assert_text: fail unless Text is on screen
+//   - click: click the cell at X, Y
+//   - wait: wait up to Timeout for Text to appear
+type ScriptStep struct {
+	Type    string        `yaml:"type" json:"type"`
+	Target  string        `yaml:"target,omitempty" json:"target,omitempty"`
+	Text    string        `yaml:"text,omitempty" json:"text,omitempty"`
+	X       int           `yaml:"x,omitempty" json:"x,omitempty"`
+	Y       int           `yaml:"y,omitempty" json:"y,omitempty"`
+	Timeout time.Duration `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// LoadScript reads a YAML list of steps from a file.
+func LoadScript(scriptPath string) ([]ScriptStep, error) {
+	data, err := os.ReadFile(scriptPath)
+	if err != nil {
+		return nil, fmt.Errorf("read script: %w", err)
+	}
+	var steps []ScriptStep
+	if err := yaml.Unmarshal(data, &steps); err != nil {
+		return nil, fmt.Errorf("parse script %s: %w", scriptPath, err)
+	}
+	return steps, nil
+}
+
+// RunScript loads a YAML script and executes its steps in order.
+// It returns the first error encountered.
+func RunScript(a *Agent, scriptPath string) error {
+	steps, err := LoadScript(scriptPath)
+	if err != nil {
+		return err
+	}
+	return RunSteps(a, steps)
+}
+
+// RunSteps executes script steps in order, returning the first error.
+func RunSteps(a *Agent, steps []ScriptStep) error {
+	if a == nil {
+		return ErrNoApp
+	}
+	for i, step := range steps {
+		if err := runStep(a, step); err != nil {
+			return fmt.Errorf("step %d (%s): %w", i+1, step.Type, err)
+		}
+	}
+	return nil
+}
+
+func runStep(a *Agent, step ScriptStep) error {
+	switch step.Type {
+	case "focus":
+		return a.Focus(step.Target)
+	case "type":
+		if step.Target != "" {
+			return a.Type(step.Target, step.Text)
+		}
+		if err := a.sendText(step.Text); err != nil {
+			return err
+		}
+		a.Tick()
+		return nil
+	case "activate":
+		return a.Activate(step.Target)
+	case "assert_text":
+		if !a.ContainsText(step.Text) {
+			return fmt.Errorf("text %q not found on screen:\n%s", step.Text, a.CaptureText())
+		}
+		return nil
+	case "click":
+		return a.ClickAt(step.X, step.Y)
+	case "wait":
+		timeout := step.Timeout
+		if timeout <= 0 {
+			timeout = DefaultScriptWait
+		}
+		if err := a.WaitForText(step.Text, timeout); err != nil {
+			return fmt.Errorf("waiting for %q: %w", step.Text, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown step type %q", step.Type)
+	}
+}
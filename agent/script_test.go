@@ -0,0 +1,98 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+func startScriptApp(t *testing.T) (*Agent, *testInput) {
+	t.Helper()
+	input := &testInput{label: "Name"}
+	button := &testButton{label: "Submit"}
+	root := runtime.VBox(runtime.Fixed(input), runtime.Fixed(button)).WithGap(1)
+
+	simBackend := sim.New(40, 10)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:           simBackend,
+		Root:              root,
+		FocusRegistration: runtime.FocusRegistrationAuto,
+		TickRate:          time.Second / 60,
+	})
+	agt := New(Config{App: app, Sim: simBackend})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	if err := agt.WaitForWidget("Name", time.Second); err != nil {
+		t.Fatalf("wait for widget: %v", err)
+	}
+	return agt, input
+}
+
+func writeScript(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "script.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write script: %v", err)
+	}
+	return path
+}
+
+func TestRunScript(t *testing.T) {
+	agt, input := startScriptApp(t)
+	path := writeScript(t, `
+- type: focus
+  target: Name
+- type: type
+  text: Alice
+- type: assert_text
+  text: Alice
+`)
+
+	if err := RunScript(agt, path); err != nil {
+		t.Fatalf("run script: %v", err)
+	}
+	if input.value != "Alice" {
+		t.Fatalf("value = %q, want Alice", input.value)
+	}
+}
+
+func TestRunScriptAssertFailure(t *testing.T) {
+	agt, _ := startScriptApp(t)
+	path := writeScript(t, `
+- type: assert_text
+  text: Bob
+`)
+
+	err := RunScript(agt, path)
+	if err == nil {
+		t.Fatal("expected assert_text to fail")
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "step 1 (assert_text)") || !strings.Contains(msg, `"Bob"`) || !strings.Contains(msg, "[Submit]") {
+		t.Fatalf("expected descriptive error with screen content, got %q", msg)
+	}
+}
+
+func TestRunScriptUnknownStep(t *testing.T) {
+	agt, _ := startScriptApp(t)
+	path := writeScript(t, "- type: dance\n")
+
+	if err := RunScript(agt, path); err == nil || !strings.Contains(err.Error(), `unknown step type "dance"`) {
+		t.Fatalf("expected unknown step error, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package agent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/odvcencio/fluffy-ui/accessibility"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// tableRows is the row access the table helpers need; widgets.Table
+// implements it.
+type tableRows interface {
+	ColumnTitles() []string
+	RowCount() int
+	Row(index int) []string
+	SelectedIndex() int
+}
+
+// TableSelectRow focuses the table with the given label and moves the
+// selection with Up/Down keys to the first row whose cell in the column
+// titled columnTitle equals cellValue.
+func (a *Agent) TableSelectRow(tableLabel, columnTitle, cellValue string) error {
+	info, data, err := a.readTable(tableLabel)
+	if err != nil {
+		return err
+	}
+	col := -1
+	for i, title := range data.columns {
+		if strings.EqualFold(title, columnTitle) {
+			col = i
+			break
+		}
+	}
+	if col < 0 {
+		return fmt.Errorf("%w: %q in table %q", ErrColumnNotFound, columnTitle, tableLabel)
+	}
+	target := -1
+	for i, row := range data.rows {
+		if col < len(row) && row[col] == cellValue {
+			target = i
+			break
+		}
+	}
+	if target < 0 {
+		return fmt.Errorf("%w: %s = %q in table %q", ErrRowNotFound, columnTitle, cellValue, tableLabel)
+	}
+	if info.State.Disabled {
+		return ErrWidgetDisabled
+	}
+	if err := a.focusByID(info.ID); err != nil {
+		return err
+	}
+
+	current := 0
+	if info.ValueInfo != nil {
+		current = int(info.ValueInfo.Current)
+	}
+	key, steps := terminal.KeyDown, target-current
+	if steps < 0 {
+		key, steps = terminal.KeyUp, -steps
+	}
+	for i := 0; i < steps; i++ {
+		if err := a.sendKey(key, 0); err != nil {
+			return err
+		}
+	}
+	a.record(AgentOp{Op: OpTableSelectRow, Label: tableLabel, Column: columnTitle, Value: cellValue})
+	a.Tick()
+	return nil
+}
+
+// TableGetSelected returns the cells of the selected row of the table with
+// the given label, or nil when the table is empty.
+func (a *Agent) TableGetSelected(tableLabel string) ([]string, error) {
+	_, data, err := a.readTable(tableLabel)
+	if err != nil {
+		return nil, err
+	}
+	if data.selected < 0 || data.selected >= len(data.rows) {
+		return nil, nil
+	}
+	return data.rows[data.selected], nil
+}
+
+// TableRowCount returns the number of rows in the table with the given
+// label, as reported by its accessible value.
+func (a *Agent) TableRowCount(tableLabel string) (int, error) {
+	info := findTableIn(a.Snapshot().Widgets, tableLabel)
+	if info == nil {
+		return 0, ErrWidgetNotFound
+	}
+	if info.ValueInfo == nil {
+		return 0, nil
+	}
+	return int(info.ValueInfo.Max) + 1, nil
+}
+
+// tableData is a copy of a table's contents.
+type tableData struct {
+	columns  []string
+	rows     [][]string
+	selected int
+}
+
+// readTable returns the snapshot info of the table with the given label and
+// a copy of its rows. The copy is taken on the app's event loop so it does
+// not race with Run updating the table.
+func (a *Agent) readTable(label string) (*WidgetInfo, tableData, error) {
+	info := findTableIn(a.Snapshot().Widgets, label)
+	if info == nil {
+		return nil, tableData{}, ErrWidgetNotFound
+	}
+	a.mu.Lock()
+	screen := a.ensureScreenLocked()
+	a.mu.Unlock()
+	if screen == nil {
+		return nil, tableData{}, ErrNoApp
+	}
+	var data tableData
+	readErr := ErrNotInteractive
+	err := a.onLoop(func() {
+		layer := screen.TopLayer()
+		if layer == nil || layer.Root == nil {
+			readErr = ErrNoApp
+			return
+		}
+		table, ok := findWidgetByID(layer.Root, info.ID).(tableRows)
+		if !ok {
+			return
+		}
+		data.columns = append([]string(nil), table.ColumnTitles()...)
+		for i, count := 0, table.RowCount(); i < count; i++ {
+			data.rows = append(data.rows, append([]string(nil), table.Row(i)...))
+		}
+		data.selected = table.SelectedIndex()
+		readErr = nil
+	})
+	if err != nil {
+		return nil, tableData{}, err
+	}
+	if readErr != nil {
+		return nil, tableData{}, readErr
+	}
+	return info, data, nil
+}
+
+// findTableIn finds the first table whose label contains label,
+// case-insensitively.
+func findTableIn(widgets []WidgetInfo, label string) *WidgetInfo {
+	label = strings.ToLower(label)
+	for i := range widgets {
+		w := &widgets[i]
+		if w.Role == accessibility.RoleTable && strings.Contains(strings.ToLower(w.Label), label) {
+			return w
+		}
+		if found := findTableIn(w.Children, label); found != nil {
+			return found
+		}
+	}
+	return nil
+}
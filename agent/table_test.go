@@ -0,0 +1,73 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/widgets"
+)
+
+func TestAgentTableHelpers(t *testing.T) {
+	table := widgets.NewTable(widgets.TableColumn{Title: "Name"}, widgets.TableColumn{Title: "Size"})
+	table.SetLabel("Files")
+	table.SetRows([][]string{{"a.txt", "1K"}, {"b.txt", "2K"}, {"c.txt", "3K"}, {"d.txt", "4K"}})
+
+	simBackend := sim.New(40, 10)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:           simBackend,
+		Root:              table,
+		Update:            runtime.DefaultUpdate,
+		FocusRegistration: runtime.FocusRegistrationAuto,
+		TickRate:          time.Second / 60,
+	})
+	agt := New(Config{App: app, Sim: simBackend, TickRate: 20 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	if err := agt.WaitForWidget("Files", time.Second); err != nil {
+		t.Fatalf("wait for table: %v", err)
+	}
+	if count, err := agt.TableRowCount("Files"); err != nil || count != 4 {
+		t.Fatalf("row count = %d, %v; want 4", count, err)
+	}
+
+	for _, step := range []struct{ column, value, want string }{
+		{"Name", "c.txt", "c.txt\t3K"},
+		{"size", "2K", "b.txt\t2K"},
+		{"Name", "d.txt", "d.txt\t4K"},
+	} {
+		if err := agt.TableSelectRow("Files", step.column, step.value); err != nil {
+			t.Fatalf("select %s=%s: %v", step.column, step.value, err)
+		}
+		selected, err := agt.TableGetSelected("Files")
+		if err != nil {
+			t.Fatalf("get selected: %v", err)
+		}
+		if got := strings.Join(selected, "\t"); got != step.want {
+			t.Fatalf("after selecting %s=%s, selected = %q, want %q", step.column, step.value, got, step.want)
+		}
+	}
+
+	if err := agt.TableSelectRow("Files", "Owner", "x"); !errors.Is(err, ErrColumnNotFound) {
+		t.Fatalf("unknown column err = %v, want ErrColumnNotFound", err)
+	}
+	if err := agt.TableSelectRow("Files", "Name", "z.txt"); !errors.Is(err, ErrRowNotFound) {
+		t.Fatalf("unknown row err = %v, want ErrRowNotFound", err)
+	}
+	if _, err := agt.TableRowCount("Missing"); err != ErrWidgetNotFound {
+		t.Fatalf("missing table err = %v, want ErrWidgetNotFound", err)
+	}
+}
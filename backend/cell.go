@@ -5,3 +5,7 @@ type Cell struct {
 	Rune  rune
 	Style Style
 }
+
+// CellContinuation is the Rune of the cell to the right of a double-width
+// character. The wide character already covers it, so backends skip it.
+const CellContinuation rune = -1
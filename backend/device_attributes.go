@@ -0,0 +1,9 @@
+package backend
+
+// DeviceAttributesReporter is an optional interface for backends that ask
+// the terminal for its primary device attributes (DA1). After Init, the app
+// passes the reply to terminal.Capabilities.ApplyDA unless
+// AppConfig.Capabilities overrides detection.
+type DeviceAttributesReporter interface {
+	DeviceAttributes() string
+}
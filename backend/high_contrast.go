@@ -0,0 +1,10 @@
+package backend
+
+// HighContrastDetector is an optional interface for backends that can tell
+// whether the user's terminal is set up for high contrast. With
+// AppConfig.DetectHighContrast, the app calls it before Init and enables
+// high-contrast mode when it reports true, unless the mode was set
+// explicitly.
+type HighContrastDetector interface {
+	PrefersHighContrast() bool
+}
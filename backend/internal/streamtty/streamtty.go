@@ -0,0 +1,158 @@
+// Package streamtty adapts a network stream to tcell.Tty for backends that
+// serve a terminal UI to a remote client.
+package streamtty
+
+import (
+	"io"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Tty is a tcell.Tty whose input is pushed with Feed and whose size is set
+// with Resize. Output is written to the writer given to New.
+type Tty struct {
+	out io.Writer
+
+	mu       sync.Mutex
+	width    int
+	height   int
+	onResize func()
+	drain    chan struct{}
+	inputErr error
+
+	input   chan []byte
+	pending []byte
+	done    chan struct{}
+	once    sync.Once
+}
+
+// New creates a tty of the given size writing to out.
+func New(out io.Writer, width, height int) *Tty {
+	return &Tty{
+		out:    out,
+		width:  width,
+		height: height,
+		drain:  make(chan struct{}),
+		input:  make(chan []byte),
+		done:   make(chan struct{}),
+	}
+}
+
+// Feed queues input for Read. It blocks until the data is read and reports
+// false once the input is closed.
+func (t *Tty) Feed(data []byte) bool {
+	if len(data) == 0 {
+		return true
+	}
+	select {
+	case t.input <- append([]byte(nil), data...):
+		return true
+	case <-t.done:
+		return false
+	}
+}
+
+// CloseInput ends input: pending Feed calls return false and Read returns
+// err, or io.EOF when err is nil. Only the first call has an effect.
+func (t *Tty) CloseInput(err error) {
+	if err == nil {
+		err = io.EOF
+	}
+	t.once.Do(func() {
+		t.mu.Lock()
+		t.inputErr = err
+		t.mu.Unlock()
+		close(t.done)
+	})
+}
+
+// Resize records a new size and notifies tcell, as SIGWINCH would.
+func (t *Tty) Resize(width, height int) {
+	t.mu.Lock()
+	t.width, t.height = width, height
+	cb := t.onResize
+	t.mu.Unlock()
+	if cb != nil {
+		cb()
+	}
+}
+
+// Start prepares for reads; the remote terminal is already in raw mode.
+func (t *Tty) Start() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	select {
+	case <-t.drain:
+		t.drain = make(chan struct{})
+	default:
+	}
+	return nil
+}
+
+// Stop is a no-op; the client restores its own terminal.
+func (t *Tty) Stop() error {
+	return nil
+}
+
+// Drain wakes a blocked Read so tcell's input loop can exit.
+func (t *Tty) Drain() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	select {
+	case <-t.drain:
+	default:
+		close(t.drain)
+	}
+	return nil
+}
+
+// NotifyResize registers the resize callback.
+func (t *Tty) NotifyResize(cb func()) {
+	t.mu.Lock()
+	t.onResize = cb
+	t.mu.Unlock()
+}
+
+// WindowSize returns the last size set by New or Resize.
+func (t *Tty) WindowSize() (tcell.WindowSize, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return tcell.WindowSize{Width: t.width, Height: t.height}, nil
+}
+
+// Read returns fed input, no data once Drain is called, or the input error
+// after CloseInput.
+func (t *Tty) Read(p []byte) (int, error) {
+	if len(t.pending) == 0 {
+		t.mu.Lock()
+		drain := t.drain
+		t.mu.Unlock()
+		select {
+		case chunk := <-t.input:
+			t.pending = chunk
+		case <-drain:
+			return 0, nil
+		case <-t.done:
+			t.mu.Lock()
+			err := t.inputErr
+			t.mu.Unlock()
+			return 0, err
+		}
+	}
+	n := copy(p, t.pending)
+	t.pending = t.pending[n:]
+	return n, nil
+}
+
+// Write sends output to the client.
+func (t *Tty) Write(p []byte) (int, error) {
+	return t.out.Write(p)
+}
+
+// Close is a no-op; the owning backend closes the connection.
+func (t *Tty) Close() error {
+	return nil
+}
+
+var _ tcell.Tty = (*Tty)(nil)
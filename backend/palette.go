@@ -0,0 +1,52 @@
+package backend
+
+// xtermANSI holds the first 16 colors of the xterm palette.
+var xtermANSI = [16][3]uint8{
+	{0, 0, 0},
+	{205, 0, 0},
+	{0, 205, 0},
+	{205, 205, 0},
+	{0, 0, 238},
+	{205, 0, 205},
+	{0, 205, 205},
+	{229, 229, 229},
+	{127, 127, 127},
+	{255, 0, 0},
+	{0, 255, 0},
+	{255, 255, 0},
+	{92, 92, 255},
+	{255, 0, 255},
+	{0, 255, 255},
+	{255, 255, 255},
+}
+
+// Resolve returns the components of c, mapping palette colors through the
+// xterm 256-color palette. ok is false for ColorDefault and other values the
+// palette does not cover.
+func (c Color) Resolve() (r, g, b uint8, ok bool) {
+	switch {
+	case c < 0:
+		// Checked first: ColorDefault has every bit set, including the RGB flag.
+		return 0, 0, 0, false
+	case c.IsRGB():
+		r, g, b = c.RGB()
+		return r, g, b, true
+	case c > 255:
+		return 0, 0, 0, false
+	case c < 16:
+		rgb := xtermANSI[c]
+		return rgb[0], rgb[1], rgb[2], true
+	case c < 232:
+		index := int(c) - 16
+		level := func(v int) uint8 {
+			if v == 0 {
+				return 0
+			}
+			return uint8(55 + v*40)
+		}
+		return level(index / 36), level(index / 6 % 6), level(index % 6), true
+	default:
+		gray := uint8(8 + (int(c)-232)*10)
+		return gray, gray, gray, true
+	}
+}
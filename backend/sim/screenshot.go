@@ -0,0 +1,145 @@
+package sim
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// ScreenshotOptions configures Screenshot.
+type ScreenshotOptions struct {
+	// FontSize is the cell height in pixels before scaling. Zero uses the
+	// line height of FontFace.
+	FontSize int
+	// FontFace draws the glyphs. Nil uses a bundled 7x13 monospace bitmap
+	// font. The cell width is the face's advance for 'M'.
+	FontFace font.Face
+	// Padding is the border, in pixels before scaling, drawn in the default
+	// background around the grid.
+	Padding int
+	// Scale multiplies every pixel, for HiDPI output. Zero means 1.
+	Scale int
+}
+
+var (
+	screenshotForeground = color.RGBA{R: 229, G: 229, B: 229, A: 255}
+	screenshotBackground = color.RGBA{A: 255}
+)
+
+// Screenshot rasterizes the screen into an image, one fixed-size cell per
+// character, using each cell's foreground and background colors. The output
+// is deterministic, so PNG encodings of two screenshots can be compared with
+// bytes.Equal for golden-image tests.
+func (s *Backend) Screenshot(opts ScreenshotOptions) (image.Image, error) {
+	if opts.FontSize < 0 || opts.Padding < 0 || opts.Scale < 0 {
+		return nil, errors.New("sim: screenshot options must not be negative")
+	}
+	face := opts.FontFace
+	if face == nil {
+		face = basicfont.Face7x13
+	}
+	scale := opts.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	metrics := face.Metrics()
+	ascent := metrics.Ascent.Ceil()
+	glyphHeight := ascent + metrics.Descent.Ceil()
+	cellH := opts.FontSize
+	if cellH == 0 {
+		cellH = metrics.Height.Ceil()
+	}
+	if cellH < glyphHeight {
+		cellH = glyphHeight
+	}
+	advance, ok := face.GlyphAdvance('M')
+	if !ok {
+		return nil, errors.New("sim: screenshot font has no glyph for 'M'")
+	}
+	cellW := advance.Ceil()
+	if cellW <= 0 || cellH <= 0 {
+		return nil, errors.New("sim: screenshot font has an empty cell size")
+	}
+	baseline := (cellH-glyphHeight)/2 + ascent
+
+	cells, cols, rows := s.Cells()
+	pad := opts.Padding
+	img := image.NewRGBA(image.Rect(0, 0, cols*cellW+2*pad, rows*cellH+2*pad))
+	draw.Draw(img, img.Bounds(), image.NewUniform(screenshotBackground), image.Point{}, draw.Src)
+
+	drawer := &font.Drawer{Dst: img, Face: face}
+	for i, cell := range cells {
+		x, y := i%cols, i/cols
+		fg, bg := screenshotColors(cell.Style)
+		rect := image.Rect(pad+x*cellW, pad+y*cellH, pad+(x+1)*cellW, pad+(y+1)*cellH)
+		draw.Draw(img, rect, image.NewUniform(bg), image.Point{}, draw.Src)
+
+		attrs := cell.Style.Attributes()
+		if attrs&backend.AttrUnderline != 0 {
+			line := image.Rect(rect.Min.X, rect.Max.Y-1, rect.Max.X, rect.Max.Y)
+			draw.Draw(img, line, image.NewUniform(fg), image.Point{}, draw.Src)
+		}
+		if cell.Rune == 0 || cell.Rune == ' ' || cell.Rune == backend.CellContinuation {
+			continue
+		}
+		drawer.Src = image.NewUniform(fg)
+		drawer.Dot = fixed.P(rect.Min.X, rect.Min.Y+baseline)
+		drawer.DrawString(string(cell.Rune))
+		if attrs&backend.AttrBold != 0 {
+			drawer.Dot = fixed.P(rect.Min.X+1, rect.Min.Y+baseline)
+			drawer.DrawString(string(cell.Rune))
+		}
+	}
+	if scale == 1 {
+		return img, nil
+	}
+	return scaleImage(img, scale), nil
+}
+
+func screenshotColors(style backend.Style) (fg, bg color.RGBA) {
+	fgColor, bgColor, attrs := style.Decompose()
+	fg = resolveColor(fgColor, screenshotForeground)
+	bg = resolveColor(bgColor, screenshotBackground)
+	if attrs&backend.AttrReverse != 0 {
+		fg, bg = bg, fg
+	}
+	if attrs&backend.AttrDim != 0 {
+		fg = color.RGBA{R: fg.R / 2, G: fg.G / 2, B: fg.B / 2, A: 255}
+	}
+	return fg, bg
+}
+
+func resolveColor(c backend.Color, fallback color.RGBA) color.RGBA {
+	r, g, b, ok := c.Resolve()
+	if !ok {
+		return fallback
+	}
+	return color.RGBA{R: r, G: g, B: b, A: 255}
+}
+
+// scaleImage enlarges src by an integer factor with nearest-neighbor
+// sampling, which keeps bitmap glyphs sharp.
+func scaleImage(src *image.RGBA, factor int) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx()*factor, bounds.Dy()*factor))
+	for y := 0; y < bounds.Dy(); y++ {
+		srcRow := src.Pix[y*src.Stride : y*src.Stride+bounds.Dx()*4]
+		dstRow := dst.Pix[y*factor*dst.Stride : y*factor*dst.Stride+dst.Stride]
+		for x := 0; x < bounds.Dx(); x++ {
+			px := srcRow[x*4 : x*4+4]
+			for i := 0; i < factor; i++ {
+				copy(dstRow[(x*factor+i)*4:], px)
+			}
+		}
+		for i := 1; i < factor; i++ {
+			copy(dst.Pix[(y*factor+i)*dst.Stride:], dstRow)
+		}
+	}
+	return dst
+}
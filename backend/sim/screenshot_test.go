@@ -0,0 +1,108 @@
+package sim
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+)
+
+func screenshotBackend(t *testing.T) *Backend {
+	t.Helper()
+	be := New(4, 2)
+	if err := be.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	t.Cleanup(be.Fini)
+	be.Resize(4, 2)
+	be.SetContent(0, 0, 'A', nil, backend.DefaultStyle().Foreground(backend.ColorWhite).Background(backend.ColorRed))
+	be.SetContent(1, 0, ' ', nil, backend.DefaultStyle().Background(backend.ColorRGB(10, 20, 30)))
+	be.SetContent(0, 1, 'B', nil, backend.DefaultStyle().Foreground(backend.ColorGreen).Reverse(true))
+	be.Show()
+	return be
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestScreenshot_CellColors(t *testing.T) {
+	be := screenshotBackend(t)
+	img, err := be.Screenshot(ScreenshotOptions{})
+	if err != nil {
+		t.Fatalf("Screenshot: %v", err)
+	}
+	if got := img.Bounds().Size(); got != image.Pt(4*7, 2*13) {
+		t.Fatalf("size = %v, want 28x26", got)
+	}
+	cases := []struct {
+		x, y int
+		want color.RGBA
+	}{
+		{0, 0, color.RGBA{R: 205, A: 255}},              // red background
+		{7, 0, color.RGBA{R: 10, G: 20, B: 30, A: 255}}, // RGB background
+		{0, 13, color.RGBA{G: 205, A: 255}},             // reversed green
+		{27, 25, color.RGBA{A: 255}},                    // default background
+	}
+	for _, tc := range cases {
+		if got := color.RGBAModel.Convert(img.At(tc.x, tc.y)); got != tc.want {
+			t.Errorf("pixel (%d,%d) = %v, want %v", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestScreenshot_PaddingAndScale(t *testing.T) {
+	be := screenshotBackend(t)
+	img, err := be.Screenshot(ScreenshotOptions{FontSize: 16, Padding: 2, Scale: 2})
+	if err != nil {
+		t.Fatalf("Screenshot: %v", err)
+	}
+	if got := img.Bounds().Size(); got != image.Pt((4*7+4)*2, (2*16+4)*2) {
+		t.Fatalf("size = %v", got)
+	}
+	if got := color.RGBAModel.Convert(img.At(3, 3)); got != (color.RGBA{A: 255}) {
+		t.Errorf("padding pixel = %v, want black", got)
+	}
+	if got := color.RGBAModel.Convert(img.At(4, 4)); got != (color.RGBA{R: 205, A: 255}) {
+		t.Errorf("first cell pixel = %v, want red", got)
+	}
+}
+
+func TestScreenshot_Deterministic(t *testing.T) {
+	first := encodePNG(t, mustScreenshot(t, screenshotBackend(t)))
+	second := encodePNG(t, mustScreenshot(t, screenshotBackend(t)))
+	if !bytes.Equal(first, second) {
+		t.Fatal("screenshots of the same screen differ")
+	}
+
+	changed := screenshotBackend(t)
+	changed.SetContent(3, 1, 'x', nil, backend.DefaultStyle())
+	changed.Show()
+	if bytes.Equal(first, encodePNG(t, mustScreenshot(t, changed))) {
+		t.Fatal("screenshot did not change with the screen")
+	}
+}
+
+func TestScreenshot_RejectsNegativeOptions(t *testing.T) {
+	be := screenshotBackend(t)
+	if _, err := be.Screenshot(ScreenshotOptions{Scale: -1}); err == nil {
+		t.Fatal("expected error for negative scale")
+	}
+}
+
+func mustScreenshot(t *testing.T, be *Backend) image.Image {
+	t.Helper()
+	img, err := be.Screenshot(ScreenshotOptions{})
+	if err != nil {
+		t.Fatalf("Screenshot: %v", err)
+	}
+	return img
+}
@@ -16,6 +16,7 @@ type Backend struct {
 	*tcell.Backend
 	screen tcellv2.SimulationScreen
 	mu     sync.Mutex
+	links  map[[2]int]string // hyperlink URLs by cell, which tcell does not expose
 }
 
 // New creates a new simulation backend with the given dimensions.
@@ -72,7 +73,7 @@ func (s *Backend) Capture() string {
 	for y := 0; y < h; y++ {
 		var line strings.Builder
 		for x := 0; x < w; x++ {
-			mainc, comb, _, _ := s.screen.GetContent(x, y)
+			mainc, comb, _, width := s.screen.GetContent(x, y)
 			if mainc == 0 {
 				mainc = ' '
 			}
@@ -80,6 +81,10 @@ func (s *Backend) Capture() string {
 			for _, c := range comb {
 				line.WriteRune(c)
 			}
+			if width == 2 {
+				// Skip the column covered by the wide rune.
+				x++
+			}
 		}
 		lines = append(lines, line.String())
 	}
@@ -87,6 +92,66 @@ func (s *Backend) Capture() string {
 	return strings.Join(lines, "\n")
 }
 
+// SetContent sets a cell and records its hyperlink URL.
+func (s *Backend) SetContent(x, y int, mainc rune, comb []rune, style backend.Style) {
+	s.recordLink(x, y, style)
+	s.Backend.SetContent(x, y, mainc, comb, style)
+}
+
+// SetRow updates a row and records hyperlink URLs.
+func (s *Backend) SetRow(y int, startX int, cells []backend.Cell) {
+	if startX < 0 || len(cells) == 0 {
+		return
+	}
+	for i, cell := range cells {
+		s.recordLink(startX+i, y, cell.Style)
+	}
+	s.Backend.SetRow(y, startX, cells)
+}
+
+// SetRect updates a rectangle and records hyperlink URLs.
+func (s *Backend) SetRect(x, y, width, height int, cells []backend.Cell) {
+	if width <= 0 || height <= 0 || len(cells) < width*height {
+		return
+	}
+	for row := 0; row < height; row++ {
+		rowStart := row * width
+		s.SetRow(y+row, x, cells[rowStart:rowStart+width])
+	}
+}
+
+// Clear clears the screen and recorded hyperlinks.
+func (s *Backend) Clear() {
+	s.mu.Lock()
+	s.links = nil
+	s.mu.Unlock()
+	s.Backend.Clear()
+}
+
+func (s *Backend) recordLink(x, y int, style backend.Style) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	url := style.HyperlinkURL()
+	if url == "" {
+		delete(s.links, [2]int{x, y})
+		return
+	}
+	if s.links == nil {
+		s.links = make(map[[2]int]string)
+	}
+	s.links[[2]int{x, y}] = url
+}
+
+// CellAt returns the cell at (x, y), including its hyperlink URL.
+func (s *Backend) CellAt(x, y int) backend.Cell {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mainc, _, tcStyle, _ := s.screen.GetContent(x, y)
+	style := convertTcellStyle(tcStyle).Hyperlink(s.links[[2]int{x, y}])
+	return backend.Cell{Rune: mainc, Style: style}
+}
+
 // CaptureCell returns the content and style of a single cell.
 func (s *Backend) CaptureCell(x, y int) (mainc rune, comb []rune, style backend.Style) {
 	s.mu.Lock()
@@ -96,6 +161,27 @@ func (s *Backend) CaptureCell(x, y int) (mainc rune, comb []rune, style backend.
 	return m, c, convertTcellStyle(tcStyle)
 }
 
+// Cells returns a copy of the screen cells in row-major order.
+func (s *Backend) Cells() (cells []backend.Cell, width, height int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	width, height = s.screen.Size()
+	cells = make([]backend.Cell, 0, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			mainc, _, tcStyle, cellWidth := s.screen.GetContent(x, y)
+			style := convertTcellStyle(tcStyle)
+			cells = append(cells, backend.Cell{Rune: mainc, Style: style})
+			if cellWidth == 2 && x+1 < width {
+				cells = append(cells, backend.Cell{Rune: backend.CellContinuation, Style: style})
+				x++
+			}
+		}
+	}
+	return cells, width, height
+}
+
 // CaptureRegion captures a rectangular region of the screen.
 func (s *Backend) CaptureRegion(x, y, w, h int) string {
 	s.mu.Lock()
@@ -105,11 +191,14 @@ func (s *Backend) CaptureRegion(x, y, w, h int) string {
 	for row := y; row < y+h; row++ {
 		var line strings.Builder
 		for col := x; col < x+w; col++ {
-			mainc, _, _, _ := s.screen.GetContent(col, row)
+			mainc, _, _, width := s.screen.GetContent(col, row)
 			if mainc == 0 {
 				mainc = ' '
 			}
 			line.WriteRune(mainc)
+			if width == 2 {
+				col++
+			}
 		}
 		lines = append(lines, line.String())
 	}
@@ -43,6 +43,31 @@ func TestBackend_BasicRendering(t *testing.T) {
 	}
 }
 
+func TestBackend_WideCells(t *testing.T) {
+	sim := New(6, 1)
+	if err := sim.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer sim.Fini()
+	sim.Resize(6, 1)
+
+	style := backend.DefaultStyle()
+	cont := backend.Cell{Rune: backend.CellContinuation, Style: style}
+	sim.SetRow(0, 0, []backend.Cell{
+		{Rune: 'a', Style: style}, {Rune: '日', Style: style}, cont,
+		{Rune: '本', Style: style}, cont, {Rune: 'b', Style: style},
+	})
+	sim.Show()
+
+	if got := sim.Capture(); got != "a日本b" {
+		t.Fatalf("Capture() = %q, want %q", got, "a日本b")
+	}
+	cells, _, _ := sim.Cells()
+	if cells[2].Rune != backend.CellContinuation {
+		t.Fatalf("Cells()[2] = %q, want a continuation", cells[2].Rune)
+	}
+}
+
 func TestBackend_Size(t *testing.T) {
 	sim := New(80, 24)
 	if err := sim.Init(); err != nil {
@@ -154,38 +179,78 @@ func TestBackend_InjectKey(t *testing.T) {
 	}
 	defer sim.Fini()
 
-	// Inject a key event
 	sim.InjectKeyRune('a')
+	keyEv := pollKey(t, sim)
+	if keyEv.Key != terminal.KeyRune || keyEv.Rune != 'a' {
+		t.Errorf("Expected KeyRune 'a', got key=%v rune=%c", keyEv.Key, keyEv.Rune)
+	}
 
-	// Use a goroutine with timeout to avoid blocking forever
-	done := make(chan struct{})
-	var ev terminal.Event
+	sim.InjectKey(terminal.KeyEnter, 0)
+	if keyEv := pollKey(t, sim); keyEv.Key != terminal.KeyEnter {
+		t.Errorf("Expected KeyEnter, got key=%v", keyEv.Key)
+	}
+}
 
-	go func() {
-		ev = sim.PollEvent()
-		close(done)
-	}()
+func TestBackend_PostMouseEvent(t *testing.T) {
+	sim := New(20, 10)
+	if err := sim.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer sim.Fini()
 
-	select {
-	case <-done:
-		// Got event
-	case <-time.After(100 * time.Millisecond):
-		t.Skip("PollEvent blocked - tcell simulation may not support this")
-		return
+	events := make(chan terminal.Event, 1)
+	poll := func() terminal.MouseEvent {
+		t.Helper()
+		go func() {
+			events <- sim.PollEvent()
+		}()
+		select {
+		case ev := <-events:
+			mouseEv, ok := ev.(terminal.MouseEvent)
+			if !ok {
+				t.Fatalf("Expected terminal.MouseEvent, got %T", ev)
+			}
+			return mouseEv
+		case <-time.After(time.Second):
+			t.Fatal("posted mouse event was not delivered")
+			return terminal.MouseEvent{}
+		}
 	}
 
-	if ev == nil {
-		t.Skip("No event received - may be tcell simulation limitation")
-		return
+	if err := sim.PostEvent(terminal.MouseEvent{X: 3, Y: 4, Button: terminal.MouseRight, Action: terminal.MousePress}); err != nil {
+		t.Fatalf("PostEvent press: %v", err)
+	}
+	press := poll()
+	if press.X != 3 || press.Y != 4 || press.Button != terminal.MouseRight || press.Action != terminal.MousePress {
+		t.Errorf("press = %+v", press)
 	}
 
-	keyEv, ok := ev.(terminal.KeyEvent)
-	if !ok {
-		t.Fatalf("Expected terminal.KeyEvent, got %T", ev)
+	if err := sim.PostEvent(terminal.MouseEvent{X: 3, Y: 4, Button: terminal.MouseRight, Action: terminal.MouseRelease}); err != nil {
+		t.Fatalf("PostEvent release: %v", err)
 	}
+	if release := poll(); release.Action != terminal.MouseRelease {
+		t.Errorf("release action = %v, want MouseRelease", release.Action)
+	}
+}
 
-	if keyEv.Key != terminal.KeyRune || keyEv.Rune != 'a' {
-		t.Errorf("Expected KeyRune 'a', got key=%v rune=%c", keyEv.Key, keyEv.Rune)
+// pollKey waits briefly for the next event and requires it to be a key.
+func pollKey(t *testing.T, sim *Backend) terminal.KeyEvent {
+	t.Helper()
+	events := make(chan terminal.Event, 1)
+	go func() {
+		events <- sim.PollEvent()
+	}()
+
+	select {
+	case ev := <-events:
+		keyEv, ok := ev.(terminal.KeyEvent)
+		if !ok {
+			t.Fatalf("Expected terminal.KeyEvent, got %T", ev)
+		}
+		return keyEv
+	case <-time.After(time.Second):
+		t.Fatal("injected key was not delivered")
+		return terminal.KeyEvent{}
 	}
 }
 
@@ -217,3 +282,26 @@ func TestBackend_Styles(t *testing.T) {
 		t.Error("Expected bold attribute to be set")
 	}
 }
+
+func TestBackend_CellAtHyperlink(t *testing.T) {
+	sim := New(10, 1)
+	if err := sim.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer sim.Fini()
+
+	link := backend.DefaultStyle().Underline(true).Hyperlink("https://example.com")
+	sim.SetContent(0, 0, 'A', nil, link)
+	sim.SetRow(0, 1, []backend.Cell{{Rune: 'B', Style: link}, {Rune: 'C', Style: backend.DefaultStyle()}})
+	sim.Show()
+
+	for x, want := range []string{"https://example.com", "https://example.com", ""} {
+		if got := sim.CellAt(x, 0).Style.HyperlinkURL(); got != want {
+			t.Errorf("CellAt(%d, 0) url = %q, want %q", x, got, want)
+		}
+	}
+	sim.SetContent(0, 0, 'A', nil, backend.DefaultStyle())
+	if got := sim.CellAt(0, 0).Style.HyperlinkURL(); got != "" {
+		t.Errorf("url after overwrite = %q, want empty", got)
+	}
+}
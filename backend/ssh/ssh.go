@@ -0,0 +1,176 @@
+// Package ssh serves FluffyUI apps over SSH. Each session with a PTY gets
+// its own Backend, sized to the client's terminal, and its own App built by
+// Config.AppFactory.
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	gliderssh "github.com/gliderlabs/ssh"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/backend/internal/streamtty"
+	fluffytcell "github.com/odvcencio/fluffy-ui/backend/tcell"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// ErrNoPTY is reported to clients that connect without requesting a PTY.
+var ErrNoPTY = errors.New("ssh: a PTY is required")
+
+// Session is an SSH session with the Backend its App should render to.
+type Session interface {
+	gliderssh.Session
+
+	// Backend returns the session's terminal backend.
+	Backend() backend.Backend
+}
+
+// Config configures a Server.
+type Config struct {
+	// Addr is the listen address for ListenAndServe, such as ":2222".
+	Addr string
+	// AppFactory builds the App for a session; it should use
+	// Session.Backend as AppConfig.Backend. Returning an error ends the
+	// session with exit status 1.
+	AppFactory func(sess Session) (*runtime.App, error)
+	// HostSigners are the server host keys. When empty, a key is generated
+	// at startup.
+	HostSigners []gliderssh.Signer
+	// Options are applied to the underlying gliderlabs server, for example
+	// to install password or public key authentication.
+	Options []gliderssh.Option
+}
+
+// Server accepts SSH connections and runs an App per session.
+type Server struct {
+	cfg Config
+	srv *gliderssh.Server
+}
+
+// NewServer creates a server from cfg.
+func NewServer(cfg Config) *Server {
+	s := &Server{cfg: cfg}
+	s.srv = &gliderssh.Server{
+		Addr:    cfg.Addr,
+		Handler: s.handle,
+	}
+	for _, signer := range cfg.HostSigners {
+		s.srv.AddHostKey(signer)
+	}
+	for _, opt := range cfg.Options {
+		_ = s.srv.SetOption(opt)
+	}
+	return s
+}
+
+// ListenAndServe listens on Config.Addr and serves sessions until Close.
+func (s *Server) ListenAndServe() error {
+	return s.srv.ListenAndServe()
+}
+
+// Serve serves sessions accepted on l until Close.
+func (s *Server) Serve(l net.Listener) error {
+	return s.srv.Serve(l)
+}
+
+// Shutdown stops accepting connections and waits for sessions to end or
+// ctx to expire.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
+
+// Close stops the server and drops every connection.
+func (s *Server) Close() error {
+	return s.srv.Close()
+}
+
+// handle runs one session's App until it exits or the connection drops.
+func (s *Server) handle(sess gliderssh.Session) {
+	pty, resizes, ok := sess.Pty()
+	if !ok {
+		fmt.Fprintln(sess.Stderr(), ErrNoPTY)
+		_ = sess.Exit(1)
+		return
+	}
+	// The session context ends when the connection drops; the cancel also
+	// covers a client that closes just this session's input.
+	ctx, cancel := context.WithCancel(sess.Context())
+	defer cancel()
+	tty := streamtty.New(sess, pty.Window.Width, pty.Window.Height)
+	defer tty.CloseInput(nil)
+	go pumpInput(sess, tty, cancel)
+	go func() {
+		// Window-change requests take the place of SIGWINCH.
+		for window := range resizes {
+			tty.Resize(window.Width, window.Height)
+		}
+	}()
+	b, err := fluffytcell.NewWithTty(tty, pty.Term)
+	if err == nil {
+		b.SetHyperlinks(sessionCapabilities(sess, pty.Term).Hyperlinks)
+	}
+	if err == nil && s.cfg.AppFactory == nil {
+		err = errors.New("ssh: Config.AppFactory is required")
+	}
+	var app *runtime.App
+	if err == nil {
+		app, err = s.cfg.AppFactory(&session{Session: sess, backend: b})
+	}
+	if err != nil {
+		fmt.Fprintln(sess.Stderr(), err)
+		_ = sess.Exit(1)
+		return
+	}
+	err = app.Run(ctx)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintln(sess.Stderr(), err)
+		_ = sess.Exit(1)
+		return
+	}
+	_ = sess.Exit(0)
+}
+
+type session struct {
+	gliderssh.Session
+	backend backend.Backend
+}
+
+func (s *session) Backend() backend.Backend {
+	return s.backend
+}
+
+// sessionCapabilities detects the client terminal's features from the
+// environment it sent, which only includes variables the server accepts,
+// and the PTY's terminal type.
+func sessionCapabilities(sess gliderssh.Session, term string) terminal.Capabilities {
+	env := make(map[string]string)
+	for _, kv := range sess.Environ() {
+		if key, value, ok := strings.Cut(kv, "="); ok {
+			env[key] = value
+		}
+	}
+	env["TERM"] = term
+	return terminal.DetectFrom(func(key string) string { return env[key] })
+}
+
+// pumpInput feeds session input to tty and hangs up when the client closes
+// its input.
+func pumpInput(sess gliderssh.Session, tty *streamtty.Tty, hangup func()) {
+	buf := make([]byte, 128)
+	for {
+		n, err := sess.Read(buf)
+		if n > 0 && !tty.Feed(buf[:n]) {
+			return
+		}
+		if err != nil {
+			tty.CloseInput(err)
+			hangup()
+			return
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package ssh
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gliderssh "github.com/gliderlabs/ssh"
+	gossh "golang.org/x/crypto/ssh"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// sizeLabel draws the session user and its own size.
+type sizeLabel struct {
+	user string
+
+	mu     sync.Mutex
+	bounds runtime.Rect
+}
+
+func (l *sizeLabel) Measure(c runtime.Constraints) runtime.Size {
+	return c.Constrain(runtime.Size{Width: c.MaxWidth, Height: c.MaxHeight})
+}
+
+func (l *sizeLabel) Layout(bounds runtime.Rect) {
+	l.mu.Lock()
+	l.bounds = bounds
+	l.mu.Unlock()
+}
+
+func (l *sizeLabel) size() (width, height int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.bounds.Width, l.bounds.Height
+}
+
+func (l *sizeLabel) Render(ctx runtime.RenderContext) {
+	width, height := l.size()
+	text := fmt.Sprintf("%s:%dx%d", l.user, width, height)
+	ctx.Buffer.SetString(0, 0, text, backend.DefaultStyle())
+}
+
+func (l *sizeLabel) HandleMessage(runtime.Message) runtime.HandleResult {
+	return runtime.Unhandled()
+}
+
+// exitPlugin reports when a session's app stops running.
+type exitPlugin struct {
+	user  string
+	ended chan<- string
+}
+
+func (p *exitPlugin) Name() string            { return "exit" }
+func (p *exitPlugin) Init(*runtime.App) error { return nil }
+func (p *exitPlugin) Shutdown() error {
+	p.ended <- p.user
+	return nil
+}
+
+// syncBuffer collects client output from the SSH library's copy goroutine.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func (b *syncBuffer) waitFor(t *testing.T, text string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(b.String(), text) {
+		if time.Now().After(deadline) {
+			t.Fatalf("output never contained %q; got %q", text, b.String())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func dialSession(t *testing.T, addr, user string, width, height int) (*gossh.Client, *gossh.Session, *syncBuffer) {
+	t.Helper()
+	client, err := gossh.Dial("tcp", addr, &gossh.ClientConfig{
+		User:            user,
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	sess, err := client.NewSession()
+	if err != nil {
+		t.Fatalf("new session: %v", err)
+	}
+	out := &syncBuffer{}
+	sess.Stdout = out
+	// Keep input open like an interactive client; EOF on input hangs up.
+	stdin, stdinW := io.Pipe()
+	t.Cleanup(func() { _ = stdinW.Close() })
+	sess.Stdin = stdin
+	if err := sess.RequestPty("xterm", height, width, gossh.TerminalModes{}); err != nil {
+		t.Fatalf("request pty: %v", err)
+	}
+	if err := sess.Shell(); err != nil {
+		t.Fatalf("shell: %v", err)
+	}
+	return client, sess, out
+}
+
+func TestServer_IndependentSessions(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	ended := make(chan string, 2)
+	var mu sync.Mutex
+	labels := map[string]*sizeLabel{}
+	srv := NewServer(Config{
+		AppFactory: func(sess Session) (*runtime.App, error) {
+			label := &sizeLabel{user: sess.User()}
+			mu.Lock()
+			labels[sess.User()] = label
+			mu.Unlock()
+			return runtime.NewApp(runtime.AppConfig{
+				Backend: sess.Backend(),
+				Root:    label,
+				Plugins: []runtime.PluginFactory{func(*runtime.App) runtime.Plugin {
+					return &exitPlugin{user: sess.User(), ended: ended}
+				}},
+			}), nil
+		},
+	})
+	go func() { _ = srv.Serve(listener) }()
+	defer srv.Close()
+	addr := listener.Addr().String()
+
+	aliceConn, _, aliceOut := dialSession(t, addr, "alice", 40, 10)
+	_, bob, bobOut := dialSession(t, addr, "bob", 60, 20)
+
+	aliceOut.waitFor(t, "alice:40x10")
+	bobOut.waitFor(t, "bob:60x20")
+	if strings.Contains(aliceOut.String(), "bob") || strings.Contains(bobOut.String(), "alice") {
+		t.Fatal("sessions rendered each other's apps")
+	}
+
+	if err := bob.WindowChange(24, 80); err != nil {
+		t.Fatalf("window change: %v", err)
+	}
+	mu.Lock()
+	aliceLabel, bobLabel := labels["alice"], labels["bob"]
+	mu.Unlock()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if w, h := bobLabel.size(); w == 80 && h == 24 {
+			break
+		}
+		if time.Now().After(deadline) {
+			w, h := bobLabel.size()
+			t.Fatalf("bob size = %dx%d after resize, want 80x24", w, h)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if w, h := aliceLabel.size(); w != 40 || h != 10 {
+		t.Fatalf("alice size = %dx%d, want 40x10 unchanged", w, h)
+	}
+
+	// Dropping alice's connection ends only her session.
+	_ = aliceConn.Close()
+	select {
+	case user := <-ended:
+		if user != "alice" {
+			t.Fatalf("ended session = %q, want alice", user)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("session did not end after the connection dropped")
+	}
+
+	_ = bob.Close()
+	select {
+	case user := <-ended:
+		if user != "bob" {
+			t.Fatalf("ended session = %q, want bob", user)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("session did not end after the client closed it")
+	}
+}
+
+type envSession struct {
+	gliderssh.Session
+	env []string
+}
+
+func (s envSession) Environ() []string {
+	return s.env
+}
+
+func TestSessionCapabilities_UsesClientEnvironment(t *testing.T) {
+	caps := sessionCapabilities(envSession{env: []string{"TERM_PROGRAM=WezTerm"}}, "xterm-256color")
+	if !caps.Hyperlinks || !caps.Color256 {
+		t.Fatalf("caps = %+v, want hyperlinks and 256 colors", caps)
+	}
+	if caps := sessionCapabilities(envSession{}, "xterm-256color"); caps.Hyperlinks {
+		t.Fatal("expected no hyperlinks without a client hint")
+	}
+}
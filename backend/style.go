@@ -1,5 +1,11 @@
 package backend
 
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
 // Color represents a terminal color.
 // Values 0-255 are palette colors, values >= 256 are true colors.
 type Color int32
@@ -65,6 +71,7 @@ type Style struct {
 	fg    Color
 	bg    Color
 	attrs AttrMask
+	url   string
 }
 
 // DefaultStyle returns the default style (default colors, no attributes).
@@ -72,14 +79,51 @@ func DefaultStyle() Style {
 	return Style{fg: ColorDefault, bg: ColorDefault}
 }
 
+// noColor forces colour output off regardless of the environment.
+var noColor atomic.Bool
+
+// envNoColor reports whether NO_COLOR was set when first checked. Styles are
+// built on the render path, so the environment is read only once.
+var envNoColor = sync.OnceValue(func() bool {
+	return os.Getenv("NO_COLOR") != ""
+})
+
+// SetNoColor disables colour output when true, in addition to NO_COLOR.
+func SetNoColor(disabled bool) {
+	noColor.Store(disabled)
+}
+
+// IsColorEnabled reports whether styles may carry colours. Colour is
+// disabled when NO_COLOR is set to any non-empty value (see no-color.org)
+// or by SetNoColor. NO_COLOR is read once, on the first call.
+func IsColorEnabled() bool {
+	return !noColor.Load() && !envNoColor()
+}
+
+// WithoutColor returns the style with default colours, keeping attributes
+// and hyperlinks.
+func (s Style) WithoutColor() Style {
+	s.fg = ColorDefault
+	s.bg = ColorDefault
+	return s
+}
+
 // Foreground sets the foreground color.
+// The style is returned unchanged when colour is disabled.
 func (s Style) Foreground(c Color) Style {
+	if !IsColorEnabled() {
+		return s
+	}
 	s.fg = c
 	return s
 }
 
 // Background sets the background color.
+// The style is returned unchanged when colour is disabled.
 func (s Style) Background(c Color) Style {
+	if !IsColorEnabled() {
+		return s
+	}
 	s.bg = c
 	return s
 }
@@ -154,6 +198,19 @@ func (s Style) StrikeThrough(on bool) Style {
 	return s
 }
 
+// Hyperlink makes the styled text a link to url using OSC 8 escape
+// sequences. Terminals without hyperlink support show plain text.
+// An empty url removes the link.
+func (s Style) Hyperlink(url string) Style {
+	s.url = url
+	return s
+}
+
+// HyperlinkURL returns the link target, or "" when the style has no link.
+func (s Style) HyperlinkURL() string {
+	return s.url
+}
+
 // Attributes returns all attributes.
 func (s Style) Attributes() AttrMask {
 	return s.attrs
@@ -0,0 +1,61 @@
+package backend
+
+import (
+	"os"
+	"sync"
+	"testing"
+)
+
+// setEnvNoColor replaces the cached NO_COLOR lookup for one test.
+func setEnvNoColor(t *testing.T, value string) {
+	t.Helper()
+	t.Setenv("NO_COLOR", value)
+	saved := envNoColor
+	envNoColor = sync.OnceValue(func() bool { return os.Getenv("NO_COLOR") != "" })
+	t.Cleanup(func() { envNoColor = saved })
+}
+
+func TestNoColorEnv(t *testing.T) {
+	setEnvNoColor(t, "1")
+	if IsColorEnabled() {
+		t.Fatal("IsColorEnabled() = true with NO_COLOR set")
+	}
+	style := DefaultStyle().Foreground(ColorRed).Background(ColorBlue).Bold(true)
+	if style.FG() != ColorDefault || style.BG() != ColorDefault {
+		t.Fatalf("colors = %v/%v, want defaults", style.FG(), style.BG())
+	}
+	if style.Attributes()&AttrBold == 0 {
+		t.Fatal("attributes should still apply without colour")
+	}
+}
+
+func TestSetNoColor(t *testing.T) {
+	setEnvNoColor(t, "")
+	if !IsColorEnabled() {
+		t.Fatal("IsColorEnabled() = false with NO_COLOR empty")
+	}
+	if got := DefaultStyle().Foreground(ColorRed).FG(); got != ColorRed {
+		t.Fatalf("FG = %v, want red", got)
+	}
+
+	SetNoColor(true)
+	defer SetNoColor(false)
+	if got := DefaultStyle().Foreground(ColorRed).FG(); got != ColorDefault {
+		t.Fatalf("FG = %v, want default with SetNoColor", got)
+	}
+}
+
+func TestColorResolve(t *testing.T) {
+	if _, _, _, ok := ColorDefault.Resolve(); ok {
+		t.Fatal("ColorDefault should not resolve")
+	}
+	if r, g, b, ok := ColorRGB(1, 2, 3).Resolve(); !ok || r != 1 || g != 2 || b != 3 {
+		t.Fatalf("RGB resolve = %d,%d,%d,%v", r, g, b, ok)
+	}
+	if r, g, b, ok := Color(196).Resolve(); !ok || r != 255 || g != 0 || b != 0 {
+		t.Fatalf("Color(196) resolve = %d,%d,%d,%v", r, g, b, ok)
+	}
+	if r, _, _, ok := Color(232).Resolve(); !ok || r != 8 {
+		t.Fatalf("Color(232) resolve = %d,%v", r, ok)
+	}
+}
@@ -0,0 +1,136 @@
+package tcell
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// colorSchemeTimeout bounds how long Init waits for the terminal to answer
+// the colour scheme query.
+const colorSchemeTimeout = 100 * time.Millisecond
+
+// PrefersHighContrast reports whether the terminal's default colours are
+// pure white on black or black on white. Backends from New read
+// $COLORFGBG, falling back to an OSC 10/11 query on the controlling
+// terminal. Call it before Init so the terminal's reply is not read as
+// input.
+func (b *Backend) PrefersHighContrast() bool {
+	if b == nil || !b.detectScheme {
+		return false
+	}
+	if scheme, ok := colorSchemeFromEnv(os.Getenv); ok {
+		return scheme.HighContrast()
+	}
+	scheme, ok := terminal.ParseColorScheme(b.terminalReply())
+	return ok && scheme.HighContrast()
+}
+
+// DeviceAttributes returns the terminal's primary device attributes reply,
+// which backends from New request from the controlling terminal during
+// Init. It is empty before Init or when the terminal did not answer.
+func (b *Backend) DeviceAttributes() string {
+	if b == nil || !b.queried {
+		return ""
+	}
+	return b.reply
+}
+
+// terminalReply sends terminal.ColorSchemeQuery to the controlling terminal
+// the first time it is called and returns the raw reply.
+func (b *Backend) terminalReply() string {
+	if b.queried {
+		return b.reply
+	}
+	b.queried = true
+	tty, err := tcell.NewDevTty()
+	if err != nil {
+		return ""
+	}
+	b.reply, _ = queryTerminal(tty, colorSchemeTimeout)
+	return b.reply
+}
+
+// colorSchemeFromEnv reads the scheme from $COLORFGBG, which terminals such
+// as rxvt and Konsole set to "fg;bg" or "fg;default;bg" using ANSI colour
+// indices. ok is false when the variable is missing or uses "default".
+func colorSchemeFromEnv(getenv func(string) string) (scheme terminal.ColorScheme, ok bool) {
+	parts := strings.Split(getenv("COLORFGBG"), ";")
+	if len(parts) < 2 {
+		return terminal.ColorScheme{}, false
+	}
+	fg, ok := ansiRGB(parts[0])
+	if !ok {
+		return terminal.ColorScheme{}, false
+	}
+	bg, ok := ansiRGB(parts[len(parts)-1])
+	if !ok {
+		return terminal.ColorScheme{}, false
+	}
+	return terminal.ColorScheme{Foreground: fg, Background: bg}, true
+}
+
+// ansiRGB resolves an ANSI colour index from 0 to 15.
+func ansiRGB(index string) (terminal.RGB, bool) {
+	n, err := strconv.Atoi(index)
+	if err != nil || n < 0 || n > 15 {
+		return terminal.RGB{}, false
+	}
+	r, g, b, _ := backend.Color(n).Resolve()
+	return terminal.RGB{R: r, G: g, B: b}, true
+}
+
+// queryColorScheme writes terminal.ColorSchemeQuery to tty and parses the
+// reply, giving up after timeout.
+func queryColorScheme(tty tcell.Tty, timeout time.Duration) (terminal.ColorScheme, bool) {
+	response, ok := queryTerminal(tty, timeout)
+	if !ok {
+		return terminal.ColorScheme{}, false
+	}
+	return terminal.ParseColorScheme(response)
+}
+
+// queryTerminal writes terminal.ColorSchemeQuery to tty and returns whatever
+// the terminal replied within timeout.
+func queryTerminal(tty tcell.Tty, timeout time.Duration) (string, bool) {
+	if err := tty.Start(); err != nil {
+		return "", false
+	}
+	defer func() {
+		_ = tty.Stop()
+	}()
+	if _, err := tty.Write([]byte(terminal.ColorSchemeQuery)); err != nil {
+		return "", false
+	}
+	replies := make(chan string, 1)
+	go func() {
+		var response strings.Builder
+		buf := make([]byte, 256)
+		for {
+			n, err := tty.Read(buf)
+			response.Write(buf[:n])
+			// The device attributes reply ends in 'c' and follows the
+			// colour replies.
+			if strings.HasSuffix(response.String(), "c") && strings.Contains(response.String(), "\x1b[?") {
+				break
+			}
+			if err != nil {
+				break
+			}
+		}
+		replies <- response.String()
+	}()
+	var response string
+	select {
+	case response = <-replies:
+	case <-time.After(timeout):
+		_ = tty.Drain()
+		response = <-replies
+	}
+	return response, true
+}
@@ -19,6 +19,13 @@ type Backend struct {
 
 	styleCache    map[backend.Style]tcell.Style
 	styleCacheCap int
+	hyperlinks    bool
+
+	// detectScheme is set for backends from New, which may query the
+	// controlling terminal; the reply is kept for later lookups.
+	detectScheme bool
+	queried      bool
+	reply        string
 }
 
 // New creates a new tcell backend.
@@ -27,6 +34,28 @@ func New() (*Backend, error) {
 	if err != nil {
 		return nil, err
 	}
+	return &Backend{screen: screen, hyperlinks: terminal.Detect().Hyperlinks, detectScheme: true}, nil
+}
+
+// DefaultTerm is the terminfo entry NewWithTty falls back to.
+const DefaultTerm = "xterm-256color"
+
+// NewWithTty creates a backend drawing to tty, such as a remote session,
+// using the terminfo entry for term or DefaultTerm when term is unknown.
+// Hyperlinks start disabled because the local environment says nothing
+// about the remote terminal; call SetHyperlinks once its support is known.
+func NewWithTty(tty tcell.Tty, term string) (*Backend, error) {
+	ti, err := tcell.LookupTerminfo(term)
+	if err != nil {
+		ti, err = tcell.LookupTerminfo(DefaultTerm)
+		if err != nil {
+			return nil, err
+		}
+	}
+	screen, err := tcell.NewTerminfoScreenFromTtyTerminfo(tty, ti)
+	if err != nil {
+		return nil, err
+	}
 	return &Backend{screen: screen}, nil
 }
 
@@ -35,8 +64,23 @@ func NewWithScreen(screen tcell.Screen) *Backend {
 	return &Backend{screen: screen}
 }
 
+// SetHyperlinks enables or disables OSC 8 output for styles with a
+// hyperlink. New enables it when the terminal is detected to support it.
+func (b *Backend) SetHyperlinks(enabled bool) {
+	if b.hyperlinks == enabled {
+		return
+	}
+	b.hyperlinks = enabled
+	b.styleCache = nil
+}
+
 // Init initializes the backend.
 func (b *Backend) Init() error {
+	// Query before the screen starts reading input, so the reply is not
+	// delivered as key events.
+	if b.detectScheme {
+		b.terminalReply()
+	}
 	if err := b.screen.Init(); err != nil {
 		return err
 	}
@@ -55,8 +99,12 @@ func (b *Backend) Size() (width, height int) {
 	return b.screen.Size()
 }
 
-// SetContent sets a cell at position (x, y).
+// SetContent sets a cell at position (x, y). Continuation cells are skipped;
+// tcell draws a wide rune across both columns.
 func (b *Backend) SetContent(x, y int, mainc rune, comb []rune, style backend.Style) {
+	if mainc == backend.CellContinuation {
+		return
+	}
 	b.screen.SetContent(x, y, mainc, comb, b.cachedStyle(style))
 }
 
@@ -67,7 +115,9 @@ func (b *Backend) SetRow(y int, startX int, cells []backend.Cell) {
 	}
 	x := startX
 	for _, cell := range cells {
-		b.screen.SetContent(x, y, cell.Rune, nil, b.cachedStyle(cell.Style))
+		if cell.Rune != backend.CellContinuation {
+			b.screen.SetContent(x, y, cell.Rune, nil, b.cachedStyle(cell.Style))
+		}
 		x++
 	}
 }
@@ -195,6 +245,9 @@ func (b *Backend) cachedStyle(s backend.Style) tcell.Style {
 		b.styleCache = make(map[backend.Style]tcell.Style, b.styleCacheCap)
 	}
 	style := convertStyle(s)
+	if url := s.HyperlinkURL(); url != "" && b.hyperlinks {
+		style = style.Url(url)
+	}
 	b.styleCache[s] = style
 	return style
 }
@@ -307,6 +360,8 @@ func convertKey(k tcell.Key) terminal.Key {
 		return terminal.KeyEnter
 	case tcell.KeyEscape:
 		return terminal.KeyEscape
+	case tcell.KeyCtrlA:
+		return terminal.KeyCtrlA
 	case tcell.KeyCtrlB:
 		return terminal.KeyCtrlB
 	case tcell.KeyCtrlC:
@@ -321,6 +376,8 @@ func convertKey(k tcell.Key) terminal.Key {
 		return terminal.KeyCtrlV
 	case tcell.KeyCtrlX:
 		return terminal.KeyCtrlX
+	case tcell.KeyCtrlY:
+		return terminal.KeyCtrlY
 	case tcell.KeyCtrlZ:
 		return terminal.KeyCtrlZ
 	case tcell.KeyF1:
@@ -386,10 +443,97 @@ func reverseConvertEvent(ev terminal.Event) tcell.Event {
 	switch e := ev.(type) {
 	case terminal.ResizeEvent:
 		return tcell.NewEventResize(e.Width, e.Height)
+	case terminal.KeyEvent:
+		key := reverseConvertKey(e.Key)
+		if key == tcell.KeyNUL && e.Key != terminal.KeyRune {
+			return nil
+		}
+		return tcell.NewEventKey(key, e.Rune, reverseConvertModifiers(e.Alt, e.Ctrl, e.Shift))
+	case terminal.MouseEvent:
+		return tcell.NewEventMouse(e.X, e.Y, reverseConvertMouseButton(e), reverseConvertModifiers(e.Alt, e.Ctrl, e.Shift))
 	default:
 		return nil
 	}
 }
 
+var reverseKeyMap = map[terminal.Key]tcell.Key{
+	terminal.KeyRune:      tcell.KeyRune,
+	terminal.KeyUp:        tcell.KeyUp,
+	terminal.KeyDown:      tcell.KeyDown,
+	terminal.KeyRight:     tcell.KeyRight,
+	terminal.KeyLeft:      tcell.KeyLeft,
+	terminal.KeyPageUp:    tcell.KeyPgUp,
+	terminal.KeyPageDown:  tcell.KeyPgDn,
+	terminal.KeyHome:      tcell.KeyHome,
+	terminal.KeyEnd:       tcell.KeyEnd,
+	terminal.KeyInsert:    tcell.KeyInsert,
+	terminal.KeyDelete:    tcell.KeyDelete,
+	terminal.KeyBackspace: tcell.KeyBackspace2,
+	terminal.KeyTab:       tcell.KeyTab,
+	terminal.KeyEnter:     tcell.KeyEnter,
+	terminal.KeyEscape:    tcell.KeyEscape,
+	terminal.KeyCtrlA:     tcell.KeyCtrlA,
+	terminal.KeyCtrlB:     tcell.KeyCtrlB,
+	terminal.KeyCtrlC:     tcell.KeyCtrlC,
+	terminal.KeyCtrlD:     tcell.KeyCtrlD,
+	terminal.KeyCtrlF:     tcell.KeyCtrlF,
+	terminal.KeyCtrlP:     tcell.KeyCtrlP,
+	terminal.KeyCtrlV:     tcell.KeyCtrlV,
+	terminal.KeyCtrlX:     tcell.KeyCtrlX,
+	terminal.KeyCtrlY:     tcell.KeyCtrlY,
+	terminal.KeyCtrlZ:     tcell.KeyCtrlZ,
+	terminal.KeyF1:        tcell.KeyF1,
+	terminal.KeyF2:        tcell.KeyF2,
+	terminal.KeyF3:        tcell.KeyF3,
+	terminal.KeyF4:        tcell.KeyF4,
+	terminal.KeyF5:        tcell.KeyF5,
+	terminal.KeyF6:        tcell.KeyF6,
+	terminal.KeyF7:        tcell.KeyF7,
+	terminal.KeyF8:        tcell.KeyF8,
+	terminal.KeyF9:        tcell.KeyF9,
+	terminal.KeyF10:       tcell.KeyF10,
+	terminal.KeyF11:       tcell.KeyF11,
+	terminal.KeyF12:       tcell.KeyF12,
+}
+
+// reverseConvertKey converts terminal.Key to tcell.Key.
+func reverseConvertKey(k terminal.Key) tcell.Key {
+	return reverseKeyMap[k]
+}
+
+func reverseConvertModifiers(alt, ctrl, shift bool) tcell.ModMask {
+	var mods tcell.ModMask
+	if alt {
+		mods |= tcell.ModAlt
+	}
+	if ctrl {
+		mods |= tcell.ModCtrl
+	}
+	if shift {
+		mods |= tcell.ModShift
+	}
+	return mods
+}
+
+func reverseConvertMouseButton(e terminal.MouseEvent) tcell.ButtonMask {
+	if e.Action == terminal.MouseRelease {
+		return tcell.ButtonNone
+	}
+	switch e.Button {
+	case terminal.MouseLeft:
+		return tcell.Button1
+	case terminal.MouseMiddle:
+		return tcell.Button2
+	case terminal.MouseRight:
+		return tcell.Button3
+	case terminal.MouseWheelUp:
+		return tcell.WheelUp
+	case terminal.MouseWheelDown:
+		return tcell.WheelDown
+	default:
+		return tcell.ButtonNone
+	}
+}
+
 // Ensure Backend implements backend.Backend
 var _ backend.Backend = (*Backend)(nil)
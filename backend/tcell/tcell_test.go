@@ -0,0 +1,175 @@
+package tcell
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/gdamore/tcell/v2/terminfo"
+	"github.com/odvcencio/fluffy-ui/backend"
+)
+
+// fakeTty records terminal output and never produces input.
+type fakeTty struct {
+	mu   sync.Mutex
+	out  bytes.Buffer
+	done chan struct{}
+	once sync.Once
+}
+
+func newFakeTty() *fakeTty { return &fakeTty{done: make(chan struct{})} }
+
+func (f *fakeTty) Start() error           { return nil }
+func (f *fakeTty) Stop() error            { return nil }
+func (f *fakeTty) Drain() error           { f.once.Do(func() { close(f.done) }); return nil }
+func (f *fakeTty) NotifyResize(cb func()) {}
+func (f *fakeTty) WindowSize() (tcell.WindowSize, error) {
+	return tcell.WindowSize{Width: 10, Height: 2}, nil
+}
+func (f *fakeTty) Read(p []byte) (int, error) {
+	<-f.done
+	return 0, io.EOF
+}
+func (f *fakeTty) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.out.Write(p)
+}
+func (f *fakeTty) Close() error { return f.Drain() }
+
+func (f *fakeTty) output() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.out.String()
+}
+
+func newTestBackend(t *testing.T) (*Backend, *fakeTty) {
+	t.Helper()
+	ti, err := terminfo.LookupTerminfo("xterm-256color")
+	if err != nil {
+		t.Skipf("terminfo unavailable: %v", err)
+	}
+	tty := newFakeTty()
+	screen, err := tcell.NewTerminfoScreenFromTtyTerminfo(tty, ti)
+	if err != nil {
+		t.Fatalf("NewTerminfoScreen: %v", err)
+	}
+	b := NewWithScreen(screen)
+	if err := b.Init(); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	t.Cleanup(b.Fini)
+	return b, tty
+}
+
+func TestBackend_HyperlinkOSC8(t *testing.T) {
+	b, tty := newTestBackend(t)
+	b.SetHyperlinks(true)
+
+	b.SetContent(0, 0, 'A', nil, backend.DefaultStyle().Hyperlink("https://example.com"))
+	b.SetContent(1, 0, 'B', nil, backend.DefaultStyle())
+	b.Show()
+
+	// The link opens right before A and closes before B; tcell may reset
+	// attributes after A.
+	out := tty.output()
+	open := "\x1b]8;;https://example.com\x1b\\A"
+	start := strings.Index(out, open)
+	if start < 0 {
+		t.Fatalf("output %q does not open the link before A", out)
+	}
+	if !strings.Contains(out[start+len(open):], "\x1b]8;;\x1b\\B") {
+		t.Fatalf("output %q does not close the link after A", out)
+	}
+}
+
+func TestBackend_HyperlinkDisabled(t *testing.T) {
+	b, tty := newTestBackend(t)
+	b.SetHyperlinks(false)
+
+	b.SetContent(0, 0, 'A', nil, backend.DefaultStyle().Hyperlink("https://example.com"))
+	b.Show()
+
+	if out := tty.output(); strings.Contains(out, "\x1b]8;") {
+		t.Fatalf("output %q contains OSC 8 without hyperlink support", out)
+	}
+}
+
+// replyTty answers every write with a canned terminal response.
+type replyTty struct {
+	fakeTty
+	reply   string
+	written string
+	replies chan string
+}
+
+func (r *replyTty) Write(p []byte) (int, error) {
+	r.written += string(p)
+	r.replies <- r.reply
+	return len(p), nil
+}
+
+func (r *replyTty) Read(p []byte) (int, error) {
+	select {
+	case reply := <-r.replies:
+		return copy(p, reply), nil
+	case <-r.done:
+		return 0, io.EOF
+	}
+}
+
+func TestColorSchemeFromEnv(t *testing.T) {
+	tests := []struct {
+		value        string
+		ok           bool
+		highContrast bool
+		dark         bool
+	}{
+		{"15;0", true, true, true},
+		{"0;default;15", true, true, false},
+		{"7;0", true, false, true},
+		{"default;default", false, false, false},
+		{"", false, false, false},
+	}
+	for _, tt := range tests {
+		scheme, ok := colorSchemeFromEnv(func(string) string { return tt.value })
+		if ok != tt.ok {
+			t.Errorf("%q: ok = %v, want %v", tt.value, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got := scheme.HighContrast(); got != tt.highContrast {
+			t.Errorf("%q: HighContrast() = %v, want %v", tt.value, got, tt.highContrast)
+		}
+		if got := scheme.Dark(); got != tt.dark {
+			t.Errorf("%q: Dark() = %v, want %v", tt.value, got, tt.dark)
+		}
+	}
+}
+
+func TestQueryColorScheme(t *testing.T) {
+	tty := &replyTty{
+		fakeTty: fakeTty{done: make(chan struct{})},
+		reply:   "\x1b]10;rgb:0000/0000/0000\x1b\\\x1b]11;rgb:ffff/ffff/ffff\x1b\\\x1b[?62c",
+		replies: make(chan string, 1),
+	}
+	scheme, ok := queryColorScheme(tty, time.Second)
+	if !ok || !scheme.HighContrast() {
+		t.Fatalf("scheme = %+v ok=%v, want black on white", scheme, ok)
+	}
+	if !strings.Contains(tty.written, "\x1b]11;?") {
+		t.Fatalf("query = %q, want an OSC 11 request", tty.written)
+	}
+}
+
+func TestQueryColorScheme_Timeout(t *testing.T) {
+	if _, ok := queryColorScheme(newFakeTty(), 10*time.Millisecond); ok {
+		t.Fatal("expected no scheme from a silent terminal")
+	}
+}
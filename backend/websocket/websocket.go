@@ -0,0 +1,205 @@
+// Package websocket serves a FluffyUI app to a browser terminal such as
+// xterm.js over a WebSocket. Output is sent as binary messages of raw ANSI
+// escape sequences; input arrives as binary messages of raw key bytes or as
+// JSON text messages (see ClientMessage).
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	ws "github.com/gorilla/websocket"
+
+	"github.com/odvcencio/fluffy-ui/backend/internal/streamtty"
+	fluffytcell "github.com/odvcencio/fluffy-ui/backend/tcell"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// Default terminal size used by Handler when the request gives none.
+const (
+	DefaultWidth  = 80
+	DefaultHeight = 24
+)
+
+// maxSize caps the columns and rows a browser may ask for, so a bad client
+// cannot make the app allocate an enormous screen.
+const maxSize = 1000
+
+// writeTimeout bounds each output write, so a browser that stops reading
+// cannot stall the app's render loop forever.
+const writeTimeout = 10 * time.Second
+
+// ClientMessage is the JSON form of a text message from the browser:
+// {"type":"input","data":"..."} for typed input, or
+// {"type":"resize","cols":100,"rows":30} when the terminal is resized.
+type ClientMessage struct {
+	Type string `json:"type"`
+	Data string `json:"data,omitempty"`
+	Cols int    `json:"cols,omitempty"`
+	Rows int    `json:"rows,omitempty"`
+}
+
+// Backend is a terminal backend whose screen is a browser terminal on the
+// other end of a WebSocket. It reads the connection on its own goroutine and
+// serializes writes, so input and output may happen concurrently.
+type Backend struct {
+	*fluffytcell.Backend
+
+	conn    *ws.Conn
+	tty     *streamtty.Tty
+	initErr error
+	writeMu sync.Mutex
+
+	done    chan struct{}
+	readErr error
+}
+
+// New creates a backend of the given size on conn and starts reading
+// browser messages.
+func New(conn *ws.Conn, width, height int) *Backend {
+	b := &Backend{conn: conn, done: make(chan struct{})}
+	b.tty = streamtty.New(writerFunc(b.writeOutput), width, height)
+	b.Backend, b.initErr = fluffytcell.NewWithTty(b.tty, fluffytcell.DefaultTerm)
+	go b.readLoop()
+	return b
+}
+
+// Init initializes the terminal.
+func (b *Backend) Init() error {
+	if b.initErr != nil {
+		return b.initErr
+	}
+	return b.Backend.Init()
+}
+
+// Done is closed when the browser disconnects.
+func (b *Backend) Done() <-chan struct{} {
+	return b.done
+}
+
+// Err returns the error that ended the connection, once Done is closed.
+func (b *Backend) Err() error {
+	select {
+	case <-b.done:
+		return b.readErr
+	default:
+		return nil
+	}
+}
+
+// readLoop decodes browser messages until the connection fails.
+func (b *Backend) readLoop() {
+	defer close(b.done)
+	for {
+		kind, data, err := b.conn.ReadMessage()
+		if err != nil {
+			b.readErr = err
+			b.tty.CloseInput(err)
+			return
+		}
+		if kind == ws.BinaryMessage {
+			b.tty.Feed(data)
+			continue
+		}
+		var msg ClientMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+		switch msg.Type {
+		case "input":
+			b.tty.Feed([]byte(msg.Data))
+		case "resize":
+			if msg.Cols > 0 && msg.Rows > 0 {
+				b.tty.Resize(min(msg.Cols, maxSize), min(msg.Rows, maxSize))
+			}
+		}
+	}
+}
+
+func (b *Backend) writeOutput(p []byte) (int, error) {
+	b.writeMu.Lock()
+	defer b.writeMu.Unlock()
+	if err := b.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return 0, err
+	}
+	if err := b.conn.WriteMessage(ws.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close sends the browser a normal close frame and closes the connection.
+func (b *Backend) Close() error {
+	return b.closeWith(ws.CloseNormalClosure, "")
+}
+
+func (b *Backend) closeWith(code int, reason string) error {
+	b.tty.CloseInput(nil)
+	b.writeMu.Lock()
+	_ = b.conn.WriteControl(ws.CloseMessage, ws.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	b.writeMu.Unlock()
+	return b.conn.Close()
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+// Handler upgrades each request to a WebSocket and runs an App from
+// appFactory on it until the app quits or the browser disconnects. The
+// cols and rows query parameters set the initial size, capped at 1000, and
+// hyperlinks=1 enables OSC 8 links for clients that render them, such as
+// xterm.js with a link handler. Cross-origin requests are rejected.
+func Handler(appFactory func() *runtime.App) http.Handler {
+	var upgrader ws.Upgrader
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// Upgrade has already replied with an HTTP error.
+			return
+		}
+		query := r.URL.Query()
+		b := New(conn, queryInt(query.Get("cols"), DefaultWidth), queryInt(query.Get("rows"), DefaultHeight))
+		if hyperlinks, _ := strconv.ParseBool(query.Get("hyperlinks")); hyperlinks && b.Backend != nil {
+			b.SetHyperlinks(true)
+		}
+		app := appFactory()
+		if app == nil {
+			_ = b.Close()
+			return
+		}
+		app.SetBackend(b)
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		go func() {
+			select {
+			case <-b.Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		if err := app.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			_ = b.closeWith(ws.CloseInternalServerErr, err.Error())
+			return
+		}
+		_ = b.Close()
+	})
+}
+
+// queryInt parses a size parameter, using fallback when it is missing or
+// not positive and capping it at maxSize.
+func queryInt(value string, fallback int) int {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return fallback
+	}
+	return min(n, maxSize)
+}
@@ -0,0 +1,162 @@
+package websocket
+
+import (
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ws "github.com/gorilla/websocket"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// echoLabel records its size and the last rune typed; q quits.
+type echoLabel struct {
+	mu     sync.Mutex
+	bounds runtime.Rect
+	last   rune
+}
+
+func (l *echoLabel) Measure(c runtime.Constraints) runtime.Size {
+	return c.Constrain(runtime.Size{Width: c.MaxWidth, Height: c.MaxHeight})
+}
+
+func (l *echoLabel) Layout(bounds runtime.Rect) {
+	l.mu.Lock()
+	l.bounds = bounds
+	l.mu.Unlock()
+}
+
+func (l *echoLabel) Render(ctx runtime.RenderContext) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ctx.Buffer.SetString(0, 0, "ready", backend.DefaultStyle())
+}
+
+func (l *echoLabel) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if key, ok := msg.(runtime.KeyMsg); ok && key.Key == terminal.KeyRune {
+		if key.Rune == 'q' {
+			return runtime.WithCommand(runtime.Quit{})
+		}
+		l.mu.Lock()
+		l.last = key.Rune
+		l.mu.Unlock()
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+func (l *echoLabel) state() (width, height int, last rune) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.bounds.Width, l.bounds.Height, l.last
+}
+
+func waitUntil(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestHandler_RendersAndReadsInput(t *testing.T) {
+	label := &echoLabel{}
+	srv := httptest.NewServer(Handler(func() *runtime.App {
+		return runtime.NewApp(runtime.AppConfig{Root: label})
+	}))
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/?cols=30&rows=5"
+	conn, _, err := ws.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	// Read output concurrently with the writes below, as a browser would.
+	var mu sync.Mutex
+	var output strings.Builder
+	closed := make(chan error, 1)
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				closed <- err
+				return
+			}
+			mu.Lock()
+			output.Write(data)
+			mu.Unlock()
+		}
+	}()
+
+	waitUntil(t, "first frame", func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return strings.Contains(output.String(), "ready")
+	})
+	if w, h, _ := label.state(); w != 30 || h != 5 {
+		t.Fatalf("initial size = %dx%d, want 30x5", w, h)
+	}
+
+	if err := conn.WriteMessage(ws.BinaryMessage, []byte("x")); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	waitUntil(t, "binary key", func() bool { _, _, last := label.state(); return last == 'x' })
+	if err := conn.WriteJSON(ClientMessage{Type: "input", Data: "y"}); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	waitUntil(t, "JSON key", func() bool { _, _, last := label.state(); return last == 'y' })
+	if err := conn.WriteJSON(ClientMessage{Type: "resize", Cols: 50, Rows: 12}); err != nil {
+		t.Fatalf("write resize: %v", err)
+	}
+	waitUntil(t, "resize", func() bool { w, h, _ := label.state(); return w == 50 && h == 12 })
+	// Empty sizes are ignored and oversized ones are capped.
+	if err := conn.WriteJSON(ClientMessage{Type: "resize", Cols: 40, Rows: 0}); err != nil {
+		t.Fatalf("write empty resize: %v", err)
+	}
+	if err := conn.WriteJSON(ClientMessage{Type: "resize", Cols: 1 << 20, Rows: 12}); err != nil {
+		t.Fatalf("write oversized resize: %v", err)
+	}
+	waitUntil(t, "capped resize", func() bool { w, h, _ := label.state(); return w == maxSize && h == 12 })
+
+	// Quitting the app closes the socket normally.
+	if err := conn.WriteMessage(ws.BinaryMessage, []byte("q")); err != nil {
+		t.Fatalf("write quit: %v", err)
+	}
+	select {
+	case err := <-closed:
+		if !ws.IsCloseError(err, ws.CloseNormalClosure) {
+			t.Fatalf("close error = %v, want normal closure", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not close the connection after the app quit")
+	}
+}
+
+func TestQueryInt(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{"", DefaultWidth},
+		{"abc", DefaultWidth},
+		{"0", DefaultWidth},
+		{"-5", DefaultWidth},
+		{"120", 120},
+		{"99999999", maxSize},
+	}
+	for _, tt := range tests {
+		if got := queryInt(tt.value, DefaultWidth); got != tt.want {
+			t.Errorf("queryInt(%q) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
@@ -15,6 +15,7 @@ type Target interface {
 	ClipboardCopy() (string, bool)
 	ClipboardCut() (string, bool)
 	ClipboardPaste(text string) bool
+	SelectAll()
 }
 
 // Command identifiers for clipboard actions.
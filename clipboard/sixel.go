@@ -0,0 +1,190 @@
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ImageClipboard provides image clipboard access via terminal graphics.
+type ImageClipboard interface {
+	WriteImage(img image.Image) error
+	ReadImage() (image.Image, error)
+}
+
+// ErrNoImage is returned when no image is available to read.
+var ErrNoImage = errors.New("clipboard: no image available")
+
+// SixelClipboard writes images to the terminal as Sixel graphics.
+// Terminals cannot return Sixel data, so ReadImage returns the last image written.
+type SixelClipboard struct {
+	mu   sync.Mutex
+	out  io.Writer
+	last image.Image
+}
+
+// NewSixelClipboard creates a Sixel clipboard that writes to out.
+func NewSixelClipboard(out io.Writer) *SixelClipboard {
+	return &SixelClipboard{out: out}
+}
+
+// WriteImage encodes img as Sixel data and writes it to the terminal.
+func (c *SixelClipboard) WriteImage(img image.Image) error {
+	if c == nil {
+		return nil
+	}
+	data, err := EncodeSixel(img)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.out != nil {
+		if _, err := c.out.Write(data); err != nil {
+			return err
+		}
+	}
+	c.last = img
+	return nil
+}
+
+// ReadImage returns the last image written.
+func (c *SixelClipboard) ReadImage() (image.Image, error) {
+	if c == nil {
+		return nil, ErrNoImage
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.last == nil {
+		return nil, ErrNoImage
+	}
+	return c.last, nil
+}
+
+var sixelTerms = []string{"mlterm", "yaft", "foot", "contour", "wezterm", "sixel"}
+
+// SixelSupported reports whether a terminal supports Sixel graphics.
+// term is the $TERM value and da is the terminal's primary device attributes
+// (DA1) response, e.g. "\x1b[?62;4;22c"; attribute 4 indicates Sixel support.
+func SixelSupported(term, da string) bool {
+	term = strings.ToLower(term)
+	for _, name := range sixelTerms {
+		if strings.Contains(term, name) {
+			return true
+		}
+	}
+	da = strings.TrimPrefix(da, "\x1b[?")
+	da = strings.TrimSuffix(da, "c")
+	for _, attr := range strings.Split(da, ";") {
+		if attr == "4" {
+			return true
+		}
+	}
+	return false
+}
+
+// EncodeSixel encodes an image as a Sixel escape sequence.
+// Colors are quantized to the web-safe palette.
+func EncodeSixel(img image.Image) ([]byte, error) {
+	if img == nil {
+		return nil, fmt.Errorf("clipboard: image is required")
+	}
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("clipboard: image is empty")
+	}
+	paletted := image.NewPaletted(image.Rect(0, 0, width, height), palette.WebSafe)
+	draw.Draw(paletted, paletted.Bounds(), img, bounds.Min, draw.Src)
+
+	var out bytes.Buffer
+	out.WriteString("\x1bPq")
+	fmt.Fprintf(&out, "\"1;1;%d;%d", width, height)
+
+	used := make(map[uint8]bool)
+	for _, index := range paletted.Pix {
+		used[index] = true
+	}
+	for index := range palette.WebSafe {
+		if !used[uint8(index)] {
+			continue
+		}
+		r, g, b, _ := palette.WebSafe[index].RGBA()
+		fmt.Fprintf(&out, "#%d;2;%d;%d;%d", index, sixelPercent(r), sixelPercent(g), sixelPercent(b))
+	}
+
+	for top := 0; top < height; top += 6 {
+		first := true
+		for index := range palette.WebSafe {
+			if !used[uint8(index)] {
+				continue
+			}
+			row := sixelRow(paletted, top, uint8(index))
+			if row == nil {
+				continue
+			}
+			if !first {
+				out.WriteByte('$')
+			}
+			first = false
+			out.WriteByte('#')
+			out.WriteString(strconv.Itoa(index))
+			writeSixelRuns(&out, row)
+		}
+		out.WriteByte('-')
+	}
+	out.WriteString("\x1b\\")
+	return out.Bytes(), nil
+}
+
+// sixelRow returns the sixel characters for one color in a six-pixel band,
+// or nil if the color does not appear in the band.
+func sixelRow(img *image.Paletted, top int, index uint8) []byte {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	row := make([]byte, width)
+	found := false
+	for x := 0; x < width; x++ {
+		var bits byte
+		for dy := 0; dy < 6 && top+dy < height; dy++ {
+			if img.ColorIndexAt(x, top+dy) == index {
+				bits |= 1 << dy
+			}
+		}
+		if bits != 0 {
+			found = true
+		}
+		row[x] = '?' + bits
+	}
+	if !found {
+		return nil
+	}
+	return row
+}
+
+func writeSixelRuns(out *bytes.Buffer, row []byte) {
+	for i := 0; i < len(row); {
+		j := i
+		for j < len(row) && row[j] == row[i] {
+			j++
+		}
+		if run := j - i; run > 3 {
+			fmt.Fprintf(out, "!%d%c", run, row[i])
+		} else {
+			for k := 0; k < run; k++ {
+				out.WriteByte(row[i])
+			}
+		}
+		i = j
+	}
+}
+
+func sixelPercent(v uint32) int {
+	return int(v * 100 / 0xffff)
+}
@@ -0,0 +1,73 @@
+//go:build integration
+
+package clipboard
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+)
+
+func checkerboard(size int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			c := color.RGBA{A: 255}
+			if (x+y)%2 == 0 {
+				c = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestEncodeSixelCheckerboard(t *testing.T) {
+	data, err := EncodeSixel(checkerboard(4))
+	if err != nil {
+		t.Fatalf("encode failed: %v", err)
+	}
+	out := string(data)
+	if !strings.HasPrefix(out, "\x1bPq") {
+		t.Fatalf("expected sixel introducer, got %q", out)
+	}
+	if !strings.HasSuffix(out, "\x1b\\") {
+		t.Fatalf("expected string terminator, got %q", out)
+	}
+	if !strings.Contains(out, "\"1;1;4;4") {
+		t.Fatalf("expected raster attributes for 4x4, got %q", out)
+	}
+	if !strings.Contains(out, ";2;0;0;0") || !strings.Contains(out, ";2;100;100;100") {
+		t.Fatalf("expected black and white color registers, got %q", out)
+	}
+}
+
+func TestSixelClipboardWriteImage(t *testing.T) {
+	var out bytes.Buffer
+	cb := NewSixelClipboard(&out)
+	img := checkerboard(4)
+	if err := cb.WriteImage(img); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "\x1bPq") {
+		t.Fatalf("expected sixel output, got %q", out.String())
+	}
+	got, err := cb.ReadImage()
+	if err != nil || got != img {
+		t.Fatalf("expected last image, got %v, %v", got, err)
+	}
+}
+
+func TestSixelSupported(t *testing.T) {
+	if !SixelSupported("foot", "") {
+		t.Fatal("expected foot to support sixel")
+	}
+	if !SixelSupported("xterm-256color", "\x1b[?62;4;22c") {
+		t.Fatal("expected DA attribute 4 to indicate sixel")
+	}
+	if SixelSupported("xterm-256color", "\x1b[?62;22c") {
+		t.Fatal("expected no sixel without DA attribute 4")
+	}
+}
@@ -670,7 +670,7 @@ func NewGameView(game *Game) *GameView {
 	v.updateMarketTable()
 
 	// Location list
-	adapter := widgets.NewSliceAdapter(Locations, func(loc Location, index int, selected bool, ctx runtime.RenderContext) {
+	adapter := widgets.NewSliceAdapter(Locations, func(loc Location, index int, selected, checked bool, ctx runtime.RenderContext) {
 		style := v.style
 		if selected {
 			style = style.Reverse(true)
@@ -72,7 +72,7 @@ func NewFileBrowserView() (*FileBrowserView, error) {
 	view.statusLabel = widgets.NewLabel("")
 	view.details = widgets.NewText("")
 
-	adapter := widgets.NewSignalAdapter(view.entries, func(item FileEntry, index int, selected bool, ctx runtime.RenderContext) {
+	adapter := widgets.NewSignalAdapter(view.entries, func(item FileEntry, index int, selected, checked bool, ctx runtime.RenderContext) {
 		style := backend.DefaultStyle()
 		if selected {
 			style = style.Reverse(true)
@@ -17,7 +17,7 @@ import (
 	"time"
 
 	"github.com/odvcencio/fluffy-ui/backend"
-	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/examples/internal/demo"
 	"github.com/odvcencio/fluffy-ui/recording"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/state"
@@ -79,33 +79,17 @@ func recordDemo(path string, root runtime.Widget) error {
 		return err
 	}
 
-	frameCount := 0
-	maxFrames := 90 // 3 seconds at 30fps
+	// Record 3 seconds at 30fps.
+	script := demo.NewScript(demo.Wait(3*time.Second), demo.EndScript())
+	script.Width, script.Height = *width, *height
+	script.TickRate = time.Second / 30
+	script.Recorder = recorder
 
-	update := func(app *runtime.App, msg runtime.Message) bool {
-		switch msg.(type) {
-		case runtime.TickMsg:
-			frameCount++
-			if frameCount >= maxFrames {
-				app.ExecuteCommand(runtime.Quit{})
-				return false
-			}
-			// Forward tick to widgets so animations work
-			runtime.DefaultUpdate(app, msg)
-			return true
-		}
-		return runtime.DefaultUpdate(app, msg)
+	app := script.NewApp(root)
+	if err := app.Run(context.Background()); err != nil {
+		return err
 	}
-
-	app := runtime.NewApp(runtime.AppConfig{
-		Backend:  sim.New(*width, *height),
-		Root:     root,
-		Update:   update,
-		TickRate: time.Second / 30,
-		Recorder: recorder,
-	})
-
-	return app.Run(context.Background())
+	return script.Err()
 }
 
 // =============================================================================
@@ -379,27 +363,27 @@ func (p *progressDemo) Render(ctx runtime.RenderContext) {
 
 	y := bounds.Y + 3
 	ctx.Buffer.SetString(bounds.X+2, y, "Download:", backend.DefaultStyle())
-	widgets.DrawGauge(ctx.Buffer, bounds.X+14, y, 40, progress1, widgets.GaugeStyle{
-		FillChar:  '#',
-		EmptyChar: '-',
+	ctx.Buffer.DrawProgress(bounds.X+14, y, 45, progress1, 1, runtime.GaugeStyle{
+		FillChar:      '#',
+		EmptyChar:     '-',
+		LabelPosition: runtime.GaugeLabelRight,
 	})
-	ctx.Buffer.SetString(bounds.X+56, y, fmt.Sprintf("%3.0f%%", progress1*100), backend.DefaultStyle())
 
 	y += 2
 	ctx.Buffer.SetString(bounds.X+2, y, "Upload:  ", backend.DefaultStyle())
-	widgets.DrawGauge(ctx.Buffer, bounds.X+14, y, 40, progress2, widgets.GaugeStyle{
-		FillChar:  '=',
-		EmptyChar: ' ',
+	ctx.Buffer.DrawProgress(bounds.X+14, y, 45, progress2, 1, runtime.GaugeStyle{
+		FillChar:      '=',
+		EmptyChar:     ' ',
+		LabelPosition: runtime.GaugeLabelRight,
 	})
-	ctx.Buffer.SetString(bounds.X+56, y, fmt.Sprintf("%3.0f%%", progress2*100), backend.DefaultStyle())
 
 	y += 2
 	ctx.Buffer.SetString(bounds.X+2, y, "Process: ", backend.DefaultStyle())
-	widgets.DrawGauge(ctx.Buffer, bounds.X+14, y, 40, progress3, widgets.GaugeStyle{
-		FillChar:  '*',
-		EmptyChar: '.',
+	ctx.Buffer.DrawProgress(bounds.X+14, y, 45, progress3, 1, runtime.GaugeStyle{
+		FillChar:      '*',
+		EmptyChar:     '.',
+		LabelPosition: runtime.GaugeLabelRight,
 	})
-	ctx.Buffer.SetString(bounds.X+56, y, fmt.Sprintf("%3.0f%%", progress3*100), backend.DefaultStyle())
 
 	ctx.Buffer.DrawBox(bounds, backend.DefaultStyle())
 }
@@ -0,0 +1,196 @@
+package demo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// Step is one action in a demo script. Steps run on the app's tick, one per
+// tick, so the app can process input and render between them.
+type Step interface {
+	run(s *Script, app *runtime.App, now time.Time) (done bool, err error)
+}
+
+type stepFunc func(s *Script, app *runtime.App, now time.Time) (bool, error)
+
+func (f stepFunc) run(s *Script, app *runtime.App, now time.Time) (bool, error) {
+	return f(s, app, now)
+}
+
+// Wait pauses the script for d.
+func Wait(d time.Duration) Step {
+	var until time.Time
+	return stepFunc(func(s *Script, app *runtime.App, now time.Time) (bool, error) {
+		if until.IsZero() {
+			until = now.Add(d)
+		}
+		if now.Before(until) {
+			return false, nil
+		}
+		until = time.Time{}
+		return true, nil
+	})
+}
+
+// InjectKey sends a key press through the sim backend.
+func InjectKey(key terminal.Key, r rune) Step {
+	return stepFunc(func(s *Script, app *runtime.App, now time.Time) (bool, error) {
+		s.backend.InjectKey(key, r)
+		return true, nil
+	})
+}
+
+// Screenshot saves the screen text to <GoldenDir>/<name>.golden.
+func Screenshot(name string) Step {
+	return stepFunc(func(s *Script, app *runtime.App, now time.Time) (bool, error) {
+		dir := s.GoldenDir
+		if dir == "" {
+			dir = "testdata"
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return false, err
+		}
+		path := filepath.Join(dir, name+".golden")
+		if err := os.WriteFile(path, []byte(s.backend.Capture()+"\n"), 0o644); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+// Assert fails the script unless text is on screen.
+func Assert(text string) Step {
+	return stepFunc(func(s *Script, app *runtime.App, now time.Time) (bool, error) {
+		if !s.backend.ContainsText(text) {
+			return false, fmt.Errorf("assert %q: text not on screen:\n%s", text, s.backend.Capture())
+		}
+		return true, nil
+	})
+}
+
+// EndScript quits the app.
+func EndScript() Step {
+	return stepFunc(func(s *Script, app *runtime.App, now time.Time) (bool, error) {
+		app.ExecuteCommand(runtime.Quit{})
+		return true, nil
+	})
+}
+
+// TB is the part of testing.TB used by Script.Run.
+type TB interface {
+	Helper()
+	Fatalf(format string, args ...any)
+}
+
+// Script drives an app on the sim backend through a sequence of steps.
+// The app quits after the last step or on the first failure.
+type Script struct {
+	Steps []Step
+	// Width and Height size the sim screen (default 80x24).
+	Width, Height int
+	// TickRate paces the steps (default 30 ticks per second).
+	TickRate time.Duration
+	// GoldenDir is where Screenshot writes files (default "testdata").
+	GoldenDir string
+	// Recorder, when set, records the session.
+	Recorder runtime.Recorder
+	// Timeout bounds Run (default 10s).
+	Timeout time.Duration
+
+	backend *sim.Backend
+	index   int
+	err     error
+}
+
+// NewScript creates a script from steps.
+func NewScript(steps ...Step) *Script {
+	return &Script{Steps: steps}
+}
+
+// NewScriptedApp creates an app on a sim backend that runs script.
+func NewScriptedApp(root runtime.Widget, script ...Step) *runtime.App {
+	return NewScript(script...).NewApp(root)
+}
+
+// NewApp creates an app on a sim backend that runs the script's steps.
+func (s *Script) NewApp(root runtime.Widget) *runtime.App {
+	width, height := s.Width, s.Height
+	if width <= 0 || height <= 0 {
+		width, height = 80, 24
+	}
+	tickRate := s.TickRate
+	if tickRate <= 0 {
+		tickRate = time.Second / 30
+	}
+	s.backend = sim.New(width, height)
+	s.index = 0
+	s.err = nil
+	return runtime.NewApp(runtime.AppConfig{
+		Backend:  s.backend,
+		Root:     root,
+		Update:   s.update,
+		TickRate: tickRate,
+		Recorder: s.Recorder,
+
+		FocusRegistration: runtime.FocusRegistrationAuto,
+	})
+}
+
+// Backend returns the sim backend of the most recent NewApp call.
+func (s *Script) Backend() *sim.Backend {
+	return s.backend
+}
+
+// Err returns the error that stopped the script, if any.
+func (s *Script) Err() error {
+	return s.err
+}
+
+// Run runs the script against root and fails t if a step fails.
+func (s *Script) Run(t TB, root runtime.Widget) {
+	t.Helper()
+	timeout := s.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	app := s.NewApp(root)
+	if err := app.Run(ctx); err != nil {
+		t.Fatalf("script stopped at step %d: %v", s.index+1, err)
+		return
+	}
+	if s.err != nil {
+		t.Fatalf("%v", s.err)
+	}
+}
+
+func (s *Script) update(app *runtime.App, msg runtime.Message) bool {
+	tick, ok := msg.(runtime.TickMsg)
+	if !ok {
+		return runtime.DefaultUpdate(app, msg)
+	}
+	// Forward the tick so animations keep running.
+	runtime.DefaultUpdate(app, msg)
+	if s.index >= len(s.Steps) {
+		app.ExecuteCommand(runtime.Quit{})
+		return true
+	}
+	done, err := s.Steps[s.index].run(s, app, tick.Time)
+	if err != nil {
+		s.err = fmt.Errorf("step %d: %w", s.index+1, err)
+		app.ExecuteCommand(runtime.Quit{})
+		return true
+	}
+	if done {
+		s.index++
+	}
+	return true
+}
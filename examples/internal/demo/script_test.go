@@ -0,0 +1,52 @@
+package demo
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/widgets"
+)
+
+// recordingTB captures Fatalf instead of failing the test.
+type recordingTB struct {
+	failure string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Fatalf(format string, args ...any) {
+	r.failure = fmt.Sprintf(format, args...)
+}
+
+func TestScript_InjectAndAssert(t *testing.T) {
+	script := NewScript(
+		InjectKey(terminal.KeyRune, 'a'),
+		InjectKey(terminal.KeyRune, 'b'),
+		Assert("ab"),
+	)
+	script.Width, script.Height = 20, 3
+	script.TickRate = 10 * time.Millisecond
+	script.Run(t, widgets.NewInput())
+}
+
+func TestScript_AssertFailure(t *testing.T) {
+	script := NewScript(
+		InjectKey(terminal.KeyRune, 'a'),
+		Assert("zz"),
+		EndScript(),
+	)
+	script.Width, script.Height = 20, 3
+	script.TickRate = 10 * time.Millisecond
+
+	tb := &recordingTB{}
+	script.Run(tb, widgets.NewInput())
+	if want := `step 2: assert "zz": text not on screen`; !strings.HasPrefix(tb.failure, want) {
+		t.Fatalf("failure = %q, want prefix %q", tb.failure, want)
+	}
+	if script.Err() == nil {
+		t.Fatal("expected Err to report the failed step")
+	}
+}
@@ -56,7 +56,7 @@ func NewTodoView() *TodoView {
 		view.addTask(text)
 	})
 
-	adapter := widgets.NewSignalAdapter(view.tasks, func(item Task, index int, selected bool, ctx runtime.RenderContext) {
+	adapter := widgets.NewSignalAdapter(view.tasks, func(item Task, index int, selected, checked bool, ctx runtime.RenderContext) {
 		style := view.listStyle
 		if item.Done {
 			style = style.Dim(true)
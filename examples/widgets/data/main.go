@@ -39,7 +39,7 @@ func NewDataView() *DataView {
 	view.header = widgets.NewLabel("Data Widgets").WithStyle(backend.DefaultStyle().Bold(true))
 
 	items := []string{"Alpha", "Beta", "Gamma", "Delta", "Epsilon", "Zeta", "Eta"}
-	adapter := widgets.NewSliceAdapter(items, func(item string, index int, selected bool, ctx runtime.RenderContext) {
+	adapter := widgets.NewSliceAdapter(items, func(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
 		style := backend.DefaultStyle()
 		if selected {
 			style = style.Reverse(true)
@@ -194,7 +194,7 @@ type galleryRight struct {
 
 func newGalleryRight() *galleryRight {
 	items := []string{"Alpha", "Beta", "Gamma", "Delta", "Epsilon"}
-	adapter := widgets.NewSliceAdapter(items, func(item string, index int, selected bool, ctx runtime.RenderContext) {
+	adapter := widgets.NewSliceAdapter(items, func(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
 		style := backend.DefaultStyle()
 		if selected {
 			style = style.Reverse(true)
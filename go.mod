@@ -5,12 +5,18 @@ go 1.25.1
 require (
 	github.com/alecthomas/chroma/v2 v2.22.0
 	github.com/gdamore/tcell/v2 v2.13.7
+	github.com/gliderlabs/ssh v0.3.8
+	github.com/gorilla/websocket v1.5.3
 	github.com/mattn/go-runewidth v0.0.19
 	github.com/oklog/ulid/v2 v2.1.1
 	github.com/yuin/goldmark v1.7.16
+	golang.org/x/crypto v0.31.0
+	golang.org/x/image v0.33.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
+	github.com/anmitsu/go-shlex v0.0.0-20200514113438-38f4b401e2be // indirect
 	github.com/clipperhouse/uax29/v2 v2.2.0 // indirect
 	github.com/dlclark/regexp2 v1.11.5 // indirect
 	github.com/gdamore/encoding v1.0.1 // indirect
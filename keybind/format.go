@@ -126,6 +126,7 @@ var keyDisplayNameMap = map[terminal.Key]string{
 }
 
 var ctrlKeyName = map[terminal.Key]rune{
+	terminal.KeyCtrlA: 'a',
 	terminal.KeyCtrlB: 'b',
 	terminal.KeyCtrlC: 'c',
 	terminal.KeyCtrlD: 'd',
@@ -133,5 +134,6 @@ var ctrlKeyName = map[terminal.Key]rune{
 	terminal.KeyCtrlP: 'p',
 	terminal.KeyCtrlV: 'v',
 	terminal.KeyCtrlX: 'x',
+	terminal.KeyCtrlY: 'y',
 	terminal.KeyCtrlZ: 'z',
 }
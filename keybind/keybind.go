@@ -230,6 +230,7 @@ var keyNameMap = map[string]terminal.Key{
 	"delete":    terminal.KeyDelete,
 	"del":       terminal.KeyDelete,
 	"insert":    terminal.KeyInsert,
+	"ins":       terminal.KeyInsert,
 	"home":      terminal.KeyHome,
 	"end":       terminal.KeyEnd,
 	"pageup":    terminal.KeyPageUp,
@@ -243,6 +244,7 @@ var keyNameMap = map[string]terminal.Key{
 }
 
 var ctrlKeyMap = map[rune]terminal.Key{
+	'a': terminal.KeyCtrlA,
 	'b': terminal.KeyCtrlB,
 	'c': terminal.KeyCtrlC,
 	'd': terminal.KeyCtrlD,
@@ -250,5 +252,6 @@ var ctrlKeyMap = map[rune]terminal.Key{
 	'p': terminal.KeyCtrlP,
 	'v': terminal.KeyCtrlV,
 	'x': terminal.KeyCtrlX,
+	'y': terminal.KeyCtrlY,
 	'z': terminal.KeyCtrlZ,
 }
@@ -109,3 +109,44 @@ func TestFormatKeySequence(t *testing.T) {
 		t.Fatalf("expected Space, got %q", got)
 	}
 }
+
+func TestParseShortcut(t *testing.T) {
+	shortcut, err := ParseShortcut("Ctrl+S")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if shortcut != (Shortcut{Key: "S", Modifier: ModCtrl}) {
+		t.Fatalf("unexpected shortcut: %+v", shortcut)
+	}
+	if _, err := ParseShortcut(""); err == nil {
+		t.Fatalf("expected error for empty shortcut")
+	}
+}
+
+func TestShortcutFormat(t *testing.T) {
+	shortcut := Shortcut{Key: "S", Modifier: ModCtrl}
+	if got := shortcut.Format("darwin"); got != "⌘S" {
+		t.Fatalf("expected ⌘S on macOS, got %q", got)
+	}
+	if got := shortcut.Format("linux"); got != "Ctrl+S" {
+		t.Fatalf("expected Ctrl+S on linux, got %q", got)
+	}
+	shift := Shortcut{Key: "P", Modifier: ModCtrl | ModShift}
+	if got := shift.Format("darwin"); got != "⇧⌘P" {
+		t.Fatalf("expected ⇧⌘P on macOS, got %q", got)
+	}
+}
+
+func TestShortcutMatches(t *testing.T) {
+	shortcut := Shortcut{Key: "S", Modifier: ModCtrl}
+	if !shortcut.Matches(KeyPress{Key: terminal.KeyRune, Rune: 's', Ctrl: true}) {
+		t.Fatalf("expected ctrl+s rune to match")
+	}
+	if shortcut.Matches(KeyPress{Key: terminal.KeyRune, Rune: 's'}) {
+		t.Fatalf("expected plain s not to match")
+	}
+	palette := Shortcut{Key: "P", Modifier: ModCtrl}
+	if !palette.Matches(KeyPress{Key: terminal.KeyCtrlP}) {
+		t.Fatalf("expected ctrl+p key to match")
+	}
+}
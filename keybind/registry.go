@@ -38,6 +38,14 @@ func (r *CommandRegistry) RegisterAll(cmds ...Command) {
 	}
 }
 
+// Unregister removes a command by ID.
+func (r *CommandRegistry) Unregister(id string) {
+	if r == nil {
+		return
+	}
+	delete(r.commands, id)
+}
+
 // Get returns a command by ID.
 func (r *CommandRegistry) Get(id string) (Command, bool) {
 	if r == nil {
@@ -158,13 +158,15 @@ func KeyPressFromTerminal(event terminal.KeyEvent) KeyPress {
 
 func isCtrlKey(key terminal.Key) bool {
 	switch key {
-	case terminal.KeyCtrlB,
+	case terminal.KeyCtrlA,
+		terminal.KeyCtrlB,
 		terminal.KeyCtrlC,
 		terminal.KeyCtrlD,
 		terminal.KeyCtrlF,
 		terminal.KeyCtrlP,
 		terminal.KeyCtrlV,
 		terminal.KeyCtrlX,
+		terminal.KeyCtrlY,
 		terminal.KeyCtrlZ:
 		return true
 	default:
@@ -1,5 +1,14 @@
 package keybind
 
+import (
+	"fmt"
+	goruntime "runtime"
+	"strings"
+	"unicode"
+
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
 // CommandShortcuts builds a map of command IDs to key sequences.
 func CommandShortcuts(keymaps ...*Keymap) map[string][]Key {
 	if len(keymaps) == 0 {
@@ -30,3 +39,127 @@ func CommandShortcuts(keymaps ...*Keymap) map[string][]Key {
 	}
 	return out
 }
+
+// Modifier is a set of modifier keys held with a shortcut.
+type Modifier uint8
+
+// Shortcut modifiers.
+const (
+	ModCtrl Modifier = 1 << iota
+	ModAlt
+	ModShift
+
+	// ModNone means no modifiers are held.
+	ModNone Modifier = 0
+)
+
+// Shortcut describes a single key press for display and matching.
+// Key holds the display name of the key, e.g. "S", "Enter", or "F5".
+type Shortcut struct {
+	Key      string
+	Modifier Modifier
+}
+
+// ParseShortcut parses a shortcut string like "Ctrl+S" or "alt+shift+f5".
+func ParseShortcut(s string) (Shortcut, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Shortcut{}, fmt.Errorf("empty shortcut")
+	}
+	if strings.ContainsAny(s, " \t") {
+		return Shortcut{}, fmt.Errorf("shortcut %q must be a single key press", s)
+	}
+	press, err := parseKeyToken(s)
+	if err != nil {
+		return Shortcut{}, err
+	}
+	return ShortcutFromKeyPress(press), nil
+}
+
+// ShortcutFromKeyPress converts a key press into a shortcut.
+func ShortcutFromKeyPress(press KeyPress) Shortcut {
+	var mod Modifier
+	if press.Ctrl || isCtrlKey(press.Key) {
+		mod |= ModCtrl
+	}
+	if press.Alt {
+		mod |= ModAlt
+	}
+	if press.Shift {
+		mod |= ModShift
+	}
+	return Shortcut{Key: keyDisplayName(press), Modifier: mod}
+}
+
+// KeyPress converts the shortcut into a normalized key press.
+func (s Shortcut) KeyPress() (KeyPress, error) {
+	if s.Key == "" {
+		return KeyPress{}, fmt.Errorf("empty shortcut key")
+	}
+	parts := make([]string, 0, 4)
+	if s.Modifier&ModCtrl != 0 {
+		parts = append(parts, "ctrl")
+	}
+	if s.Modifier&ModAlt != 0 {
+		parts = append(parts, "alt")
+	}
+	if s.Modifier&ModShift != 0 {
+		parts = append(parts, "shift")
+	}
+	return parseKeyToken(strings.Join(append(parts, s.Key), "+"))
+}
+
+// Matches reports whether the key press triggers this shortcut.
+// Letter keys match regardless of case.
+func (s Shortcut) Matches(press KeyPress) bool {
+	want, err := s.KeyPress()
+	if err != nil {
+		return false
+	}
+	if isCtrlKey(press.Key) {
+		press.Ctrl = true
+	}
+	if want.Key == terminal.KeyRune && press.Key == terminal.KeyRune {
+		want.Rune = unicode.ToLower(want.Rune)
+		press.Rune = unicode.ToLower(press.Rune)
+	}
+	return want.Equal(press)
+}
+
+// String formats the shortcut for the current platform.
+func (s Shortcut) String() string {
+	return s.Format(goruntime.GOOS)
+}
+
+// Format formats the shortcut for the given GOOS value.
+// macOS uses symbol glyphs (⌘S); other platforms use Ctrl+S.
+func (s Shortcut) Format(goos string) string {
+	if s.Key == "" {
+		return ""
+	}
+	if goos == "darwin" {
+		var out strings.Builder
+		if s.Modifier&ModAlt != 0 {
+			out.WriteString("⌥")
+		}
+		if s.Modifier&ModShift != 0 {
+			out.WriteString("⇧")
+		}
+		if s.Modifier&ModCtrl != 0 {
+			out.WriteString("⌘")
+		}
+		out.WriteString(s.Key)
+		return out.String()
+	}
+	parts := make([]string, 0, 4)
+	if s.Modifier&ModCtrl != 0 {
+		parts = append(parts, "Ctrl")
+	}
+	if s.Modifier&ModAlt != 0 {
+		parts = append(parts, "Alt")
+	}
+	if s.Modifier&ModShift != 0 {
+		parts = append(parts, "Shift")
+	}
+	return strings.Join(append(parts, s.Key), "+")
+}
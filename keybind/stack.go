@@ -23,6 +23,21 @@ func (s *KeymapStack) Pop() *Keymap {
 	return last
 }
 
+// Remove removes a keymap from anywhere in the stack. It reports whether the
+// keymap was found.
+func (s *KeymapStack) Remove(keymap *Keymap) bool {
+	if s == nil || keymap == nil {
+		return false
+	}
+	for i := len(s.stack) - 1; i >= 0; i-- {
+		if s.stack[i] == keymap {
+			s.stack = append(s.stack[:i], s.stack[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // Current returns the top keymap.
 func (s *KeymapStack) Current() *Keymap {
 	if s == nil || len(s.stack) == 0 {
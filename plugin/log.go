@@ -0,0 +1,82 @@
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/keybind"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// LogPlugin appends every key the app receives to a file, one per line.
+type LogPlugin struct {
+	BasePlugin
+
+	path   string
+	mu     sync.Mutex
+	out    io.WriteCloser
+	remove func()
+	now    func() time.Time
+}
+
+// NewLogPlugin creates a key logger that writes to path.
+func NewLogPlugin(path string) *LogPlugin {
+	return &LogPlugin{
+		BasePlugin: BasePlugin{PluginName: "log"},
+		path:       path,
+		now:        time.Now,
+	}
+}
+
+// LogFactory returns a factory for NewLogPlugin(path).
+func LogFactory(path string) Factory {
+	return func(app *runtime.App) Plugin {
+		return NewLogPlugin(path)
+	}
+}
+
+// Init opens the log file and starts observing messages.
+func (p *LogPlugin) Init(app *runtime.App) error {
+	file, err := os.OpenFile(p.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.out = file
+	p.mu.Unlock()
+	p.remove = app.ObserveMessages(p.observe)
+	return nil
+}
+
+// Shutdown stops observing and closes the log file.
+func (p *LogPlugin) Shutdown() error {
+	if p.remove != nil {
+		p.remove()
+		p.remove = nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.out == nil {
+		return nil
+	}
+	err := p.out.Close()
+	p.out = nil
+	return err
+}
+
+func (p *LogPlugin) observe(msg runtime.Message) {
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.out == nil {
+		return
+	}
+	press := keybind.KeyPressFromKeyMsg(key)
+	fmt.Fprintf(p.out, "%s %s\n", p.now().Format(time.RFC3339Nano), keybind.FormatKeyPress(press))
+}
@@ -0,0 +1,109 @@
+// Package plugin provides a registry for optional app extensions.
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// Plugin extends an App. Init runs once when the app starts and Shutdown
+// once when it exits.
+type Plugin = runtime.Plugin
+
+// Factory creates a plugin for an app.
+type Factory = runtime.PluginFactory
+
+// ErrNotFound is returned by Load for names that were never registered.
+var ErrNotFound = errors.New("plugin: not found")
+
+// Registry maps plugin names to factories.
+type Registry struct {
+	mu        sync.RWMutex
+	app       *runtime.App
+	factories map[string]Factory
+}
+
+// NewRegistry creates a registry whose plugins are built for app.
+func NewRegistry(app *runtime.App) *Registry {
+	return &Registry{app: app, factories: make(map[string]Factory)}
+}
+
+// Register adds a factory under name, replacing any previous factory.
+func (r *Registry) Register(name string, factory func(app *runtime.App) Plugin) {
+	if r == nil || factory == nil {
+		return
+	}
+	r.mu.Lock()
+	r.factories[name] = factory
+	r.mu.Unlock()
+}
+
+// Load creates the plugin registered under name. The plugin is not
+// initialised; pass its factory to AppConfig.Plugins or call Init directly.
+func (r *Registry) Load(name string) (Plugin, error) {
+	factory, ok := r.Factory(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	p := factory(r.app)
+	if p == nil {
+		return nil, fmt.Errorf("plugin: factory for %s returned nil", name)
+	}
+	return p, nil
+}
+
+// Factory returns the factory registered under name.
+func (r *Registry) Factory(name string) (Factory, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factory, ok := r.factories[name]
+	return factory, ok
+}
+
+// Names returns the registered plugin names in sorted order.
+func (r *Registry) Names() []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BasePlugin provides no-op Init and Shutdown methods. Embed it and set
+// PluginName, overriding only the hooks a plugin needs.
+type BasePlugin struct {
+	PluginName string
+}
+
+// Name returns PluginName.
+func (b *BasePlugin) Name() string {
+	if b == nil {
+		return ""
+	}
+	return b.PluginName
+}
+
+// Init does nothing.
+func (b *BasePlugin) Init(app *runtime.App) error {
+	return nil
+}
+
+// Shutdown does nothing.
+func (b *BasePlugin) Shutdown() error {
+	return nil
+}
+
+var _ Plugin = (*BasePlugin)(nil)
@@ -0,0 +1,141 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+type countingPlugin struct {
+	BasePlugin
+	inits     int
+	shutdowns int
+	started   chan struct{}
+}
+
+func (p *countingPlugin) Init(app *runtime.App) error {
+	p.inits++
+	close(p.started)
+	return nil
+}
+
+func (p *countingPlugin) Shutdown() error {
+	p.shutdowns++
+	return nil
+}
+
+func TestRegistry_Load(t *testing.T) {
+	reg := NewRegistry(nil)
+	reg.Register("base", func(app *runtime.App) Plugin {
+		return &BasePlugin{PluginName: "base"}
+	})
+
+	p, err := reg.Load("base")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if p.Name() != "base" {
+		t.Fatalf("Name = %q, want base", p.Name())
+	}
+	if _, err := reg.Load("missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Load(missing) err = %v, want ErrNotFound", err)
+	}
+	if names := reg.Names(); len(names) != 1 || names[0] != "base" {
+		t.Fatalf("Names = %v, want [base]", names)
+	}
+}
+
+func runApp(t *testing.T, factories ...Factory) (*runtime.App, context.CancelFunc, <-chan error) {
+	t.Helper()
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend: sim.New(10, 3),
+		Plugins: factories,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	return app, cancel, done
+}
+
+func TestPlugin_Lifecycle(t *testing.T) {
+	p := &countingPlugin{BasePlugin: BasePlugin{PluginName: "count"}, started: make(chan struct{})}
+	_, cancel, done := runApp(t, func(app *runtime.App) Plugin { return p })
+	defer cancel()
+
+	select {
+	case <-p.started:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("plugin was not initialised")
+	}
+	cancel()
+	if err := <-done; err != nil && !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run: %v", err)
+	}
+	if p.inits != 1 || p.shutdowns != 1 {
+		t.Fatalf("inits=%d shutdowns=%d, want 1 and 1", p.inits, p.shutdowns)
+	}
+}
+
+func TestPlugin_InitErrorStopsRun(t *testing.T) {
+	failing := func(app *runtime.App) Plugin {
+		return &failingPlugin{BasePlugin{PluginName: "broken"}}
+	}
+	_, cancel, done := runApp(t, failing)
+	defer cancel()
+
+	err := <-done
+	if err == nil || !strings.Contains(err.Error(), "broken") {
+		t.Fatalf("Run err = %v, want init error naming plugin", err)
+	}
+}
+
+type failingPlugin struct {
+	BasePlugin
+}
+
+func (p *failingPlugin) Init(app *runtime.App) error {
+	return errors.New("boom")
+}
+
+func TestLogPlugin_WritesKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.log")
+	logger := NewLogPlugin(path)
+	app, cancel, done := runApp(t, func(app *runtime.App) Plugin { return logger })
+	defer cancel()
+
+	app.Post(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'x'})
+	app.Post(runtime.KeyMsg{Key: terminal.KeyEnter})
+
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for {
+		data, _ := os.ReadFile(path)
+		if strings.Count(string(data), "\n") >= 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("log = %q, want two key lines", data)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if !strings.HasSuffix(lines[0], " X") || !strings.HasSuffix(lines[1], " Enter") {
+		t.Fatalf("lines = %q, want X then Enter", lines)
+	}
+}
@@ -51,6 +51,9 @@ func (e *ANSIEncoder) Encode(buffer *runtime.Buffer, full bool) string {
 }
 
 func (e *ANSIEncoder) writeCell(writer *compositor.ANSIWriter, x, y int, cell runtime.Cell) {
+	if cell.Rune == backend.CellContinuation {
+		return
+	}
 	writer.MoveTo(x, y)
 	writer.SetStyle(e.toCompositor(cell.Style))
 	r := cell.Rune
@@ -0,0 +1,185 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Frame is one output event of a recording.
+type Frame struct {
+	// Delay is the time since the previous frame, or since the start of the
+	// recording for the first frame.
+	Delay time.Duration
+	// Data is the terminal output written by the frame.
+	Data string
+}
+
+// AsciicastPlayer replays an asciicast v2 recording.
+type AsciicastPlayer struct {
+	width  int
+	height int
+	title  string
+	frames []Frame
+	total  time.Duration
+}
+
+// NewAsciicastPlayer parses an asciicast v2 recording from r. Gzip-compressed
+// input, as written for a .cast.gz path, is detected and decompressed. Only
+// output ("o") events become frames; other event types are skipped, but
+// their time still counts towards the next frame's Delay.
+func NewAsciicastPlayer(r io.Reader) (*AsciicastPlayer, error) {
+	if r == nil {
+		return nil, errors.New("reader is required")
+	}
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		br = bufio.NewReader(gz)
+	}
+
+	scanner := bufio.NewScanner(br)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("asciicast: missing header")
+	}
+	var header struct {
+		Version int    `json:"version"`
+		Width   int    `json:"width"`
+		Height  int    `json:"height"`
+		Title   string `json:"title"`
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, fmt.Errorf("asciicast: header: %w", err)
+	}
+	if header.Version != 2 {
+		return nil, fmt.Errorf("asciicast: unsupported version %d", header.Version)
+	}
+	p := &AsciicastPlayer{width: header.Width, height: header.Height, title: header.Title}
+
+	var last time.Duration
+	for line := 2; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		var event []json.RawMessage
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, fmt.Errorf("asciicast: line %d: %w", line, err)
+		}
+		if len(event) != 3 {
+			return nil, fmt.Errorf("asciicast: line %d: expected 3 fields, got %d", line, len(event))
+		}
+		var seconds float64
+		var kind, payload string
+		if err := json.Unmarshal(event[0], &seconds); err != nil {
+			return nil, fmt.Errorf("asciicast: line %d: time: %w", line, err)
+		}
+		if err := json.Unmarshal(event[1], &kind); err != nil {
+			return nil, fmt.Errorf("asciicast: line %d: type: %w", line, err)
+		}
+		if err := json.Unmarshal(event[2], &payload); err != nil {
+			return nil, fmt.Errorf("asciicast: line %d: data: %w", line, err)
+		}
+		at := time.Duration(seconds * float64(time.Second))
+		if at < p.total {
+			at = p.total
+		}
+		p.total = at
+		if kind != "o" {
+			continue
+		}
+		p.frames = append(p.frames, Frame{Delay: at - last, Data: payload})
+		last = at
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Size returns the terminal size from the recording header.
+func (p *AsciicastPlayer) Size() (width, height int) {
+	if p == nil {
+		return 0, 0
+	}
+	return p.width, p.height
+}
+
+// Title returns the recording title, if any.
+func (p *AsciicastPlayer) Title() string {
+	if p == nil {
+		return ""
+	}
+	return p.title
+}
+
+// Frames returns the output frames in order.
+func (p *AsciicastPlayer) Frames() []Frame {
+	if p == nil {
+		return nil
+	}
+	return p.frames
+}
+
+// TotalDuration returns the time of the last event in the recording.
+func (p *AsciicastPlayer) TotalDuration() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return p.total
+}
+
+// Play calls fn for each frame at its recorded time. It returns ctx.Err() if
+// ctx is cancelled before the last frame.
+func (p *AsciicastPlayer) Play(ctx context.Context, fn func(frame Frame)) error {
+	return p.PlayAt(ctx, 1, fn)
+}
+
+// PlayAt is Play with the timing divided by speed, so 2 plays twice as fast
+// and 0.5 at half speed. A speed of zero or less plays at normal speed.
+func (p *AsciicastPlayer) PlayAt(ctx context.Context, speed float64, fn func(frame Frame)) error {
+	if p == nil || fn == nil {
+		return nil
+	}
+	if speed <= 0 {
+		speed = 1
+	}
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	<-timer.C
+
+	// Deadlines are measured from the start so callback time does not drift
+	// the playback.
+	start := time.Now()
+	var at time.Duration
+	for _, frame := range p.frames {
+		at += frame.Delay
+		wait := time.Duration(float64(at)/speed) - time.Since(start)
+		if wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+		fn(frame)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package recording
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+const testCast = `{"version": 2, "width": 10, "height": 3, "title": "demo"}
+[0.1, "o", "a"]
+[0.25, "i", "x"]
+[0.3, "o", "b"]
+[0.5, "o", "c"]
+`
+
+func TestAsciicastPlayerFrames(t *testing.T) {
+	player, err := NewAsciicastPlayer(strings.NewReader(testCast))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if w, h := player.Size(); w != 10 || h != 3 {
+		t.Fatalf("size = %dx%d, want 10x3", w, h)
+	}
+	if player.Title() != "demo" {
+		t.Fatalf("title = %q", player.Title())
+	}
+	want := []Frame{
+		{Delay: 100 * time.Millisecond, Data: "a"},
+		{Delay: 200 * time.Millisecond, Data: "b"},
+		{Delay: 200 * time.Millisecond, Data: "c"},
+	}
+	frames := player.Frames()
+	if len(frames) != len(want) {
+		t.Fatalf("frames = %d, want %d", len(frames), len(want))
+	}
+	for i, frame := range frames {
+		if frame.Data != want[i].Data || (frame.Delay-want[i].Delay).Abs() > time.Millisecond {
+			t.Errorf("frame %d = %+v, want %+v", i, frame, want[i])
+		}
+	}
+	if got := player.TotalDuration(); (got - 500*time.Millisecond).Abs() > time.Millisecond {
+		t.Fatalf("total = %v, want 500ms", got)
+	}
+}
+
+func TestAsciicastPlayerRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	rec := NewAsciicastRecorderWriter(gz, AsciicastOptions{Title: "Test"})
+	now := time.Unix(0, 0)
+	screen := runtime.NewBuffer(2, 1)
+	screen.Set(0, 0, 'A', backend.DefaultStyle())
+	if err := rec.Frame(screen, now); err != nil {
+		t.Fatalf("frame failed: %v", err)
+	}
+	screen.Set(1, 0, 'B', backend.DefaultStyle())
+	if err := rec.Frame(screen, now.Add(250*time.Millisecond)); err != nil {
+		t.Fatalf("frame failed: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("gzip close failed: %v", err)
+	}
+
+	player, err := NewAsciicastPlayer(&buf)
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	frames := player.Frames()
+	if len(frames) != 2 {
+		t.Fatalf("frames = %d, want 2", len(frames))
+	}
+	if !strings.Contains(frames[0].Data, "A") || !strings.Contains(frames[1].Data, "B") {
+		t.Fatalf("unexpected frame data: %q", frames)
+	}
+	if frames[1].Delay != 250*time.Millisecond {
+		t.Fatalf("second delay = %v, want 250ms", frames[1].Delay)
+	}
+}
+
+func TestAsciicastPlayerPlayAt(t *testing.T) {
+	player, err := NewAsciicastPlayer(strings.NewReader(testCast))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	var got []string
+	start := time.Now()
+	if err := player.PlayAt(context.Background(), 10, func(frame Frame) {
+		got = append(got, frame.Data)
+	}); err != nil {
+		t.Fatalf("play failed: %v", err)
+	}
+	elapsed := time.Since(start)
+	if strings.Join(got, "") != "abc" {
+		t.Fatalf("played %q, want abc", got)
+	}
+	if elapsed < 45*time.Millisecond || elapsed > 400*time.Millisecond {
+		t.Fatalf("playback at 10x took %v, want about 50ms", elapsed)
+	}
+}
+
+func TestAsciicastPlayerCancel(t *testing.T) {
+	player, err := NewAsciicastPlayer(strings.NewReader(testCast))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	var got []string
+	err = player.Play(ctx, func(frame Frame) {
+		got = append(got, frame.Data)
+		cancel()
+	})
+	if err != context.Canceled {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("played %d frames after cancel, want 1", len(got))
+	}
+}
+
+func TestAsciicastPlayerRejectsBadInput(t *testing.T) {
+	for name, input := range map[string]string{
+		"empty":   "",
+		"version": `{"version": 1}`,
+		"event":   "{\"version\": 2}\n[0.1, \"o\"]\n",
+	} {
+		if _, err := NewAsciicastPlayer(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package recording
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// PNGOptions configures PNG snapshot rendering.
+type PNGOptions struct {
+	// FontPath is a TrueType/OpenType font file. Empty uses a built-in 7x13 bitmap font.
+	FontPath string
+	// CellWidth and CellHeight set the pixel size of a cell (default 8x16).
+	CellWidth  int
+	CellHeight int
+}
+
+var (
+	defaultPNGForeground = color.RGBA{R: 229, G: 229, B: 229, A: 255}
+	defaultPNGBackground = color.RGBA{A: 255}
+)
+
+// BufferToPNG rasterizes a buffer into a PNG image.
+// Each cell is drawn at cellW x cellH pixels using the font at fontPath.
+func BufferToPNG(buf *runtime.Buffer, fontPath string, cellW, cellH int) ([]byte, error) {
+	if buf == nil {
+		return nil, fmt.Errorf("buffer is required")
+	}
+	if cellW <= 0 {
+		cellW = 8
+	}
+	if cellH <= 0 {
+		cellH = 16
+	}
+	face, err := loadPNGFace(fontPath, cellH)
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+
+	cols, rows := buf.Size()
+	img := image.NewRGBA(image.Rect(0, 0, cols*cellW, rows*cellH))
+	metrics := face.Metrics()
+	ascent := metrics.Ascent.Ceil()
+	glyphHeight := ascent + metrics.Descent.Ceil()
+	baseline := (cellH-glyphHeight)/2 + ascent
+
+	drawer := &font.Drawer{Dst: img, Face: face}
+	cells := buf.Cells()
+	for y := 0; y < rows; y++ {
+		for x := 0; x < cols; x++ {
+			cell := cells[y*cols+x]
+			fg, bg := pngCellColors(cell.Style)
+			rect := image.Rect(x*cellW, y*cellH, (x+1)*cellW, (y+1)*cellH)
+			draw.Draw(img, rect, image.NewUniform(bg), image.Point{}, draw.Src)
+
+			attrs := cell.Style.Attributes()
+			if attrs&backend.AttrUnderline != 0 {
+				line := image.Rect(rect.Min.X, rect.Max.Y-1, rect.Max.X, rect.Max.Y)
+				draw.Draw(img, line, image.NewUniform(fg), image.Point{}, draw.Src)
+			}
+			if cell.Rune == 0 || cell.Rune == ' ' || cell.Rune == backend.CellContinuation {
+				continue
+			}
+			drawer.Src = image.NewUniform(fg)
+			drawer.Dot = fixed.P(rect.Min.X, rect.Min.Y+baseline)
+			drawer.DrawString(string(cell.Rune))
+			if attrs&backend.AttrBold != 0 {
+				drawer.Dot = fixed.P(rect.Min.X+1, rect.Min.Y+baseline)
+				drawer.DrawString(string(cell.Rune))
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	if err := png.Encode(&out, img); err != nil {
+		return nil, fmt.Errorf("encode png: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+func loadPNGFace(fontPath string, cellH int) (font.Face, error) {
+	if fontPath == "" {
+		return basicfont.Face7x13, nil
+	}
+	data, err := os.ReadFile(fontPath)
+	if err != nil {
+		return nil, fmt.Errorf("read font: %w", err)
+	}
+	parsed, err := opentype.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse font: %w", err)
+	}
+	face, err := opentype.NewFace(parsed, &opentype.FaceOptions{
+		Size:    float64(cellH) * 0.8,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create font face: %w", err)
+	}
+	return face, nil
+}
+
+func pngCellColors(style backend.Style) (fg, bg color.RGBA) {
+	fgColor, bgColor, attrs := style.Decompose()
+	fg = colorToRGBA(fgColor, defaultPNGForeground)
+	bg = colorToRGBA(bgColor, defaultPNGBackground)
+	if attrs&backend.AttrReverse != 0 {
+		fg, bg = bg, fg
+	}
+	if attrs&backend.AttrDim != 0 {
+		fg = color.RGBA{R: fg.R / 2, G: fg.G / 2, B: fg.B / 2, A: 255}
+	}
+	return fg, bg
+}
+
+// colorToRGBA converts a terminal color using the xterm 256-color palette.
+func colorToRGBA(c backend.Color, fallback color.RGBA) color.RGBA {
+	r, g, b, ok := c.Resolve()
+	if !ok {
+		return fallback
+	}
+	return color.RGBA{R: r, G: g, B: b, A: 255}
+}
@@ -0,0 +1,52 @@
+package recording
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+func TestBufferToPNG(t *testing.T) {
+	buf := runtime.NewBuffer(3, 2)
+	buf.Set(0, 0, 'A', backend.DefaultStyle())
+	buf.Set(1, 0, ' ', backend.DefaultStyle().Background(backend.ColorRed))
+
+	data, err := BufferToPNG(buf, "", 8, 16)
+	if err != nil {
+		t.Fatalf("BufferToPNG failed: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("invalid png: %v", err)
+	}
+	if got := img.Bounds().Size(); got.X != 24 || got.Y != 32 {
+		t.Fatalf("image size = %v, want 24x32", got)
+	}
+
+	r, g, b, _ := img.At(12, 8).RGBA()
+	if r>>8 < 200 || g>>8 > 20 || b>>8 > 20 {
+		t.Fatalf("expected red pixel in red cell, got %d,%d,%d", r>>8, g>>8, b>>8)
+	}
+
+	lit := false
+	for y := 0; y < 16 && !lit; y++ {
+		for x := 0; x < 8; x++ {
+			if r, _, _, _ := img.At(x, y).RGBA(); r > 0 {
+				lit = true
+				break
+			}
+		}
+	}
+	if !lit {
+		t.Fatal("expected glyph pixels in first cell")
+	}
+}
+
+func TestBufferToPNGMissingFont(t *testing.T) {
+	if _, err := BufferToPNG(runtime.NewBuffer(1, 1), "missing.ttf", 8, 16); err == nil {
+		t.Fatal("expected error for missing font")
+	}
+}
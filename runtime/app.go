@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -35,12 +36,40 @@ type AppConfig struct {
 	KeyHandler        KeyHandler
 	Announcer         accessibility.Announcer
 	Clipboard         clipboard.Clipboard
+	ImageClipboard    clipboard.ImageClipboard
 	FocusStyle        *accessibility.FocusStyle
 	Recorder          Recorder
 	RenderObserver    RenderObserver
 	FocusRegistration FocusRegistrationMode
+	// EventLog records messages handled by DefaultUpdate for debugging.
+	EventLog *EventLog
+	// NoColor disables colour output for this app even when NO_COLOR is
+	// unset. Other apps in the process are not affected.
+	NoColor bool
+	// Capabilities overrides terminal feature detection.
+	Capabilities *terminal.Capabilities
+	// DetectHighContrast enables high-contrast mode at startup when the
+	// backend reports that the terminal prefers it. Detection may query the
+	// terminal, so it is off unless requested.
+	DetectHighContrast bool
+	// Plugins are created and initialised when Run starts and shut down
+	// when it returns.
+	Plugins []PluginFactory
+	// PProfAddr, when set, serves net/http/pprof on this address while Run is active.
+	PProfAddr string
+	// RecoverRender recovers panics raised while rendering the widget tree.
+	// The failed widget's bounds show the error and the app keeps running.
+	RecoverRender bool
+	// ErrorHandler receives ErrorMsg values. Return true to request a render.
+	ErrorHandler ErrorHandler
+	// Logger records errors that no ErrorHandler handled.
+	Logger *log.Logger
 }
 
+// ErrorHandler handles errors posted as ErrorMsg. Return false to fall back
+// to logging the error.
+type ErrorHandler func(err error, source string) bool
+
 // App runs a widget tree against a terminal backend.
 type App struct {
 	backend           backend.Backend
@@ -57,14 +86,35 @@ type App struct {
 	invalidator       *Invalidator
 	announcer         accessibility.Announcer
 	clipboard         clipboard.Clipboard
+	imageClipboard    clipboard.ImageClipboard
 	focusStyle        *accessibility.FocusStyle
 	recorder          Recorder
 	renderObserver    RenderObserver
 	focusRegistration FocusRegistrationMode
+	recoverRender     bool
+	lastRenderError   *RenderError
+	macroRecorder     *MacroRecorder
+	layerCycleKey     terminal.Key
+	eventLog          *EventLog
+	pprofAddr         string
+	pprofURL          atomic.Value
+	highContrast      atomic.Bool
+	highContrastSet   atomic.Bool
+	detectContrast    bool
+	capabilities      terminal.Capabilities
+	detectDA          bool
+	plugins           []PluginFactory
+	observerMu        sync.Mutex
+	observers         map[int]func(Message)
+	nextObserver      int
 	taskCtx           context.Context
 	taskCancel        context.CancelFunc
 	pendingMu         sync.Mutex
 	pendingEffects    []Effect
+	errorHandler      ErrorHandler
+	logger            *log.Logger
+	noColor           bool
+	noColorCells      []Cell
 
 	running     bool
 	dirty       bool
@@ -95,14 +145,29 @@ func NewApp(cfg AppConfig) *App {
 		flushPolicy:       policy,
 		announcer:         cfg.Announcer,
 		clipboard:         cfg.Clipboard,
+		imageClipboard:    cfg.ImageClipboard,
 		focusStyle:        cfg.FocusStyle,
 		recorder:          cfg.Recorder,
 		renderObserver:    cfg.RenderObserver,
 		focusRegistration: cfg.FocusRegistration,
+		recoverRender:     cfg.RecoverRender,
+		eventLog:          cfg.EventLog,
+		pprofAddr:         cfg.PProfAddr,
+		plugins:           cfg.Plugins,
+		errorHandler:      cfg.ErrorHandler,
+		logger:            cfg.Logger,
+		noColor:           cfg.NoColor,
+		detectContrast:    cfg.DetectHighContrast,
 	}
 	if app.flushPolicy == 0 {
 		app.flushPolicy = FlushOnMessageAndTick
 	}
+	if cfg.Capabilities != nil {
+		app.capabilities = *cfg.Capabilities
+	} else {
+		app.capabilities = terminal.Detect()
+		app.detectDA = true
+	}
 	app.queueScheduler = NewQueueScheduler(queue, app.tryPost)
 	app.invalidator = NewInvalidator(app.tryPost)
 	return app
@@ -113,6 +178,17 @@ func (a *App) Screen() *Screen {
 	return a.screen
 }
 
+// LastRenderError returns the most recent recovered render panic, if any.
+// Only populated when AppConfig.RecoverRender is enabled.
+func (a *App) LastRenderError() *RenderError {
+	if a == nil {
+		return nil
+	}
+	a.renderMu.Lock()
+	defer a.renderMu.Unlock()
+	return a.lastRenderError
+}
+
 // StateQueue returns the app's state queue.
 func (a *App) StateQueue() *state.Queue {
 	if a == nil {
@@ -175,6 +251,11 @@ func (a *App) Every(interval time.Duration, fn func(time.Time) Message) {
 	a.Spawn(Every(interval, fn))
 }
 
+// EveryAfter schedules a recurring message whose first tick fires after delay.
+func (a *App) EveryAfter(delay, interval time.Duration, fn func(time.Time) Message) {
+	a.Spawn(EveryAfter(delay, interval, fn))
+}
+
 // SetRoot swaps the root widget.
 func (a *App) SetRoot(root Widget) {
 	a.root = root
@@ -184,6 +265,30 @@ func (a *App) SetRoot(root Widget) {
 	}
 }
 
+// SetBackend sets the backend used by the next Run, for apps built before
+// their terminal exists, such as one per network connection.
+func (a *App) SetBackend(b backend.Backend) {
+	a.backend = b
+}
+
+// SetMacroRecorder attaches a recorder that captures keys handled by DefaultUpdate.
+func (a *App) SetMacroRecorder(r *MacroRecorder) {
+	if a == nil {
+		return
+	}
+	a.macroRecorder = r
+}
+
+// SetLayerCycle registers key, pressed with Alt, as a global shortcut that
+// cycles focus between overlay layers. Adding Shift cycles backwards.
+// Pass terminal.KeyNone to disable it.
+func (a *App) SetLayerCycle(key terminal.Key) {
+	if a == nil {
+		return
+	}
+	a.layerCycleKey = key
+}
+
 // Post sends a message to the event loop.
 func (a *App) Post(msg Message) {
 	_ = a.tryPost(msg)
@@ -222,16 +327,32 @@ func (a *App) Run(ctx context.Context) error {
 		a.taskCtx = nil
 		a.taskCancel = nil
 	}()
+	if a.pprofAddr != "" {
+		stopPProf, err := a.startPProf(taskCtx, a.pprofAddr)
+		if err != nil {
+			return fmt.Errorf("start pprof: %w", err)
+		}
+		defer stopPProf()
+	}
+	// Detect before Init so a terminal query finishes before the backend
+	// starts reading input.
+	if a.detectContrast {
+		a.detectHighContrast()
+	}
 	if err := a.backend.Init(); err != nil {
 		return fmt.Errorf("init backend: %w", err)
 	}
 	defer a.backend.Fini()
+	if reporter, ok := a.backend.(backend.DeviceAttributesReporter); ok && a.detectDA {
+		a.capabilities.ApplyDA(reporter.DeviceAttributes())
+	}
 
 	a.backend.HideCursor()
 	w, h := a.backend.Size()
 	a.screen = NewScreen(w, h)
 	a.screen.SetServices(a.Services())
 	a.screen.SetAutoRegisterFocus(a.focusRegistration == FocusRegistrationAuto)
+	a.screen.SetRecoverRender(a.recoverRender)
 	if a.root != nil {
 		a.screen.SetRoot(a.root)
 	}
@@ -248,6 +369,14 @@ func (a *App) Run(ctx context.Context) error {
 		a.update = DefaultUpdate
 	}
 
+	stopPlugins, err := a.startPlugins()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = stopPlugins()
+	}()
+
 	a.running = true
 	a.dirty = true
 
@@ -270,11 +399,13 @@ func (a *App) Run(ctx context.Context) error {
 			a.running = false
 			a.cancelTasks()
 		case msg = <-a.messages:
+			a.notifyObservers(msg)
 			if a.update(a, msg) {
 				a.dirty = true
 			}
 		case now := <-ticks:
 			msg = TickMsg{Time: now}
+			a.notifyObservers(msg)
 			if a.update(a, msg) {
 				a.dirty = true
 			}
@@ -303,10 +434,16 @@ func (a *App) Run(ctx context.Context) error {
 }
 
 // DefaultUpdate handles input messages and widget commands.
-func DefaultUpdate(app *App, msg Message) bool {
+func DefaultUpdate(app *App, msg Message) (dirty bool) {
 	if app == nil || app.screen == nil {
 		return false
 	}
+	if app.eventLog != nil {
+		app.eventLog.record(msg)
+		// Dispatched messages complete with the widget result first; every
+		// other path completes here with the return value.
+		defer func() { app.eventLog.complete(dirty, nil) }()
+	}
 
 	switch m := msg.(type) {
 	case ResizeMsg:
@@ -316,6 +453,18 @@ func DefaultUpdate(app *App, msg Message) bool {
 		}
 		return true
 	case KeyMsg:
+		if app.macroRecorder != nil {
+			app.macroRecorder.record(m)
+		}
+		if app.layerCycleKey != terminal.KeyNone && m.Key == app.layerCycleKey && m.Alt {
+			direction := 1
+			if m.Shift {
+				direction = -1
+			}
+			if app.screen.CycleLayer(direction) {
+				return true
+			}
+		}
 		if app.keyHandler != nil {
 			var focused Widget
 			if scope := app.screen.FocusScope(); scope != nil {
@@ -325,21 +474,70 @@ func DefaultUpdate(app *App, msg Message) bool {
 				return true
 			}
 		}
+		if app.dispatchMessage(msg) {
+			return true
+		}
+		if isSelectAllKey(m) {
+			app.Post(SelectAllMsg{})
+		}
+		return false
+	case MouseMsg:
+		if handler, ok := app.keyHandler.(MouseHandler); ok && handler.HandleMouse(app, m, app.screen.WidgetAt(m.X, m.Y)) {
+			return true
+		}
 		return app.dispatchMessage(msg)
+	case ErrorMsg:
+		return app.handleError(m)
 	case QueueFlushMsg:
 		return false
 	case InvalidateMsg:
 		return true
+	case HighContrastChangedMsg:
+		app.screen.applyHighContrast(m.Enabled)
+		return true
+	case ThemeChangedMsg:
+		app.screen.applyTheme()
+		return true
 	default:
 		return app.dispatchMessage(msg)
 	}
 }
 
+// handleError passes an ErrorMsg to the error handler, logging it when the
+// handler is missing or declines it.
+func (a *App) handleError(msg ErrorMsg) bool {
+	if msg.Err == nil {
+		return false
+	}
+	if a.errorHandler != nil && a.errorHandler(msg.Err, msg.Source) {
+		return true
+	}
+	if a.logger != nil {
+		if msg.Source != "" {
+			a.logger.Printf("%s: %v", msg.Source, msg.Err)
+		} else {
+			a.logger.Printf("error: %v", msg.Err)
+		}
+	}
+	return false
+}
+
+// isSelectAllKey reports whether key is Ctrl+A.
+func isSelectAllKey(key KeyMsg) bool {
+	if key.Key == terminal.KeyCtrlA {
+		return true
+	}
+	return key.Key == terminal.KeyRune && key.Ctrl && (key.Rune == 'a' || key.Rune == 'A')
+}
+
 func (a *App) dispatchMessage(msg Message) bool {
 	if a == nil || a.screen == nil {
 		return false
 	}
 	result := a.screen.HandleMessage(msg)
+	if a.eventLog != nil {
+		a.eventLog.complete(result.Handled, result.Commands)
+	}
 	dirty := result.Handled
 	for _, cmd := range result.Commands {
 		if a.handleCommand(cmd) {
@@ -439,6 +637,9 @@ func (a *App) render() {
 		renderStart = time.Now()
 	}
 	a.screen.Render()
+	if err := a.screen.takeRenderError(); err != nil {
+		a.lastRenderError = err
+	}
 	if observer != nil {
 		stats.RenderDuration = time.Since(renderStart)
 	}
@@ -463,6 +664,9 @@ func (a *App) render() {
 		rowWriter, hasRowWriter := a.backend.(backend.RowWriter)
 		rectWriter, hasRectWriter := a.backend.(backend.RectWriter)
 		cells := buf.Cells()
+		if a.noColor {
+			cells = a.stripColors(cells)
+		}
 		flushedCells := 0
 		flushStart := time.Time{}
 		if observer != nil {
@@ -482,7 +686,9 @@ func (a *App) render() {
 					rowStart := y * w
 					row := cells[rowStart : rowStart+w]
 					for x, cell := range row {
-						a.backend.SetContent(x, y, cell.Rune, nil, cell.Style)
+						if cell.Rune != backend.CellContinuation {
+							a.backend.SetContent(x, y, cell.Rune, nil, cell.Style)
+						}
 					}
 				}
 			}
@@ -504,7 +710,9 @@ func (a *App) render() {
 				})
 			} else {
 				buf.ForEachDirtyCell(func(x, y int, cell Cell) {
-					a.backend.SetContent(x, y, cell.Rune, nil, cell.Style)
+					if cell.Rune != backend.CellContinuation {
+						a.backend.SetContent(x, y, cell.Rune, nil, cells[y*w+x].Style)
+					}
 				})
 				flushedCells = dirtyCount
 			}
@@ -529,6 +737,20 @@ func (a *App) render() {
 	}
 }
 
+// stripColors copies cells with colours removed, for AppConfig.NoColor.
+// Colour is dropped only on output so other apps in the process keep it.
+func (a *App) stripColors(cells []Cell) []Cell {
+	if cap(a.noColorCells) < len(cells) {
+		a.noColorCells = make([]Cell, len(cells))
+	}
+	out := a.noColorCells[:len(cells)]
+	for i, cell := range cells {
+		cell.Style = cell.Style.WithoutColor()
+		out[i] = cell
+	}
+	return out
+}
+
 func (a *App) taskContext() context.Context {
 	if a != nil && a.taskCtx != nil {
 		return a.taskCtx
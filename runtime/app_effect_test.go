@@ -1,7 +1,10 @@
 package runtime
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"log"
 	"testing"
 	"time"
 )
@@ -69,3 +72,48 @@ func TestApp_SpawnPendingEffect(t *testing.T) {
 		t.Fatal("expected pending effect to run")
 	}
 }
+
+func TestDefaultUpdate_ErrorMsg(t *testing.T) {
+	var gotErr error
+	var gotSource string
+	app := NewApp(AppConfig{
+		ErrorHandler: func(err error, source string) bool {
+			gotErr, gotSource = err, source
+			return true
+		},
+	})
+	app.screen = NewScreen(10, 5)
+
+	boom := errors.New("boom")
+	effect := Effect{Run: func(ctx context.Context, post PostFunc) {
+		post(ErrorMsg{Err: boom, Source: "fetch"})
+	}}
+	effect.Run(context.Background(), app.tryPost)
+	msg := <-app.messages
+	if !DefaultUpdate(app, msg) {
+		t.Fatal("expected a handled error to request a render")
+	}
+	if gotErr != boom || gotSource != "fetch" {
+		t.Fatalf("handler got %v from %q, want boom from fetch", gotErr, gotSource)
+	}
+}
+
+func TestDefaultUpdate_ErrorMsgLogsWithoutHandler(t *testing.T) {
+	app := NewApp(AppConfig{})
+	app.screen = NewScreen(10, 5)
+	if DefaultUpdate(app, ErrorMsg{Err: errors.New("boom")}) {
+		t.Fatal("unhandled error should not request a render")
+	}
+
+	var out bytes.Buffer
+	app = NewApp(AppConfig{
+		Logger:       log.New(&out, "", 0),
+		ErrorHandler: func(error, string) bool { return false },
+	})
+	app.screen = NewScreen(10, 5)
+	app.Services().PostError(errors.New("disk full"), "save")
+	DefaultUpdate(app, <-app.messages)
+	if got := out.String(); got != "save: disk full\n" {
+		t.Fatalf("log = %q, want %q", got, "save: disk full\n")
+	}
+}
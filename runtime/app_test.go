@@ -2,6 +2,8 @@ package runtime
 
 import (
 	"context"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -206,3 +208,251 @@ func waitForBounds(t *testing.T, ch <-chan Rect, width, height int) {
 		}
 	}
 }
+
+type panicRenderWidget struct {
+	appTestWidget
+}
+
+func (w *panicRenderWidget) Render(ctx RenderContext) {
+	panic("boom")
+}
+
+func TestApp_RecoverRender(t *testing.T) {
+	be := sim.New(20, 3)
+	w := &panicRenderWidget{appTestWidget{
+		keyCommands: map[rune]Command{'q': Quit{}},
+	}}
+
+	app := NewApp(AppConfig{
+		Backend:       be,
+		Root:          w,
+		RecoverRender: true,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+
+	waitForScreen(t, app)
+	app.Post(InvalidateMsg{})
+
+	deadline := time.After(500 * time.Millisecond)
+	for app.LastRenderError() == nil {
+		select {
+		case err := <-done:
+			t.Fatalf("app exited after render panic: %v", err)
+		case <-deadline:
+			t.Fatal("expected render error to be recorded")
+		default:
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	renderErr := app.LastRenderError()
+	if renderErr.Value != "boom" || len(renderErr.Stack) == 0 {
+		t.Fatalf("unexpected render error: %+v", renderErr)
+	}
+	if !be.ContainsText("render panic: boom") {
+		t.Fatalf("expected error text on screen, got %q", be.Capture())
+	}
+	_, _, style := be.CaptureCell(0, 0)
+	if fg, _, _ := style.Decompose(); fg != backend.ColorRed {
+		t.Fatalf("expected red error text, got fg %v", fg)
+	}
+
+	app.Post(KeyMsg{Key: terminal.KeyRune, Rune: 'q'})
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run returned error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("app did not keep running after render panic")
+	}
+}
+
+func TestApp_PProfEndpoint(t *testing.T) {
+	be := sim.New(5, 3)
+	app := NewApp(AppConfig{
+		Backend:   be,
+		Root:      &appTestWidget{renderChar: 'X'},
+		PProfAddr: "127.0.0.1:0",
+	})
+	if app.PProfURL() != "" {
+		t.Fatal("PProfURL should be empty before Run")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	waitForScreen(t, app)
+
+	url := app.PProfURL()
+	if !strings.HasSuffix(url, "/debug/pprof/") {
+		t.Fatalf("PProfURL = %q", url)
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not exit")
+	}
+	if app.PProfURL() != "" {
+		t.Fatal("PProfURL should be empty after Run")
+	}
+	if resp, err := http.Get(url); err == nil {
+		resp.Body.Close()
+		t.Fatal("pprof server still reachable after exit")
+	}
+}
+
+func TestDefaultUpdate_LayerCycleKey(t *testing.T) {
+	app := NewApp(AppConfig{})
+	app.screen = NewScreen(10, 5)
+	app.screen.SetRoot(&nonHandlingWidget{})
+	app.screen.PushLayer(&nonHandlingWidget{}, false)
+	app.screen.PushLayer(&nonHandlingWidget{}, false)
+	app.SetLayerCycle(terminal.KeyTab)
+
+	if DefaultUpdate(app, KeyMsg{Key: terminal.KeyTab}) && app.screen.ActiveLayer() != app.screen.TopLayer() {
+		t.Fatal("plain Tab should not cycle layers")
+	}
+	if !DefaultUpdate(app, KeyMsg{Key: terminal.KeyTab, Alt: true}) {
+		t.Fatal("expected Alt+Tab to be handled")
+	}
+	if app.screen.ActiveLayer() == app.screen.TopLayer() {
+		t.Fatal("expected Alt+Tab to raise the previous overlay")
+	}
+}
+
+// mouseKeyHandler claims right-clicks and records the target it was given.
+type mouseKeyHandler struct {
+	target Widget
+}
+
+func (h *mouseKeyHandler) HandleKey(app *App, msg KeyMsg, focused Widget) bool { return false }
+
+func (h *mouseKeyHandler) HandleMouse(app *App, msg MouseMsg, target Widget) bool {
+	h.target = target
+	return msg.Button == MouseRight
+}
+
+type hitWidget struct {
+	nonHandlingWidget
+}
+
+func (w *hitWidget) Bounds() Rect { return w.bounds }
+
+func TestDefaultUpdate_MouseHandler(t *testing.T) {
+	handler := &mouseKeyHandler{}
+	app := NewApp(AppConfig{KeyHandler: handler})
+	app.screen = NewScreen(10, 5)
+	root := &hitWidget{}
+	app.screen.SetRoot(root)
+
+	if !DefaultUpdate(app, MouseMsg{X: 2, Y: 1, Button: MouseRight, Action: MousePress}) {
+		t.Fatal("expected the mouse handler to claim the right-click")
+	}
+	if handler.target != root {
+		t.Fatalf("target = %v, want the widget under the pointer", handler.target)
+	}
+	DefaultUpdate(app, MouseMsg{X: 2, Y: 1, Button: MouseLeft, Action: MousePress})
+	if root.handleCalls == 0 {
+		t.Fatal("unclaimed clicks should reach the widget")
+	}
+}
+
+func TestDefaultUpdate_CtrlAPostsSelectAll(t *testing.T) {
+	app := NewApp(AppConfig{})
+	app.screen = NewScreen(10, 5)
+	app.screen.SetRoot(&nonHandlingWidget{})
+
+	DefaultUpdate(app, KeyMsg{Key: terminal.KeyCtrlA})
+	select {
+	case msg := <-app.messages:
+		if _, ok := msg.(SelectAllMsg); !ok {
+			t.Fatalf("posted %T, want SelectAllMsg", msg)
+		}
+	default:
+		t.Fatal("expected SelectAllMsg to be posted")
+	}
+
+	DefaultUpdate(app, KeyMsg{Key: terminal.KeyRune, Rune: 'a'})
+	select {
+	case msg := <-app.messages:
+		t.Fatalf("unexpected message %T for plain 'a'", msg)
+	default:
+	}
+}
+
+// colorWidget draws a red 'C' in its top-left cell.
+type colorWidget struct{ appTestWidget }
+
+func (w *colorWidget) Render(ctx RenderContext) {
+	ctx.Buffer.Set(ctx.Bounds.X, ctx.Bounds.Y, 'C', backend.DefaultStyle().Foreground(backend.ColorRed).Bold(true))
+}
+
+// renderedStyle runs an app until it draws its first frame and returns the
+// style of the top-left cell.
+func renderedStyle(t *testing.T, noColor bool) backend.Style {
+	t.Helper()
+	be := sim.New(5, 1)
+	app := NewApp(AppConfig{Backend: be, Root: &colorWidget{}, NoColor: noColor})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	waitForScreen(t, app)
+	app.Post(InvalidateMsg{})
+
+	deadline := time.After(500 * time.Millisecond)
+	for {
+		if r, _, style := be.CaptureCell(0, 0); r == 'C' {
+			cancel()
+			<-done
+			return style
+		}
+		select {
+		case <-deadline:
+			t.Fatal("app did not render in time")
+		default:
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+}
+
+func TestApp_NoColorIsPerApp(t *testing.T) {
+	if !backend.IsColorEnabled() {
+		t.Skip("NO_COLOR is set for this process")
+	}
+
+	plain := renderedStyle(t, true)
+	if fg, _, attrs := plain.Decompose(); fg != backend.ColorDefault || attrs&backend.AttrBold == 0 {
+		t.Fatalf("NoColor app fg = %v attrs = %v, want default colour and bold kept", fg, attrs)
+	}
+	colored := renderedStyle(t, false)
+	if fg, _, _ := colored.Decompose(); fg != backend.ColorRed {
+		t.Fatalf("colour app fg = %v after a NoColor app, want red", fg)
+	}
+	if !backend.IsColorEnabled() {
+		t.Fatal("NoColor app disabled colour process-wide")
+	}
+}
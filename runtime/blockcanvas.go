@@ -0,0 +1,146 @@
+package runtime
+
+import "github.com/odvcencio/fluffy-ui/backend"
+
+// quadrantRunes maps a 4-bit mask (top-left=1, top-right=2, bottom-left=4,
+// bottom-right=8) to the matching Unicode quadrant block.
+var quadrantRunes = [16]rune{
+	' ', '▘', '▝', '▀', '▖', '▌', '▞', '▛',
+	'▗', '▚', '▐', '▜', '▄', '▙', '▟', '█',
+}
+
+// brailleBits maps [row][col] within a 2x4 cell to its braille dot bit.
+var brailleBits = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// BlockCanvas is a virtual pixel grid drawn with block or braille characters.
+// Block canvases have 2x2 pixels per cell; braille canvases have 2x4.
+type BlockCanvas struct {
+	cols    int
+	rows    int
+	cellH   int
+	braille bool
+	pixels  []bool
+	style   backend.Style
+}
+
+// NewBlockCanvas creates a canvas covering cols x rows cells using quadrant
+// blocks, giving a cols*2 x rows*2 pixel grid.
+func NewBlockCanvas(cols, rows int) *BlockCanvas {
+	return newBlockCanvas(cols, rows, 2, false)
+}
+
+// NewBrailleCanvas creates a canvas covering cols x rows cells using braille
+// patterns, giving a cols*2 x rows*4 pixel grid.
+func NewBrailleCanvas(cols, rows int) *BlockCanvas {
+	return newBlockCanvas(cols, rows, 4, true)
+}
+
+func newBlockCanvas(cols, rows, cellH int, braille bool) *BlockCanvas {
+	cols = max(0, cols)
+	rows = max(0, rows)
+	return &BlockCanvas{
+		cols:    cols,
+		rows:    rows,
+		cellH:   cellH,
+		braille: braille,
+		pixels:  make([]bool, cols*2*rows*cellH),
+		style:   backend.DefaultStyle(),
+	}
+}
+
+// Size returns the pixel dimensions of the canvas.
+func (c *BlockCanvas) Size() (w, h int) {
+	if c == nil {
+		return 0, 0
+	}
+	return c.cols * 2, c.rows * c.cellH
+}
+
+// SetStyle sets the style used for rendered cells.
+func (c *BlockCanvas) SetStyle(style backend.Style) {
+	if c == nil {
+		return
+	}
+	c.style = style
+}
+
+// SetPixel turns a pixel on or off. Out-of-range pixels are ignored.
+func (c *BlockCanvas) SetPixel(x, y int, on bool) {
+	if c == nil {
+		return
+	}
+	w, h := c.Size()
+	if x < 0 || x >= w || y < 0 || y >= h {
+		return
+	}
+	c.pixels[y*w+x] = on
+}
+
+// Pixel reports whether a pixel is on.
+func (c *BlockCanvas) Pixel(x, y int) bool {
+	if c == nil {
+		return false
+	}
+	w, h := c.Size()
+	if x < 0 || x >= w || y < 0 || y >= h {
+		return false
+	}
+	return c.pixels[y*w+x]
+}
+
+// Clear turns every pixel off.
+func (c *BlockCanvas) Clear() {
+	if c == nil {
+		return
+	}
+	clear(c.pixels)
+}
+
+// Render writes the canvas to buf with its top-left cell at (xOff, yOff).
+func (c *BlockCanvas) Render(buf *Buffer, xOff, yOff int) {
+	if c == nil || buf == nil {
+		return
+	}
+	for row := 0; row < c.rows; row++ {
+		for col := 0; col < c.cols; col++ {
+			buf.Set(xOff+col, yOff+row, c.cellRune(col, row), c.style)
+		}
+	}
+}
+
+func (c *BlockCanvas) cellRune(col, row int) rune {
+	px, py := col*2, row*c.cellH
+	if c.braille {
+		var bits rune
+		for dy := 0; dy < 4; dy++ {
+			for dx := 0; dx < 2; dx++ {
+				if c.Pixel(px+dx, py+dy) {
+					bits |= brailleBits[dy][dx]
+				}
+			}
+		}
+		if bits == 0 {
+			return ' '
+		}
+		return 0x2800 + bits
+	}
+	mask := 0
+	if c.Pixel(px, py) {
+		mask |= 1
+	}
+	if c.Pixel(px+1, py) {
+		mask |= 2
+	}
+	if c.Pixel(px, py+1) {
+		mask |= 4
+	}
+	if c.Pixel(px+1, py+1) {
+		mask |= 8
+	}
+	return quadrantRunes[mask]
+}
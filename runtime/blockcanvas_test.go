@@ -0,0 +1,44 @@
+package runtime
+
+import "testing"
+
+func TestBlockCanvas_TopHalf(t *testing.T) {
+	canvas := NewBlockCanvas(1, 1)
+	canvas.SetPixel(0, 0, true)
+	canvas.SetPixel(1, 0, true)
+
+	buf := NewBuffer(2, 1)
+	canvas.Render(buf, 0, 0)
+	if got := buf.Get(0, 0).Rune; got != '▀' {
+		t.Fatalf("cell = %q, want ▀", got)
+	}
+
+	canvas.SetPixel(0, 1, true)
+	canvas.SetPixel(1, 1, true)
+	canvas.Render(buf, 1, 0)
+	if got := buf.Get(1, 0).Rune; got != '█' {
+		t.Fatalf("cell = %q, want █", got)
+	}
+
+	canvas.Clear()
+	canvas.Render(buf, 0, 0)
+	if got := buf.Get(0, 0).Rune; got != ' ' {
+		t.Fatalf("cleared cell = %q, want space", got)
+	}
+}
+
+func TestBlockCanvas_Braille(t *testing.T) {
+	canvas := NewBrailleCanvas(1, 1)
+	if w, h := canvas.Size(); w != 2 || h != 4 {
+		t.Fatalf("size = %dx%d, want 2x4", w, h)
+	}
+	canvas.SetPixel(0, 0, true)
+	canvas.SetPixel(1, 3, true)
+	canvas.SetPixel(5, 5, true) // Out of range is ignored.
+
+	buf := NewBuffer(1, 1)
+	canvas.Render(buf, 0, 0)
+	if got := buf.Get(0, 0).Rune; got != '⢁' {
+		t.Fatalf("cell = %q, want ⢁", got)
+	}
+}
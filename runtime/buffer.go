@@ -9,7 +9,11 @@ package runtime
 // compositor.Screen exists as an alternative for pure-ANSI output but
 // is not used in the tcell backend path.
 
-import "github.com/odvcencio/fluffy-ui/backend"
+import (
+	"github.com/mattn/go-runewidth"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+)
 
 // Cell represents a single character cell in the buffer.
 type Cell = backend.Cell
@@ -31,6 +35,10 @@ type Buffer struct {
 	dirtyIndices     []int // Sparse dirty list for fast iteration
 	dirtyListCap     int   // Max indices to collect before disabling list
 	dirtyListEnabled bool
+
+	// Clipped views share cells with the root buffer and forward writes to it.
+	root *Buffer
+	clip Rect
 }
 
 // NewBuffer creates a buffer with the given dimensions.
@@ -54,8 +62,42 @@ func (b *Buffer) Size() (w, h int) {
 	return b.width, b.height
 }
 
+// Clip returns a view of the buffer that uses the same absolute coordinates
+// but discards writes outside r. Clipping a view intersects the regions.
+func (b *Buffer) Clip(r Rect) *Buffer {
+	if b == nil {
+		return nil
+	}
+	root := b
+	if b.root != nil {
+		root = b.root
+		r = r.Intersection(b.clip)
+	} else {
+		r = r.Intersection(Rect{X: 0, Y: 0, Width: b.width, Height: b.height})
+	}
+	return &Buffer{
+		cells:  root.cells,
+		width:  root.width,
+		height: root.height,
+		root:   root,
+		clip:   r,
+	}
+}
+
+// ClipRect returns the writable region of the buffer.
+func (b *Buffer) ClipRect() Rect {
+	if b.root != nil {
+		return b.clip
+	}
+	return Rect{X: 0, Y: 0, Width: b.width, Height: b.height}
+}
+
 // Resize changes the buffer dimensions, preserving content where possible.
+// Resize is a no-op on clipped views.
 func (b *Buffer) Resize(w, h int) {
+	if b.root != nil {
+		return
+	}
 	if w == b.width && h == b.height {
 		return
 	}
@@ -103,33 +145,114 @@ func (b *Buffer) Get(x, y int) Cell {
 	return b.cells[y*b.width+x]
 }
 
-// Set writes a rune with style at position (x, y).
-// No-op if out of bounds. Marks the cell as dirty if changed.
+// Set writes a rune with style at position (x, y). A double-width rune also
+// claims (x+1, y), which holds backend.CellContinuation; it is replaced by a
+// space when that cell is outside the buffer. Writing over either half of a
+// wide rune blanks the other half. Setting CellContinuation itself only
+// restyles a cell that already is one, so copied cells stay paired.
+// No-op if out of bounds. Marks changed cells as dirty.
 func (b *Buffer) Set(x, y int, r rune, s backend.Style) {
+	if b.root != nil {
+		if b.clip.Contains(x, y) {
+			if runeCells(r) == 2 && !b.clip.Contains(x+1, y) {
+				r = ' '
+			}
+			b.root.Set(x, y, r, s)
+		}
+		return
+	}
 	if x < 0 || x >= b.width || y < 0 || y >= b.height {
 		return
 	}
+	b.setCell(x, y, r, s)
+}
+
+// runeCells returns the number of columns r occupies: 0 for combining marks,
+// 2 for wide characters such as CJK and emoji, and 1 otherwise.
+func runeCells(r rune) int {
+	if r < 0x80 {
+		return 1
+	}
+	return runewidth.RuneWidth(r)
+}
+
+// setCell writes r at an in-bounds (x, y), keeping wide runes paired with
+// their continuation cells.
+func (b *Buffer) setCell(x, y int, r rune, s backend.Style) {
 	idx := y*b.width + x
-	old := b.cells[idx]
-	// Only mark dirty if content actually changed
-	if old.Rune != r || old.Style != s {
-		b.cells[idx] = Cell{Rune: r, Style: s}
+	if r == backend.CellContinuation {
+		if b.cells[idx].Rune == backend.CellContinuation {
+			b.writeCell(x, y, idx, Cell{Rune: r, Style: s})
+		}
+		return
+	}
+	width := 1
+	if runeCells(r) == 2 {
+		if x+1 < b.width {
+			width = 2
+		} else {
+			r = ' '
+		}
+	}
+	if b.cells[idx].Rune == backend.CellContinuation && x > 0 {
+		b.writeCell(x-1, y, idx-1, Cell{Rune: ' ', Style: b.cells[idx-1].Style})
+	}
+	if next := x + width; next < b.width && b.cells[idx+width].Rune == backend.CellContinuation {
+		b.writeCell(next, y, idx+width, Cell{Rune: ' ', Style: b.cells[idx+width].Style})
+	}
+	b.writeCell(x, y, idx, Cell{Rune: r, Style: s})
+	if width == 2 {
+		b.writeCell(x+1, y, idx+1, Cell{Rune: backend.CellContinuation, Style: s})
+	}
+}
+
+// writeCell stores cell at idx, marking it dirty when it changed.
+func (b *Buffer) writeCell(x, y, idx int, cell Cell) {
+	if b.cells[idx] != cell {
+		b.cells[idx] = cell
 		b.markCellDirty(x, y, idx)
 	}
 }
 
-// SetString writes a string starting at (x, y).
-// Clips to buffer bounds. Marks changed cells as dirty.
+// SetString writes a string starting at (x, y), advancing by each rune's
+// display width. Combining marks are dropped, and a wide rune cut off by the
+// edge is replaced by a space. Marks changed cells as dirty.
 func (b *Buffer) SetString(x, y int, s string, style backend.Style) {
+	if b.root != nil {
+		if y < b.clip.Y || y >= b.clip.Y+b.clip.Height {
+			return
+		}
+		right := b.clip.X + b.clip.Width
+		px := x
+		for _, r := range s {
+			width := runeCells(r)
+			if width == 0 {
+				continue
+			}
+			if px >= right {
+				break
+			}
+			switch {
+			case px >= b.clip.X:
+				b.Set(px, y, r, style)
+			case width == 2 && px+1 == b.clip.X:
+				// Only the right half is visible.
+				b.root.Set(px+1, y, ' ', style)
+			}
+			px += width
+		}
+		return
+	}
 	if y < 0 || y >= b.height {
 		return
 	}
 	if x >= b.width {
 		return
 	}
+	px := x
+	i := 0
 	if x >= 0 {
-		px := x
-		i := 0
+		// Fast path for ASCII, which is always one cell wide.
 		for i < len(s) && px < b.width {
 			ch := s[i]
 			if ch >= 0x80 {
@@ -139,8 +262,12 @@ func (b *Buffer) SetString(x, y int, s string, style backend.Style) {
 			old := b.cells[idx]
 			r := rune(ch)
 			if old.Rune != r || old.Style != style {
-				b.cells[idx] = Cell{Rune: r, Style: style}
-				b.markCellDirty(px, y, idx)
+				if old.Rune == backend.CellContinuation || (px+1 < b.width && b.cells[idx+1].Rune == backend.CellContinuation) {
+					b.setCell(px, y, r, style)
+				} else {
+					b.cells[idx] = Cell{Rune: r, Style: style}
+					b.markCellDirty(px, y, idx)
+				}
 			}
 			i++
 			px++
@@ -148,53 +275,51 @@ func (b *Buffer) SetString(x, y int, s string, style backend.Style) {
 		if i >= len(s) {
 			return
 		}
-		offset := x + i
-		for j, r := range s[i:] {
-			px := offset + j
-			if px < 0 {
-				continue
-			}
-			if px >= b.width {
-				break
-			}
-			idx := y*b.width + px
-			old := b.cells[idx]
-			if old.Rune != r || old.Style != style {
-				b.cells[idx] = Cell{Rune: r, Style: style}
-				b.markCellDirty(px, y, idx)
-			}
-		}
-		return
 	}
-	for i, r := range s {
-		px := x + i
-		if px < 0 {
+	for _, r := range s[i:] {
+		width := runeCells(r)
+		if width == 0 {
 			continue
 		}
 		if px >= b.width {
 			break
 		}
-		idx := y*b.width + px
-		old := b.cells[idx]
-		if old.Rune != r || old.Style != style {
-			b.cells[idx] = Cell{Rune: r, Style: style}
-			b.markCellDirty(px, y, idx)
+		switch {
+		case px >= 0:
+			b.setCell(px, y, r, style)
+		case width == 2 && px == -1:
+			b.setCell(0, y, ' ', style)
 		}
+		px += width
 	}
 }
 
-// Fill fills a rectangular region with a rune and style.
+// Fill fills a rectangular region with a rune and style. Wide runes that
+// straddle the edge of the region lose their outside half.
 // Marks changed cells as dirty.
 func (b *Buffer) Fill(r Rect, ch rune, s backend.Style) {
+	if b.root != nil {
+		b.root.Fill(r.Intersection(b.clip), ch, s)
+		return
+	}
 	// Clip to buffer bounds
 	x0 := max(0, r.X)
 	y0 := max(0, r.Y)
 	x1 := min(b.width, r.X+r.Width)
 	y1 := min(b.height, r.Y+r.Height)
+	if x0 >= x1 {
+		return
+	}
 
 	cell := Cell{Rune: ch, Style: s}
 	for y := y0; y < y1; y++ {
 		idx := y*b.width + x0
+		if b.cells[idx].Rune == backend.CellContinuation && x0 > 0 {
+			b.writeCell(x0-1, y, idx-1, Cell{Rune: ' ', Style: b.cells[idx-1].Style})
+		}
+		if end := y*b.width + x1; x1 < b.width && b.cells[end].Rune == backend.CellContinuation {
+			b.writeCell(x1, y, end, Cell{Rune: ' ', Style: b.cells[end].Style})
+		}
 		for x := x0; x < x1; x++ {
 			if b.cells[idx] != cell {
 				b.cells[idx] = cell
@@ -205,53 +330,64 @@ func (b *Buffer) Fill(r Rect, ch rune, s backend.Style) {
 	}
 }
 
-// DrawBox draws a border around a rect using box-drawing characters.
-func (b *Buffer) DrawBox(r Rect, s backend.Style) {
-	if r.Width < 2 || r.Height < 2 {
-		return
-	}
-
-	// Corners
-	b.Set(r.X, r.Y, '┌', s)
-	b.Set(r.X+r.Width-1, r.Y, '┐', s)
-	b.Set(r.X, r.Y+r.Height-1, '└', s)
-	b.Set(r.X+r.Width-1, r.Y+r.Height-1, '┘', s)
+// BoxStyle is the set of characters used to draw a box border.
+type BoxStyle struct {
+	TopLeft     rune
+	TopRight    rune
+	BottomLeft  rune
+	BottomRight rune
+	Horizontal  rune
+	Vertical    rune
+}
 
-	// Horizontal edges
-	for x := r.X + 1; x < r.X+r.Width-1; x++ {
-		b.Set(x, r.Y, '─', s)
-		b.Set(x, r.Y+r.Height-1, '─', s)
-	}
+// Predefined box styles.
+var (
+	// BoxStyleSingle uses light lines with square corners.
+	BoxStyleSingle = BoxStyle{'┌', '┐', '└', '┘', '─', '│'}
+	// BoxStyleDouble uses double lines.
+	BoxStyleDouble = BoxStyle{'╔', '╗', '╚', '╝', '═', '║'}
+	// BoxStyleHeavy uses heavy lines.
+	BoxStyleHeavy = BoxStyle{'┏', '┓', '┗', '┛', '━', '┃'}
+	// BoxStyleDashed uses dashed edges with light corners.
+	BoxStyleDashed = BoxStyle{'┌', '┐', '└', '┘', '╌', '╎'}
+	// BoxStyleRounded uses light lines with rounded corners.
+	BoxStyleRounded = BoxStyle{'╭', '╮', '╰', '╯', '─', '│'}
+)
 
-	// Vertical edges
-	for y := r.Y + 1; y < r.Y+r.Height-1; y++ {
-		b.Set(r.X, y, '│', s)
-		b.Set(r.X+r.Width-1, y, '│', s)
-	}
+// DrawBox draws a border around a rect using box-drawing characters.
+func (b *Buffer) DrawBox(r Rect, s backend.Style) {
+	b.DrawBoxWithStyle(r, s, BoxStyleSingle)
 }
 
 // DrawRoundedBox draws a border with rounded corners.
+//
+// Deprecated: Use DrawBoxWithStyle with BoxStyleRounded.
 func (b *Buffer) DrawRoundedBox(r Rect, s backend.Style) {
+	b.DrawBoxWithStyle(r, s, BoxStyleRounded)
+}
+
+// DrawBoxWithStyle draws a border around a rect using the characters in bs.
+func (b *Buffer) DrawBoxWithStyle(r Rect, s backend.Style, bs BoxStyle) {
 	if r.Width < 2 || r.Height < 2 {
 		return
 	}
 
-	// Rounded corners
-	b.Set(r.X, r.Y, '╭', s)
-	b.Set(r.X+r.Width-1, r.Y, '╮', s)
-	b.Set(r.X, r.Y+r.Height-1, '╰', s)
-	b.Set(r.X+r.Width-1, r.Y+r.Height-1, '╯', s)
+	// Corners
+	b.Set(r.X, r.Y, bs.TopLeft, s)
+	b.Set(r.X+r.Width-1, r.Y, bs.TopRight, s)
+	b.Set(r.X, r.Y+r.Height-1, bs.BottomLeft, s)
+	b.Set(r.X+r.Width-1, r.Y+r.Height-1, bs.BottomRight, s)
 
 	// Horizontal edges
 	for x := r.X + 1; x < r.X+r.Width-1; x++ {
-		b.Set(x, r.Y, '─', s)
-		b.Set(x, r.Y+r.Height-1, '─', s)
+		b.Set(x, r.Y, bs.Horizontal, s)
+		b.Set(x, r.Y+r.Height-1, bs.Horizontal, s)
 	}
 
 	// Vertical edges
 	for y := r.Y + 1; y < r.Y+r.Height-1; y++ {
-		b.Set(r.X, y, '│', s)
-		b.Set(r.X+r.Width-1, y, '│', s)
+		b.Set(r.X, y, bs.Vertical, s)
+		b.Set(r.X+r.Width-1, y, bs.Vertical, s)
 	}
 }
 
@@ -285,16 +421,7 @@ func (s *SubBuffer) SetString(x, y int, str string, style backend.Style) {
 	if y < 0 || y >= s.bounds.Height {
 		return
 	}
-	for i, r := range str {
-		px := x + i
-		if px < 0 {
-			continue
-		}
-		if px >= s.bounds.Width {
-			break
-		}
-		s.parent.Set(s.bounds.X+px, s.bounds.Y+y, r, style)
-	}
+	s.parent.Clip(s.bounds).SetString(s.bounds.X+x, s.bounds.Y+y, str, style)
 }
 
 // Fill fills a region relative to the sub-buffer.
@@ -360,6 +487,10 @@ func (b *Buffer) markCellDirty(x, y, idx int) {
 
 // MarkAllDirty marks the entire buffer as dirty.
 func (b *Buffer) MarkAllDirty() {
+	if b.root != nil {
+		b.root.MarkAllDirty()
+		return
+	}
 	b.dirtyAll = true
 	b.dirtyCount = b.width * b.height
 	b.dirtyRect = Rect{X: 0, Y: 0, Width: b.width, Height: b.height}
@@ -369,6 +500,10 @@ func (b *Buffer) MarkAllDirty() {
 
 // ClearDirty resets all dirty flags.
 func (b *Buffer) ClearDirty() {
+	if b.root != nil {
+		b.root.ClearDirty()
+		return
+	}
 	b.dirtyAll = false
 	b.dirtyCount = 0
 	b.dirtyRect = Rect{}
@@ -379,11 +514,17 @@ func (b *Buffer) ClearDirty() {
 
 // IsDirty returns true if any cells have changed.
 func (b *Buffer) IsDirty() bool {
+	if b.root != nil {
+		return b.root.IsDirty()
+	}
 	return b.dirtyAll || b.dirtyCount > 0
 }
 
 // DirtyCount returns the number of dirty cells.
 func (b *Buffer) DirtyCount() int {
+	if b.root != nil {
+		return b.root.DirtyCount()
+	}
 	if b.dirtyAll {
 		return b.width * b.height
 	}
@@ -393,6 +534,9 @@ func (b *Buffer) DirtyCount() int {
 // DirtyRect returns the bounding box of dirty cells.
 // Returns empty rect if nothing is dirty.
 func (b *Buffer) DirtyRect() Rect {
+	if b.root != nil {
+		return b.root.DirtyRect()
+	}
 	if b.dirtyAll {
 		return Rect{X: 0, Y: 0, Width: b.width, Height: b.height}
 	}
@@ -401,6 +545,9 @@ func (b *Buffer) DirtyRect() Rect {
 
 // IsCellDirty returns true if the cell at (x, y) is dirty.
 func (b *Buffer) IsCellDirty(x, y int) bool {
+	if b.root != nil {
+		return b.root.IsCellDirty(x, y)
+	}
 	if x < 0 || x >= b.width || y < 0 || y >= b.height {
 		return false
 	}
@@ -413,6 +560,10 @@ func (b *Buffer) IsCellDirty(x, y int) bool {
 // ForEachDirtyCell calls fn for each dirty cell.
 // More efficient than iterating all cells when few are dirty.
 func (b *Buffer) ForEachDirtyCell(fn func(x, y int, cell Cell)) {
+	if b.root != nil {
+		b.root.ForEachDirtyCell(fn)
+		return
+	}
 	if b.dirtyAll {
 		for y := 0; y < b.height; y++ {
 			rowStart := y * b.width
@@ -467,6 +618,10 @@ func (b *Buffer) ForEachDirtyCell(fn func(x, y int, cell Cell)) {
 
 // ForEachDirtySpan calls fn for each contiguous dirty span per row.
 func (b *Buffer) ForEachDirtySpan(fn func(y, startX, endX int)) {
+	if b.root != nil {
+		b.root.ForEachDirtySpan(fn)
+		return
+	}
 	if b.dirtyAll {
 		for y := 0; y < b.height; y++ {
 			fn(y, 0, b.width)
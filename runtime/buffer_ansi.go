@@ -0,0 +1,182 @@
+package runtime
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+)
+
+// SetANSI writes a string containing ANSI SGR escape sequences starting at
+// (x, y). Each run of text is written with the style built up by the
+// sequences before it, starting from the default style. Reset, bold, dim,
+// italic, underline, reverse, and 16, 256, and true colour foregrounds and
+// backgrounds are understood; other escape sequences and control characters
+// are dropped.
+func (b *Buffer) SetANSI(x, y int, s string) {
+	style := backend.DefaultStyle()
+	var run strings.Builder
+	flush := func() {
+		if run.Len() == 0 {
+			return
+		}
+		text := run.String()
+		b.SetString(x, y, text, style)
+		x += stringCells(text)
+		run.Reset()
+	}
+	for i := 0; i < len(s); {
+		ch := s[i]
+		if ch == 0x1b {
+			flush()
+			params, final, next := scanEscape(s, i)
+			if final == 'm' {
+				style = applySGR(style, params)
+			}
+			i = next
+			continue
+		}
+		if ch < 0x20 || ch == 0x7f {
+			i++
+			continue
+		}
+		run.WriteByte(ch)
+		i++
+	}
+	flush()
+}
+
+// stringCells returns the number of columns SetString advances for s.
+func stringCells(s string) int {
+	n := 0
+	for _, r := range s {
+		n += runeCells(r)
+	}
+	return n
+}
+
+// scanEscape reads the escape sequence starting at s[start], which is ESC.
+// For a CSI sequence it returns the parameter bytes and final byte; other
+// sequences return a zero final byte. next is the index after the sequence.
+func scanEscape(s string, start int) (params string, final byte, next int) {
+	i := start + 1
+	if i >= len(s) {
+		return "", 0, i
+	}
+	switch s[i] {
+	case '[':
+		i++
+		begin := i
+		for i < len(s) && (s[i] < 0x40 || s[i] > 0x7e) {
+			i++
+		}
+		if i >= len(s) {
+			return "", 0, i
+		}
+		return s[begin:i], s[i], i + 1
+	case ']', 'P', '_', '^':
+		// String sequences run to BEL or ST.
+		for i++; i < len(s); i++ {
+			if s[i] == 0x07 {
+				return "", 0, i + 1
+			}
+			if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\' {
+				return "", 0, i + 2
+			}
+		}
+		return "", 0, i
+	default:
+		return "", 0, i + 1
+	}
+}
+
+// applySGR applies the semicolon-separated SGR parameters to style.
+func applySGR(style backend.Style, params string) backend.Style {
+	if params == "" {
+		return backend.DefaultStyle()
+	}
+	codes := strings.Split(params, ";")
+	for i := 0; i < len(codes); i++ {
+		code, err := strconv.Atoi(codes[i])
+		if err != nil {
+			continue
+		}
+		switch {
+		case code == 0:
+			style = backend.DefaultStyle()
+		case code == 1:
+			style = style.Bold(true)
+		case code == 2:
+			style = style.Dim(true)
+		case code == 3:
+			style = style.Italic(true)
+		case code == 4:
+			style = style.Underline(true)
+		case code == 7:
+			style = style.Reverse(true)
+		case code == 22:
+			style = style.Bold(false).Dim(false)
+		case code == 23:
+			style = style.Italic(false)
+		case code == 24:
+			style = style.Underline(false)
+		case code == 27:
+			style = style.Reverse(false)
+		case code >= 30 && code <= 37:
+			style = style.Foreground(backend.Color(code - 30))
+		case code >= 40 && code <= 47:
+			style = style.Background(backend.Color(code - 40))
+		case code >= 90 && code <= 97:
+			style = style.Foreground(backend.Color(code - 90 + 8))
+		case code >= 100 && code <= 107:
+			style = style.Background(backend.Color(code - 100 + 8))
+		case code == 39:
+			style = style.Foreground(backend.ColorDefault)
+		case code == 49:
+			style = style.Background(backend.ColorDefault)
+		case code == 38 || code == 48:
+			color, used, ok := parseSGRColor(codes[i+1:])
+			i += used
+			if !ok {
+				continue
+			}
+			if code == 38 {
+				style = style.Foreground(color)
+			} else {
+				style = style.Background(color)
+			}
+		}
+	}
+	return style
+}
+
+// parseSGRColor parses the arguments after 38 or 48: "5;n" for the 256
+// colour palette or "2;r;g;b" for true colour. used is the number of
+// arguments consumed.
+func parseSGRColor(args []string) (color backend.Color, used int, ok bool) {
+	if len(args) == 0 {
+		return 0, 0, false
+	}
+	num := func(i int) (int, bool) {
+		v, err := strconv.Atoi(args[i])
+		return v, err == nil && v >= 0 && v <= 255
+	}
+	switch args[0] {
+	case "5":
+		if len(args) < 2 {
+			return 0, len(args), false
+		}
+		n, ok := num(1)
+		return backend.Color(n), 2, ok
+	case "2":
+		if len(args) < 4 {
+			return 0, len(args), false
+		}
+		r, okR := num(1)
+		g, okG := num(2)
+		bl, okB := num(3)
+		return backend.ColorRGB(uint8(r), uint8(g), uint8(bl)), 4, okR && okG && okB
+	default:
+		return 0, 1, false
+	}
+}
@@ -0,0 +1,57 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+)
+
+func TestBuffer_SetANSI(t *testing.T) {
+	b := NewBuffer(12, 1)
+	b.SetANSI(1, 0, "\x1b[1;31mab\x1b[0m-\x1b[38;5;208;48;2;1;2;3mc\x1b[4;94md\x1b[m!")
+
+	def := backend.DefaultStyle()
+	want := []Cell{
+		{Rune: 'a', Style: def.Bold(true).Foreground(backend.ColorRed)},
+		{Rune: 'b', Style: def.Bold(true).Foreground(backend.ColorRed)},
+		{Rune: '-', Style: def},
+		{Rune: 'c', Style: def.Foreground(backend.Color(208)).Background(backend.ColorRGB(1, 2, 3))},
+		{Rune: 'd', Style: def.Foreground(backend.ColorBrightBlue).Background(backend.ColorRGB(1, 2, 3)).Underline(true)},
+		{Rune: '!', Style: def},
+	}
+	for i, cell := range want {
+		if got := b.Get(1+i, 0); got != cell {
+			t.Errorf("cell %d = %+v, want %+v", 1+i, got, cell)
+		}
+	}
+}
+
+func TestBuffer_SetANSIIgnoresUnknownSequences(t *testing.T) {
+	b := NewBuffer(8, 1)
+	b.SetANSI(0, 0, "\x1b[2Ja\x1b]8;;http://x\x07b\x1b[2;99mc\x1b")
+
+	def := backend.DefaultStyle()
+	want := []Cell{
+		{Rune: 'a', Style: def},
+		{Rune: 'b', Style: def},
+		{Rune: 'c', Style: def.Dim(true)},
+		{},
+	}
+	for i, cell := range want {
+		if got := b.Get(i, 0); got != cell {
+			t.Errorf("cell %d = %+v, want %+v", i, got, cell)
+		}
+	}
+}
+
+func TestBuffer_SetANSIWide(t *testing.T) {
+	b := NewBuffer(5, 1)
+	b.SetANSI(0, 0, "\x1b[32m日\x1b[0mx")
+
+	if got := b.Get(0, 0); got.Rune != '日' || got.Style.FG() != backend.ColorGreen {
+		t.Fatalf("cell 0 = %+v, want green 日", got)
+	}
+	if got := b.Get(2, 0).Rune; got != 'x' {
+		t.Fatalf("cell 2 = %q, want 'x' after the wide rune", got)
+	}
+}
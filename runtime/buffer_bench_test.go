@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/odvcencio/fluffy-ui/backend"
@@ -91,6 +92,93 @@ func BenchmarkBuffer_ForEachDirtyCell_Many(b *testing.B) {
 	_ = count
 }
 
+// BenchmarkBuffer_Set_Single measures repeated writes to one cell.
+func BenchmarkBuffer_Set_Single(b *testing.B) {
+	buf := NewBuffer(80, 24)
+	style := backend.DefaultStyle()
+	runes := [2]rune{'A', 'B'}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Set(10, 10, runes[i&1], style)
+	}
+}
+
+// BenchmarkBuffer_SetString_Short measures an 8-character string write.
+func BenchmarkBuffer_SetString_Short(b *testing.B) {
+	buf := NewBuffer(80, 24)
+	style := backend.DefaultStyle()
+	text := "Status: "
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.SetString(0, i%24, text, style)
+	}
+}
+
+// BenchmarkBuffer_SetString_Wide measures a full-width 80-character string write.
+func BenchmarkBuffer_SetString_Wide(b *testing.B) {
+	buf := NewBuffer(80, 24)
+	style := backend.DefaultStyle()
+	text := strings.Repeat("0123456789", 8)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.SetString(0, i%24, text, style)
+	}
+}
+
+// BenchmarkBuffer_Fill_FullScreen measures filling an 80x24 screen.
+func BenchmarkBuffer_Fill_FullScreen(b *testing.B) {
+	buf := NewBuffer(80, 24)
+	style := backend.DefaultStyle()
+	rect := Rect{Width: 80, Height: 24}
+	runes := [2]rune{'.', ' '}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Fill(rect, runes[i&1], style)
+	}
+}
+
+// BenchmarkBuffer_ForEachDirtyCell_Sparse measures iteration with 1% of cells dirty.
+func BenchmarkBuffer_ForEachDirtyCell_Sparse(b *testing.B) {
+	benchmarkForEachDirtyCell(b, 1)
+}
+
+// BenchmarkBuffer_ForEachDirtyCell_Dense measures iteration with 80% of cells dirty.
+func BenchmarkBuffer_ForEachDirtyCell_Dense(b *testing.B) {
+	benchmarkForEachDirtyCell(b, 80)
+}
+
+func benchmarkForEachDirtyCell(b *testing.B, percent int) {
+	buf := NewBuffer(80, 24)
+	markDirtyPercent(buf, percent)
+
+	var count int
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		count = 0
+		buf.ForEachDirtyCell(func(x, y int, cell Cell) {
+			count++
+		})
+	}
+	_ = count
+}
+
+// markDirtyPercent clears dirty state, then changes the first percent cells
+// of every run of 100 cells so dirty cells are spread across the buffer.
+func markDirtyPercent(buf *Buffer, percent int) {
+	buf.ClearDirty()
+	w, h := buf.Size()
+	style := backend.DefaultStyle()
+	for idx := 0; idx < w*h; idx++ {
+		if idx%100 < percent {
+			buf.Set(idx%w, idx/w, '#', style)
+		}
+	}
+}
+
 // BenchmarkBuffer_ClearDirty measures dirty flag clearing.
 func BenchmarkBuffer_ClearDirty(b *testing.B) {
 	buf := NewBuffer(120, 40)
@@ -0,0 +1,141 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+)
+
+// GaugeStyle defines the visual appearance of a progress bar.
+type GaugeStyle struct {
+	// Fill characters
+	FillChar  rune // Filled portion (default '█')
+	EmptyChar rune // Empty portion (default '░')
+
+	// Gradient thresholds and styles (ascending order)
+	// Each threshold defines the ratio at which a new color begins
+	Thresholds []GaugeThreshold
+
+	// EmptyStyle for unfilled portion
+	EmptyStyle backend.Style
+
+	// EdgeStyle for the leading edge (optional glow effect)
+	EdgeStyle backend.Style
+
+	// LabelPosition places the label inside or to the right of the bar.
+	LabelPosition GaugeLabelPosition
+	// Label is the label text; empty shows the percentage.
+	Label string
+	// LabelStyle styles the label.
+	LabelStyle backend.Style
+}
+
+// GaugeThreshold defines a color breakpoint in the gradient.
+type GaugeThreshold struct {
+	Ratio float64       // Start ratio for this color (0.0-1.0)
+	Style backend.Style // Style for this segment
+}
+
+// GaugeLabelPosition controls where a progress label is drawn.
+type GaugeLabelPosition int
+
+const (
+	// GaugeLabelNone draws no label.
+	GaugeLabelNone GaugeLabelPosition = iota
+	// GaugeLabelInside centers the label over the bar.
+	GaugeLabelInside
+	// GaugeLabelRight draws the label after the bar, shrinking the bar to fit.
+	GaugeLabelRight
+)
+
+// DrawProgress draws a horizontal progress bar of width cells at (x, y)
+// filled to value/max. A max of zero or less draws an empty bar.
+func (b *Buffer) DrawProgress(x, y, width int, value, max float64, style GaugeStyle) {
+	if b == nil || width <= 0 {
+		return
+	}
+	ratio := 0.0
+	if max > 0 {
+		ratio = value / max
+	}
+	if ratio < 0 {
+		ratio = 0
+	}
+	if ratio > 1 {
+		ratio = 1
+	}
+
+	label := ""
+	if style.LabelPosition != GaugeLabelNone {
+		label = style.Label
+		if label == "" {
+			// Pad to a fixed width so the bar does not jitter as value changes.
+			label = fmt.Sprintf("%3.0f%%", ratio*100)
+			if style.LabelPosition == GaugeLabelInside {
+				label = strings.TrimSpace(label)
+			}
+		}
+	}
+	labelWidth := len([]rune(label))
+	barWidth := width
+	if style.LabelPosition == GaugeLabelRight && labelWidth > 0 {
+		barWidth = width - labelWidth - 1
+		if barWidth < 0 {
+			barWidth = 0
+		}
+	}
+
+	fill := int(float64(barWidth)*ratio + 0.5)
+	if fill > barWidth {
+		fill = barWidth
+	}
+	fillChar := style.FillChar
+	if fillChar == 0 {
+		fillChar = '█'
+	}
+	emptyChar := style.EmptyChar
+	if emptyChar == 0 {
+		emptyChar = '░'
+	}
+	for i := 0; i < barWidth; i++ {
+		if i < fill {
+			cellStyle := style.ThresholdStyle(float64(i) / float64(barWidth))
+			if i == fill-1 && style.EdgeStyle != (backend.Style{}) {
+				cellStyle = style.EdgeStyle
+			}
+			b.Set(x+i, y, fillChar, cellStyle)
+		} else {
+			b.Set(x+i, y, emptyChar, style.EmptyStyle)
+		}
+	}
+
+	switch style.LabelPosition {
+	case GaugeLabelInside:
+		if labelWidth <= width {
+			b.SetString(x+(width-labelWidth)/2, y, label, style.LabelStyle)
+		}
+	case GaugeLabelRight:
+		if barWidth > 0 {
+			b.Set(x+barWidth, y, ' ', style.LabelStyle)
+			b.SetString(x+barWidth+1, y, label, style.LabelStyle)
+		} else {
+			b.SetString(x, y, string([]rune(label)[:min(labelWidth, width)]), style.LabelStyle)
+		}
+	}
+}
+
+// ThresholdStyle returns the style of the highest threshold ratio reaches,
+// or the default style when there are no thresholds.
+func (s GaugeStyle) ThresholdStyle(ratio float64) backend.Style {
+	if len(s.Thresholds) == 0 {
+		return backend.DefaultStyle()
+	}
+	result := s.Thresholds[0].Style
+	for _, t := range s.Thresholds {
+		if ratio >= t.Ratio {
+			result = t.Style
+		}
+	}
+	return result
+}
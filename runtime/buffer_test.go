@@ -77,6 +77,80 @@ func TestBuffer_SetStringClips(t *testing.T) {
 	}
 }
 
+// rowRunes returns the runes in row y of b.
+func rowRunes(b *Buffer, y int) []rune {
+	w, _ := b.Size()
+	out := make([]rune, w)
+	for x := range out {
+		out[x] = b.Get(x, y).Rune
+	}
+	return out
+}
+
+func TestBuffer_SetStringWide(t *testing.T) {
+	b := NewBuffer(6, 1)
+	b.SetString(0, 0, "a日本b", backend.DefaultStyle())
+
+	want := []rune{'a', '日', backend.CellContinuation, '本', backend.CellContinuation, 'b'}
+	if got := rowRunes(b, 0); string(got) != string(want) {
+		t.Fatalf("row = %q, want %q", got, want)
+	}
+}
+
+func TestBuffer_SetStringWideOverflow(t *testing.T) {
+	b := NewBuffer(4, 1)
+	b.SetString(0, 0, "ab日x", backend.DefaultStyle())
+	b.SetString(1, 0, "c日", backend.DefaultStyle())
+
+	// The wide rune no longer fits in the last column.
+	want := []rune{'a', 'c', '日', backend.CellContinuation}
+	if got := rowRunes(b, 0); string(got) != string(want) {
+		t.Fatalf("row = %q, want %q", got, want)
+	}
+	b.SetString(2, 0, "xy本", backend.DefaultStyle())
+	want = []rune{'a', 'c', 'x', 'y'}
+	if got := rowRunes(b, 0); string(got) != string(want) {
+		t.Fatalf("row = %q, want %q", got, want)
+	}
+	b.SetString(0, 0, "abc日", backend.DefaultStyle())
+	if got := b.Get(3, 0).Rune; got != ' ' {
+		t.Fatalf("overflowing wide rune = %q, want a space", got)
+	}
+}
+
+func TestBuffer_SetWideOverwrite(t *testing.T) {
+	b := NewBuffer(4, 1)
+	style := backend.DefaultStyle()
+	b.Set(0, 0, '日', style)
+	b.Set(2, 0, '本', style)
+
+	// Writing over the continuation blanks the wide rune it belonged to.
+	b.Set(1, 0, 'x', style)
+	// Writing over the left half blanks the continuation.
+	b.Set(2, 0, 'y', style)
+
+	want := []rune{' ', 'x', 'y', ' '}
+	if got := rowRunes(b, 0); string(got) != string(want) {
+		t.Fatalf("row = %q, want %q", got, want)
+	}
+}
+
+func TestBuffer_SetWideClipped(t *testing.T) {
+	b := NewBuffer(6, 1)
+	view := b.Clip(Rect{X: 1, Y: 0, Width: 3, Height: 1})
+	view.SetString(0, 0, "日本語", backend.DefaultStyle())
+
+	// 日 at 0-1 shows only its right half; 語 at 4-5 is outside the clip.
+	want := []rune{0, ' ', '本', backend.CellContinuation, 0, 0}
+	if got := rowRunes(b, 0); string(got) != string(want) {
+		t.Fatalf("row = %q, want %q", got, want)
+	}
+	view.Set(3, 0, '語', backend.DefaultStyle())
+	if got := b.Get(3, 0).Rune; got != ' ' {
+		t.Fatalf("wide rune at the clip edge = %q, want a space", got)
+	}
+}
+
 func TestBuffer_Fill(t *testing.T) {
 	b := NewBuffer(10, 10)
 	style := backend.DefaultStyle()
@@ -351,6 +425,37 @@ func TestBuffer_ForEachDirtyCell_MostDirty(t *testing.T) {
 	}
 }
 
+func TestBuffer_ForEachDirtyCell_Percent(t *testing.T) {
+	tests := []struct {
+		name    string
+		percent int
+		want    int
+	}{
+		{"sparse", 1, 20},
+		{"dense", 80, 1540},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := NewBuffer(80, 24)
+			markDirtyPercent(buf, tt.percent)
+
+			count := 0
+			buf.ForEachDirtyCell(func(x, y int, cell Cell) {
+				if cell.Rune != '#' {
+					t.Fatalf("visited clean cell (%d,%d)", x, y)
+				}
+				count++
+			})
+			if count != tt.want {
+				t.Fatalf("visited %d cells, want %d", count, tt.want)
+			}
+			if got := buf.DirtyCount(); got != tt.want {
+				t.Fatalf("DirtyCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestBuffer_IsCellDirty_OutOfBounds(t *testing.T) {
 	b := NewBuffer(10, 10)
 
@@ -420,6 +525,28 @@ func TestBuffer_ResizeSameSize(t *testing.T) {
 	}
 }
 
+func TestBuffer_DrawBoxWithStyle(t *testing.T) {
+	tests := []struct {
+		name string
+		bs   BoxStyle
+		want string // top-left, top edge, left edge, bottom-right
+	}{
+		{"single", BoxStyleSingle, "┌─│┘"},
+		{"double", BoxStyleDouble, "╔═║╝"},
+		{"heavy", BoxStyleHeavy, "┏━┃┛"},
+		{"dashed", BoxStyleDashed, "┌╌╎┘"},
+		{"rounded", BoxStyleRounded, "╭─│╯"},
+	}
+	for _, tt := range tests {
+		b := NewBuffer(4, 3)
+		b.DrawBoxWithStyle(Rect{0, 0, 4, 3}, backend.DefaultStyle(), tt.bs)
+		got := string([]rune{b.Get(0, 0).Rune, b.Get(1, 0).Rune, b.Get(0, 1).Rune, b.Get(3, 2).Rune})
+		if got != tt.want {
+			t.Errorf("%s: border = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
 func TestBuffer_DrawRoundedBox(t *testing.T) {
 	b := NewBuffer(10, 5)
 	style := backend.DefaultStyle()
@@ -588,3 +715,42 @@ func TestSubBuffer_Clear(t *testing.T) {
 		t.Error("SubBuffer Clear should fill with spaces")
 	}
 }
+
+func TestBuffer_DrawProgress(t *testing.T) {
+	row := func(b *Buffer, width int) string {
+		out := make([]rune, width)
+		for x := range out {
+			out[x] = b.Get(x, 0).Rune
+		}
+		return string(out)
+	}
+	style := GaugeStyle{FillChar: '#', EmptyChar: '-'}
+
+	buf := NewBuffer(10, 1)
+	buf.DrawProgress(0, 0, 10, 0.5, 1.0, style)
+	if got := row(buf, 10); got != "#####-----" {
+		t.Fatalf("half bar = %q, want %q", got, "#####-----")
+	}
+
+	buf = NewBuffer(10, 1)
+	buf.DrawProgress(0, 0, 10, 5, 0, style)
+	if got := row(buf, 10); got != "----------" {
+		t.Fatalf("zero max = %q, want an empty bar", got)
+	}
+
+	inside := style
+	inside.LabelPosition = GaugeLabelInside
+	buf = NewBuffer(10, 1)
+	buf.DrawProgress(0, 0, 10, 0.5, 1.0, inside)
+	if got := row(buf, 10); got != "###50%----" {
+		t.Fatalf("inside label = %q, want %q", got, "###50%----")
+	}
+
+	right := style
+	right.LabelPosition = GaugeLabelRight
+	buf = NewBuffer(10, 1)
+	buf.DrawProgress(0, 0, 10, 0.5, 1.0, right)
+	if got := row(buf, 10); got != "###--  50%" {
+		t.Fatalf("right label = %q, want %q", got, "###--  50%")
+	}
+}
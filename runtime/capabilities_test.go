@@ -0,0 +1,36 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// sixelBackend is a simulated terminal whose DA1 reply advertises Sixel.
+type sixelBackend struct {
+	*sim.Backend
+}
+
+func (sixelBackend) DeviceAttributes() string {
+	return "\x1b]11;rgb:0000/0000/0000\x1b\\\x1b[?62;4;22c"
+}
+
+func TestApp_DeviceAttributesUpdateCapabilities(t *testing.T) {
+	for _, override := range []*terminal.Capabilities{nil, {}} {
+		app := NewApp(AppConfig{Backend: sixelBackend{sim.New(5, 3)}, Root: &bindTestWidget{}, Capabilities: override})
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		done := make(chan error, 1)
+		go func() {
+			done <- app.Run(ctx)
+		}()
+		waitForScreen(t, app)
+		cancel()
+		<-done
+		if got, want := app.Services().Capabilities().Sixel, override == nil; got != want {
+			t.Fatalf("override=%v: Sixel = %v, want %v", override != nil, got, want)
+		}
+	}
+}
@@ -71,10 +71,19 @@ type FocusPrev struct{}
 
 func (FocusPrev) Command() {}
 
+// FocusWidget requests focus move to a widget in the active focus scope.
+type FocusWidget struct {
+	Widget Focusable
+}
+
+func (FocusWidget) Command() {}
+
 // PushOverlay requests a modal overlay be pushed.
 type PushOverlay struct {
 	Widget Widget
 	Modal  bool
+	// InitialFocus, when set, is focused instead of the first focusable widget.
+	InitialFocus Focusable
 }
 
 func (PushOverlay) Command() {}
@@ -20,6 +20,7 @@ func TestCommands_ImplementsInterface(t *testing.T) {
 		FileSelected{Path: "/test"},
 		FocusNext{},
 		FocusPrev{},
+		FocusWidget{},
 		PushOverlay{Widget: nil, Modal: false},
 		PopOverlay{},
 		PaletteSelected{ID: "item1", Data: nil},
@@ -30,11 +30,31 @@ func After(delay time.Duration, msg Message) Effect {
 // Every posts messages on a fixed interval.
 // Returning nil from fn skips posting.
 func Every(interval time.Duration, fn func(time.Time) Message) Effect {
+	return EveryAfter(interval, interval, fn)
+}
+
+// EveryAfter waits delay before the first tick, then posts messages every
+// interval. Staggering delays keeps timers from firing together.
+// Returning nil from fn skips posting.
+func EveryAfter(delay, interval time.Duration, fn func(time.Time) Message) Effect {
 	return Effect{
 		Run: func(ctx context.Context, post PostFunc) {
 			if interval <= 0 || fn == nil || post == nil {
 				return
 			}
+			if delay < 0 {
+				delay = 0
+			}
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-timer.C:
+				if msg := fn(now); msg != nil {
+					post(msg)
+				}
+			}
 			ticker := time.NewTicker(interval)
 			defer ticker.Stop()
 			for {
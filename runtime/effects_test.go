@@ -39,3 +39,40 @@ func TestEvery_Invalid(t *testing.T) {
 		t.Fatalf("expected no posts for nil callback, got %d", calls)
 	}
 }
+
+func TestEveryAfter_Delay(t *testing.T) {
+	const delay, interval = 100 * time.Millisecond, 50 * time.Millisecond
+	ticks := make(chan time.Time, 2)
+	effect := EveryAfter(delay, interval, func(now time.Time) Message {
+		return TickMsg{Time: now}
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := time.Now()
+	go effect.Run(ctx, func(msg Message) bool {
+		select {
+		case ticks <- msg.(TickMsg).Time:
+		default:
+		}
+		return true
+	})
+
+	// Only lower bounds are checked: the first tick waits for delay and the
+	// next one for a further interval, however late the scheduler runs them.
+	next := func() time.Time {
+		select {
+		case at := <-ticks:
+			return at
+		case <-time.After(5 * time.Second):
+			t.Fatal("tick timed out")
+			return time.Time{}
+		}
+	}
+	first := next()
+	if got := first.Sub(start); got < delay {
+		t.Fatalf("first tick after %v, want at least %v", got, delay)
+	}
+	if got := next().Sub(first); got < interval {
+		t.Fatalf("second tick %v after the first, want at least %v", got, interval)
+	}
+}
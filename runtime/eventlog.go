@@ -0,0 +1,152 @@
+package runtime
+
+import (
+	"sync"
+	"time"
+)
+
+// MessageKind classifies messages for filtering.
+type MessageKind string
+
+const (
+	MessageKindKey        MessageKind = "key"
+	MessageKindMouse      MessageKind = "mouse"
+	MessageKindResize     MessageKind = "resize"
+	MessageKindPaste      MessageKind = "paste"
+	MessageKindTick       MessageKind = "tick"
+	MessageKindQueueFlush MessageKind = "queue_flush"
+	MessageKindInvalidate MessageKind = "invalidate"
+	MessageKindSelectAll  MessageKind = "select_all"
+	MessageKindError      MessageKind = "error"
+	MessageKindContrast   MessageKind = "high_contrast"
+	MessageKindTheme      MessageKind = "theme"
+	MessageKindCustom     MessageKind = "custom"
+)
+
+// KindOf returns the kind of a message. Messages defined outside the
+// runtime package report MessageKindCustom.
+func KindOf(msg Message) MessageKind {
+	switch msg.(type) {
+	case KeyMsg:
+		return MessageKindKey
+	case MouseMsg:
+		return MessageKindMouse
+	case ResizeMsg:
+		return MessageKindResize
+	case PasteMsg:
+		return MessageKindPaste
+	case TickMsg:
+		return MessageKindTick
+	case QueueFlushMsg:
+		return MessageKindQueueFlush
+	case InvalidateMsg:
+		return MessageKindInvalidate
+	case SelectAllMsg:
+		return MessageKindSelectAll
+	case ErrorMsg:
+		return MessageKindError
+	case HighContrastChangedMsg:
+		return MessageKindContrast
+	case ThemeChangedMsg:
+		return MessageKindTheme
+	default:
+		return MessageKindCustom
+	}
+}
+
+// EventEntry records one message processed by DefaultUpdate.
+type EventEntry struct {
+	Msg       Message
+	Handled   bool
+	Commands  []Command
+	Timestamp time.Time
+}
+
+// EventLog keeps the most recent messages in a ring buffer for debugging.
+type EventLog struct {
+	mu      sync.Mutex
+	entries []EventEntry
+	start   int
+	count   int
+	done    bool // the newest entry has its result
+	now     func() time.Time
+}
+
+// NewEventLog creates a log that retains the last capacity messages.
+func NewEventLog(capacity int) *EventLog {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &EventLog{
+		entries: make([]EventEntry, capacity),
+		now:     time.Now,
+	}
+}
+
+// Dump returns the recorded entries, oldest first.
+func (l *EventLog) Dump() []EventEntry {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	out := make([]EventEntry, 0, l.count)
+	for i := 0; i < l.count; i++ {
+		out = append(out, l.entries[(l.start+i)%len(l.entries)])
+	}
+	return out
+}
+
+// Filter returns the recorded entries of the given kind, oldest first.
+func (l *EventLog) Filter(kind MessageKind) []EventEntry {
+	var out []EventEntry
+	for _, entry := range l.Dump() {
+		if KindOf(entry.Msg) == kind {
+			out = append(out, entry)
+		}
+	}
+	return out
+}
+
+// Clear removes all recorded entries.
+func (l *EventLog) Clear() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	clear(l.entries)
+	l.start = 0
+	l.count = 0
+	l.done = false
+}
+
+// record appends an entry for msg, evicting the oldest when full.
+func (l *EventLog) record(msg Message) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	index := (l.start + l.count) % len(l.entries)
+	if l.count == len(l.entries) {
+		l.start = (l.start + 1) % len(l.entries)
+	} else {
+		l.count++
+	}
+	l.entries[index] = EventEntry{Msg: msg, Timestamp: l.now()}
+	l.done = false
+}
+
+// complete stores the dispatch result on the newest entry. Only the first
+// call after record takes effect.
+func (l *EventLog) complete(handled bool, commands []Command) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.count == 0 || l.done {
+		return
+	}
+	l.done = true
+	entry := &l.entries[(l.start+l.count-1)%len(l.entries)]
+	entry.Handled = handled
+	if len(commands) > 0 {
+		entry.Commands = append([]Command(nil), commands...)
+	}
+}
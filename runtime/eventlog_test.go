@@ -0,0 +1,119 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// keyOnlyWidget consumes key messages and ignores everything else.
+type keyOnlyWidget struct {
+	mockWidget
+}
+
+func (w *keyOnlyWidget) HandleMessage(msg Message) HandleResult {
+	if _, ok := msg.(KeyMsg); ok {
+		return WithCommand(FocusNext{})
+	}
+	return Unhandled()
+}
+
+func newEventLogApp(capacity int) (*App, *EventLog) {
+	log := NewEventLog(capacity)
+	app := NewApp(AppConfig{EventLog: log})
+	app.screen = NewScreen(10, 5)
+	app.screen.SetRoot(&keyOnlyWidget{})
+	return app, log
+}
+
+func TestEventLog_RecordsDispatch(t *testing.T) {
+	app, log := newEventLogApp(8)
+
+	DefaultUpdate(app, KeyMsg{Key: terminal.KeyEnter})
+	DefaultUpdate(app, MouseMsg{X: 1, Y: 1})
+	DefaultUpdate(app, ResizeMsg{Width: 20, Height: 10})
+
+	entries := log.Dump()
+	if len(entries) != 3 {
+		t.Fatalf("entries = %d, want 3", len(entries))
+	}
+	if !entries[0].Handled || len(entries[0].Commands) != 1 {
+		t.Fatalf("key entry = %+v, want handled with one command", entries[0])
+	}
+	if entries[1].Handled {
+		t.Fatalf("mouse entry should not be handled: %+v", entries[1])
+	}
+	if !entries[2].Handled {
+		t.Fatalf("resize entry should be handled: %+v", entries[2])
+	}
+	if entries[0].Timestamp.IsZero() {
+		t.Fatal("expected timestamp")
+	}
+
+	keys := log.Filter(MessageKindKey)
+	if len(keys) != 1 || KindOf(keys[0].Msg) != MessageKindKey {
+		t.Fatalf("Filter(key) = %+v, want one key entry", keys)
+	}
+}
+
+func TestEventLog_EvictsOldest(t *testing.T) {
+	app, log := newEventLogApp(2)
+
+	DefaultUpdate(app, KeyMsg{Key: terminal.KeyRune, Rune: 'a'})
+	DefaultUpdate(app, KeyMsg{Key: terminal.KeyRune, Rune: 'b'})
+	DefaultUpdate(app, KeyMsg{Key: terminal.KeyRune, Rune: 'c'})
+
+	entries := log.Dump()
+	if len(entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(entries))
+	}
+	if entries[0].Msg.(KeyMsg).Rune != 'b' || entries[1].Msg.(KeyMsg).Rune != 'c' {
+		t.Fatalf("entries = %+v, want b then c", entries)
+	}
+}
+
+func TestEventLog_CompletesEveryPath(t *testing.T) {
+	app, log := newEventLogApp(8)
+
+	DefaultUpdate(app, InvalidateMsg{})
+	DefaultUpdate(app, QueueFlushMsg{})
+	DefaultUpdate(app, HighContrastChangedMsg{Enabled: true})
+
+	entries := log.Dump()
+	if len(entries) != 3 {
+		t.Fatalf("entries = %d, want 3", len(entries))
+	}
+	if !entries[0].Handled {
+		t.Fatalf("invalidate entry = %+v, want handled", entries[0])
+	}
+	if entries[1].Handled {
+		t.Fatalf("queue flush entry = %+v, want unhandled", entries[1])
+	}
+	if !entries[2].Handled {
+		t.Fatalf("high contrast entry = %+v, want handled", entries[2])
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		msg  Message
+		want MessageKind
+	}{
+		{KeyMsg{}, MessageKindKey},
+		{MouseMsg{}, MessageKindMouse},
+		{ResizeMsg{}, MessageKindResize},
+		{PasteMsg{}, MessageKindPaste},
+		{TickMsg{}, MessageKindTick},
+		{QueueFlushMsg{}, MessageKindQueueFlush},
+		{InvalidateMsg{}, MessageKindInvalidate},
+		{SelectAllMsg{}, MessageKindSelectAll},
+		{ErrorMsg{}, MessageKindError},
+		{HighContrastChangedMsg{}, MessageKindContrast},
+		{ThemeChangedMsg{}, MessageKindTheme},
+	}
+	for _, tt := range tests {
+		if got := KindOf(tt.msg); got != tt.want {
+			t.Errorf("KindOf(%T) = %q, want %q", tt.msg, got, tt.want)
+		}
+	}
+}
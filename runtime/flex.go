@@ -1,5 +1,7 @@
 package runtime
 
+import "math"
+
 // FlexDirection specifies the main axis of a flex container.
 type FlexDirection int
 
@@ -14,8 +16,13 @@ type FlexChild struct {
 	Grow   float64 // How much to grow (0 = fixed, 1+ = proportional)
 	Shrink float64 // How much to shrink (0 = fixed, 1+ = proportional)
 	Basis  int     // Base size (-1 = use measured size)
+	Min    int     // Minimum main-axis size (0 = none)
+	Max    int     // Maximum main-axis size (<= 0 = none)
 }
 
+// FlexItem is another name for FlexChild, for use with NewFlex.
+type FlexItem = FlexChild
+
 // Fixed creates a child that doesn't grow or shrink.
 func Fixed(w Widget) FlexChild {
 	return FlexChild{Widget: w, Grow: 0, Shrink: 0, Basis: -1}
@@ -36,6 +43,24 @@ func Sized(w Widget, basis int) FlexChild {
 	return FlexChild{Widget: w, Grow: 0, Shrink: 0, Basis: basis}
 }
 
+// Constrained creates a growing child whose main-axis size stays within
+// [min, max]: rows in a VBox, columns in an HBox. A max of -1 leaves the
+// size unbounded above.
+func Constrained(w Widget, min, max int) FlexChild {
+	return FlexChild{Widget: w, Grow: 1, Shrink: 1, Basis: -1, Min: min, Max: max}
+}
+
+// clamp limits a main-axis size to the child's Min and Max.
+func (c FlexChild) clamp(size int) int {
+	if c.Max > 0 && size > c.Max {
+		size = c.Max
+	}
+	if size < c.Min {
+		size = c.Min
+	}
+	return size
+}
+
 // Flex is a container that lays out children along an axis.
 type Flex struct {
 	Direction FlexDirection
@@ -48,6 +73,12 @@ type Flex struct {
 	measured    Size
 }
 
+// NewFlex creates an empty flex container; add children with Add or
+// AddFixed.
+func NewFlex(direction FlexDirection) *Flex {
+	return &Flex{Direction: direction}
+}
+
 // VBox creates a vertical flex container.
 func VBox(children ...FlexChild) *Flex {
 	return &Flex{Direction: Column, Children: children}
@@ -69,6 +100,11 @@ func (f *Flex) Add(child FlexChild) {
 	f.Children = append(f.Children, child)
 }
 
+// AddFixed appends a child with a fixed main-axis size.
+func (f *Flex) AddFixed(w Widget, size int) {
+	f.Add(Sized(w, size))
+}
+
 // Measure calculates the desired size of the flex container.
 func (f *Flex) Measure(constraints Constraints) Size {
 	if len(f.Children) == 0 {
@@ -108,9 +144,11 @@ func (f *Flex) Measure(constraints Constraints) Size {
 		}
 
 		if f.Direction == Column {
+			childSizes[i].Height = child.clamp(childSizes[i].Height)
 			totalMain += childSizes[i].Height
 			maxCross = max(maxCross, childSizes[i].Width)
 		} else {
+			childSizes[i].Width = child.clamp(childSizes[i].Width)
 			totalMain += childSizes[i].Width
 			maxCross = max(maxCross, childSizes[i].Height)
 		}
@@ -141,7 +179,6 @@ func (f *Flex) Layout(bounds Rect) {
 	// Measure children to get their preferred sizes
 	childSizes := make([]Size, len(f.Children))
 	totalFixed := 0
-	totalGrow := 0.0
 
 	for i, child := range f.Children {
 		var childConstraints Constraints
@@ -157,11 +194,9 @@ func (f *Flex) Layout(bounds Rect) {
 			childSizes[i] = child.Widget.Measure(childConstraints)
 		}
 
-		mainSize := f.mainSize(childSizes[i])
 		if child.Grow == 0 {
-			totalFixed += mainSize
+			totalFixed += child.clamp(f.mainSize(childSizes[i]))
 		}
-		totalGrow += child.Grow
 	}
 
 	// Add gaps to fixed space
@@ -177,18 +212,13 @@ func (f *Flex) Layout(bounds Rect) {
 		available = 0
 	}
 
+	mainSizes := f.distribute(childSizes, available)
+	f.shrink(mainSizes, f.mainSize(bounds.Size())-gaps)
+
 	// Position children
 	offset := 0
 	for i, child := range f.Children {
-		// Calculate size
-		var mainSize int
-		if child.Grow > 0 && totalGrow > 0 {
-			// Growing child: get proportional share
-			share := child.Grow / totalGrow
-			mainSize = int(float64(available) * share)
-		} else {
-			mainSize = f.mainSize(childSizes[i])
-		}
+		mainSize := mainSizes[i]
 
 		// Create bounds for this child
 		var childBounds Rect
@@ -215,11 +245,94 @@ func (f *Flex) Layout(bounds Rect) {
 	}
 }
 
+// distribute returns each child's main-axis size. Fixed children keep their
+// measured size; growing children share available in proportion to Grow.
+// A growing child whose share falls outside its Min/Max is pinned to the
+// limit and the rest is shared again among the others.
+func (f *Flex) distribute(measured []Size, available int) []int {
+	sizes := make([]int, len(f.Children))
+	pinned := make([]bool, len(f.Children))
+	for i, child := range f.Children {
+		if child.Grow <= 0 {
+			sizes[i] = child.clamp(f.mainSize(measured[i]))
+			pinned[i] = true
+		}
+	}
+	for {
+		remaining := available
+		totalGrow := 0.0
+		for i, child := range f.Children {
+			if child.Grow <= 0 {
+				continue
+			}
+			if pinned[i] {
+				remaining -= sizes[i]
+			} else {
+				totalGrow += child.Grow
+			}
+		}
+		if totalGrow == 0 {
+			return sizes
+		}
+		remaining = max(remaining, 0)
+		clamped := false
+		for i, child := range f.Children {
+			if pinned[i] {
+				continue
+			}
+			share := int(float64(remaining) * (child.Grow / totalGrow))
+			sizes[i] = share
+			if limited := child.clamp(share); limited != share {
+				sizes[i] = limited
+				pinned[i] = true
+				clamped = true
+			}
+		}
+		if !clamped {
+			return sizes
+		}
+	}
+}
+
+// shrink takes space back from children with a Shrink factor when sizes
+// overflow space. Each gives up a share weighted by Shrink times its size, as
+// in CSS, without going below its Min. Children with Shrink 0 keep their size
+// and may overflow.
+func (f *Flex) shrink(sizes []int, space int) {
+	overflow := -max(space, 0)
+	totalWeight := 0.0
+	for i, child := range f.Children {
+		overflow += sizes[i]
+		if child.Shrink > 0 {
+			totalWeight += child.Shrink * float64(sizes[i])
+		}
+	}
+	if overflow <= 0 || totalWeight == 0 {
+		return
+	}
+	// Cut by cumulative weight so rounding never loses or adds a cell.
+	weight, cut := 0.0, 0
+	for i, child := range f.Children {
+		if child.Shrink <= 0 {
+			continue
+		}
+		weight += child.Shrink * float64(sizes[i])
+		target := int(math.Round(float64(overflow) * weight / totalWeight))
+		sizes[i] = max(sizes[i]-(target-cut), child.Min, 0)
+		cut = target
+	}
+}
+
 // Bounds returns the assigned bounds for the flex container.
 func (f *Flex) Bounds() Rect {
 	return f.bounds
 }
 
+// CacheKey implements Cacheable; the flex draws nothing of its own.
+func (f *Flex) CacheKey() uint64 {
+	return rectsCacheKey(f.childBounds...)
+}
+
 // ChildWidgets returns the flex container's child widgets.
 func (f *Flex) ChildWidgets() []Widget {
 	if len(f.Children) == 0 {
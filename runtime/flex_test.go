@@ -75,6 +75,44 @@ func TestVBox_FlexibleChildren(t *testing.T) {
 	}
 }
 
+func TestVBox_ConstrainedChild(t *testing.T) {
+	constrained := newTestWidget(100, 0)
+	vbox := VBox(Constrained(constrained, 5, 10))
+	vbox.Layout(Rect{0, 0, 100, 100})
+	if constrained.bounds.Height != 10 {
+		t.Errorf("height = %d, want max 10", constrained.bounds.Height)
+	}
+
+	// The space a capped child gives up goes to its flexible siblings.
+	flex := newTestWidget(100, 0)
+	vbox = VBox(Constrained(constrained, 5, 10), Expanded(flex))
+	vbox.Layout(Rect{0, 0, 100, 100})
+	if constrained.bounds.Height != 10 || flex.bounds.Height != 90 {
+		t.Errorf("heights = %d/%d, want 10/90", constrained.bounds.Height, flex.bounds.Height)
+	}
+
+	// With little space left the child still gets its minimum.
+	fixed := newTestWidget(100, 98)
+	vbox = VBox(Fixed(fixed), Constrained(constrained, 5, 10))
+	vbox.Layout(Rect{0, 0, 100, 100})
+	if constrained.bounds.Height != 5 {
+		t.Errorf("height = %d, want min 5", constrained.bounds.Height)
+	}
+}
+
+func TestHBox_ConstrainedUnboundedMax(t *testing.T) {
+	sidebar := newTestWidget(0, 10)
+	main := newTestWidget(0, 10)
+	hbox := HBox(Constrained(sidebar, 20, -1), Flexible(main, 3))
+	hbox.Layout(Rect{0, 0, 60, 10})
+	if sidebar.bounds.Width != 20 {
+		t.Errorf("sidebar width = %d, want min 20", sidebar.bounds.Width)
+	}
+	if main.bounds.Width != 40 {
+		t.Errorf("main width = %d, want 40", main.bounds.Width)
+	}
+}
+
 func TestVBox_MultipleFlexible(t *testing.T) {
 	fixed := newTestWidget(100, 20)
 	flex1 := newTestWidget(100, 0)
@@ -412,3 +450,42 @@ func TestFlex_MeasureVBox(t *testing.T) {
 		t.Errorf("VBox Measure height = %d, want 50", size.Height)
 	}
 }
+
+func TestFlex_GrowFactors(t *testing.T) {
+	fixed := newTestWidget(10, 1)
+	one := newTestWidget(0, 1)
+	two := newTestWidget(0, 1)
+
+	flex := NewFlex(Row)
+	flex.AddFixed(fixed, 10)
+	flex.Add(FlexItem{Widget: one, Grow: 1, Basis: -1})
+	flex.Add(FlexItem{Widget: two, Grow: 2, Basis: -1})
+	flex.Layout(Rect{X: 0, Y: 0, Width: 40, Height: 1})
+
+	if fixed.bounds.Width != 10 {
+		t.Errorf("fixed width = %d, want 10", fixed.bounds.Width)
+	}
+	if one.bounds.Width != 10 || two.bounds.Width != 20 {
+		t.Errorf("grow widths = %d, %d, want 10 and 20", one.bounds.Width, two.bounds.Width)
+	}
+	if two.bounds.X != 20 {
+		t.Errorf("grow=2 child X = %d, want 20", two.bounds.X)
+	}
+}
+
+func TestFlex_ShrinkFactors(t *testing.T) {
+	a := newTestWidget(0, 1)
+	b := newTestWidget(0, 1)
+	c := newTestWidget(0, 1)
+
+	flex := NewFlex(Row)
+	flex.Add(FlexItem{Widget: a, Shrink: 1, Basis: 20})
+	flex.Add(FlexItem{Widget: b, Shrink: 3, Basis: 20})
+	flex.AddFixed(c, 10)
+	flex.Layout(Rect{X: 0, Y: 0, Width: 34, Height: 1})
+
+	// 16 cells of overflow split 1:3 between the shrinking children.
+	if a.bounds.Width != 16 || b.bounds.Width != 8 || c.bounds.Width != 10 {
+		t.Errorf("widths = %d, %d, %d, want 16, 8, 10", a.bounds.Width, b.bounds.Width, c.bounds.Width)
+	}
+}
@@ -1,13 +1,28 @@
 package runtime
 
+import "github.com/odvcencio/fluffy-ui/terminal"
+
 // FocusScope manages focus within a layer/context.
 // Each modal layer has its own FocusScope, so overlays trap focus.
 type FocusScope struct {
-	widgets  []Focusable
-	current  int // Index of focused widget, -1 if none
-	onChange func(prev Focusable, next Focusable)
+	widgets     []Focusable
+	current     int // Index of focused widget, -1 if none
+	onChange    func(prev Focusable, next Focusable)
+	directional bool
+	noWrap      bool
+	initial     func(w Focusable) bool
 }
 
+// FocusDirection is a spatial direction for focus movement.
+type FocusDirection int
+
+const (
+	FocusUp FocusDirection = iota
+	FocusDown
+	FocusLeft
+	FocusRight
+)
+
 // NewFocusScope creates a new empty focus scope.
 func NewFocusScope() *FocusScope {
 	return &FocusScope{current: -1}
@@ -21,8 +36,20 @@ func (f *FocusScope) SetOnChange(fn func(prev Focusable, next Focusable)) {
 	f.onChange = fn
 }
 
+// SetInitialFocus sets a predicate choosing which widget receives focus when
+// the scope is populated. While it is set, Register only auto-focuses
+// matching widgets; RegisterFocusables falls back to the first focusable
+// widget when none match. Pass nil to restore first-registered focus.
+func (f *FocusScope) SetInitialFocus(predicate func(w Focusable) bool) {
+	if f == nil {
+		return
+	}
+	f.initial = predicate
+}
+
 // Register adds a focusable widget to the scope.
-// The first registered widget receives focus if nothing is focused.
+// The first registered widget receives focus if nothing is focused, or the
+// first one matching the SetInitialFocus predicate.
 func (f *FocusScope) Register(w Focusable) {
 	// Check if already registered
 	for _, existing := range f.widgets {
@@ -33,7 +60,7 @@ func (f *FocusScope) Register(w Focusable) {
 	f.widgets = append(f.widgets, w)
 
 	// Auto-focus first widget
-	if f.current == -1 && w.CanFocus() {
+	if f.current == -1 && w.CanFocus() && (f.initial == nil || f.initial(w)) {
 		f.current = len(f.widgets) - 1
 		w.Focus()
 	}
@@ -73,6 +100,7 @@ func (f *FocusScope) Current() Focusable {
 }
 
 // SetFocus focuses a specific widget.
+// Widgets owned by a registered FocusContainer are focused through it.
 // Returns true if focus changed.
 func (f *FocusScope) SetFocus(w Focusable) bool {
 	for i, existing := range f.widgets {
@@ -80,9 +108,27 @@ func (f *FocusScope) SetFocus(w Focusable) bool {
 			return f.focusIndex(i)
 		}
 	}
+	for i, existing := range f.widgets {
+		child := childFocusScope(existing)
+		if child == nil || !child.contains(w) || !existing.CanFocus() {
+			continue
+		}
+		changed := f.focusIndex(i)
+		return child.SetFocus(w) || changed
+	}
 	return false
 }
 
+// SetWrap controls whether FocusNext and FocusPrev wrap around at the ends.
+// Scopes owned by a FocusContainer usually disable wrapping so focus can
+// leave the container. Wrapping is enabled by default.
+func (f *FocusScope) SetWrap(wrap bool) {
+	if f == nil {
+		return
+	}
+	f.noWrap = !wrap
+}
+
 // FocusFirst focuses the first focusable widget.
 func (f *FocusScope) FocusFirst() bool {
 	for i, w := range f.widgets {
@@ -110,6 +156,9 @@ func (f *FocusScope) FocusNext() bool {
 	if len(f.widgets) == 0 {
 		return false
 	}
+	if child := childFocusScope(f.Current()); child != nil && child.FocusNext() {
+		return true
+	}
 
 	start := f.current
 	if start < 0 {
@@ -118,6 +167,9 @@ func (f *FocusScope) FocusNext() bool {
 
 	// Search forward, wrapping around
 	for i := 1; i <= len(f.widgets); i++ {
+		if f.noWrap && start+i >= len(f.widgets) {
+			break
+		}
 		idx := (start + i) % len(f.widgets)
 		if f.widgets[idx].CanFocus() {
 			return f.focusIndex(idx)
@@ -134,6 +186,10 @@ func (f *FocusScope) FocusPrev() bool {
 		return false
 	}
 
+	if child := childFocusScope(f.Current()); child != nil && child.FocusPrev() {
+		return true
+	}
+
 	start := f.current
 	if start < 0 {
 		start = len(f.widgets)
@@ -141,14 +197,129 @@ func (f *FocusScope) FocusPrev() bool {
 
 	// Search backward, wrapping around
 	for i := 1; i <= len(f.widgets); i++ {
+		if f.noWrap && start-i < 0 {
+			break
+		}
 		idx := (start - i + len(f.widgets)) % len(f.widgets)
 		if f.widgets[idx].CanFocus() {
-			return f.focusIndex(idx)
+			changed := f.focusIndex(idx)
+			// Entering a container backwards lands on its last child.
+			if child := childFocusScope(f.widgets[idx]); child != nil {
+				child.FocusLast()
+			}
+			return changed
 		}
 	}
 	return false
 }
 
+// SetDirectional enables arrow-key focus movement based on widget bounds.
+func (f *FocusScope) SetDirectional(enabled bool) {
+	if f == nil {
+		return
+	}
+	f.directional = enabled
+}
+
+// Directional reports whether arrow-key focus movement is enabled.
+func (f *FocusScope) Directional() bool {
+	return f != nil && f.directional
+}
+
+// HandleMessage moves focus for unmodified arrow keys when directional
+// navigation is enabled. Other messages are left unhandled.
+func (f *FocusScope) HandleMessage(msg Message) HandleResult {
+	if f == nil || !f.directional {
+		return Unhandled()
+	}
+	key, ok := msg.(KeyMsg)
+	if !ok || key.Alt || key.Ctrl || key.Shift {
+		return Unhandled()
+	}
+	var dir FocusDirection
+	switch key.Key {
+	case terminal.KeyUp:
+		dir = FocusUp
+	case terminal.KeyDown:
+		dir = FocusDown
+	case terminal.KeyLeft:
+		dir = FocusLeft
+	case terminal.KeyRight:
+		dir = FocusRight
+	default:
+		return Unhandled()
+	}
+	if f.FocusInDirection(dir) {
+		return Handled()
+	}
+	return Unhandled()
+}
+
+// FocusInDirection focuses the nearest widget in the given direction.
+// Widgets must implement BoundsProvider; ties are broken by tab order.
+// Returns true if focus changed.
+func (f *FocusScope) FocusInDirection(dir FocusDirection) bool {
+	if f == nil || f.current < 0 || f.current >= len(f.widgets) {
+		return false
+	}
+	from, ok := f.widgets[f.current].(BoundsProvider)
+	if !ok {
+		return false
+	}
+	origin := from.Bounds()
+	best := -1
+	bestScore := 0
+	for i, w := range f.widgets {
+		if i == f.current || !w.CanFocus() {
+			continue
+		}
+		bp, ok := w.(BoundsProvider)
+		if !ok {
+			continue
+		}
+		score, ok := directionalScore(origin, bp.Bounds(), dir)
+		if !ok {
+			continue
+		}
+		if best < 0 || score < bestScore {
+			best = i
+			bestScore = score
+		}
+	}
+	if best < 0 {
+		return false
+	}
+	return f.focusIndex(best)
+}
+
+// directionalScore ranks a candidate rect relative to origin.
+// Lower is closer; off-axis distance is weighted more heavily than distance
+// along the direction of travel. Centers are doubled to stay in integers.
+func directionalScore(origin, candidate Rect, dir FocusDirection) (int, bool) {
+	ox, oy := origin.X*2+origin.Width, origin.Y*2+origin.Height
+	cx, cy := candidate.X*2+candidate.Width, candidate.Y*2+candidate.Height
+	var primary, secondary int
+	switch dir {
+	case FocusUp:
+		primary, secondary = oy-cy, cx-ox
+	case FocusDown:
+		primary, secondary = cy-oy, cx-ox
+	case FocusLeft:
+		primary, secondary = ox-cx, cy-oy
+	case FocusRight:
+		primary, secondary = cx-ox, cy-oy
+	default:
+		return 0, false
+	}
+	if primary <= 0 {
+		return 0, false
+	}
+	if secondary < 0 {
+		secondary = -secondary
+	}
+	return primary + secondary*2, true
+}
+
 // ClearFocus removes focus from the current widget.
 func (f *FocusScope) ClearFocus() {
 	var prev Focusable
@@ -174,9 +345,48 @@ func (f *FocusScope) Reset() {
 
 // Count returns the number of registered widgets.
 func (f *FocusScope) Count() int {
+	if f == nil {
+		return 0
+	}
 	return len(f.widgets)
 }
 
+// RegisterAll registers every focusable widget in the tree rooted at root.
+func (f *FocusScope) RegisterAll(root Widget) {
+	RegisterFocusables(f, root)
+}
+
+// TabOrder returns a snapshot of the registered widgets in tab order.
+func (f *FocusScope) TabOrder() []Focusable {
+	if f == nil || len(f.widgets) == 0 {
+		return nil
+	}
+	order := make([]Focusable, len(f.widgets))
+	copy(order, f.widgets)
+	return order
+}
+
+// contains reports whether w is registered here or in a nested container scope.
+func (f *FocusScope) contains(w Focusable) bool {
+	for _, existing := range f.widgets {
+		if existing == w {
+			return true
+		}
+		if child := childFocusScope(existing); child != nil && child.contains(w) {
+			return true
+		}
+	}
+	return false
+}
+
+// childFocusScope returns the nested scope of a FocusContainer, or nil.
+func childFocusScope(w Focusable) *FocusScope {
+	if container, ok := w.(FocusContainer); ok {
+		return container.ChildFocusScope()
+	}
+	return nil
+}
+
 // focusIndex changes focus to the widget at index i.
 func (f *FocusScope) focusIndex(i int) bool {
 	if i == f.current {
@@ -1,23 +1,79 @@
 package runtime
 
+// FocusContainer is implemented by widgets that manage focus for their own
+// subtree, such as scroll views. RegisterFocusables registers the container
+// but not its descendants; FocusScope steps into the container's scope.
+type FocusContainer interface {
+	ChildFocusScope() *FocusScope
+}
+
 // RegisterFocusables registers focusable widgets from the tree into the scope.
+// Descendants of a FocusContainer are left to the container's own scope.
 func RegisterFocusables(scope *FocusScope, root Widget) {
 	if scope == nil || root == nil {
 		return
 	}
 	registerFocusable(scope, root)
+	if scope.Current() == nil {
+		scope.FocusFirst()
+	}
+}
+
+// RefreshFocusables replaces the widgets registered in scope with the
+// focusable widgets under root without moving focus. The focused widget
+// keeps focus if it is still in the tree and is blurred otherwise; no other
+// widget is focused.
+func RefreshFocusables(scope *FocusScope, root Widget) {
+	if scope == nil {
+		return
+	}
+	current := scope.Current()
+	var widgets []Focusable
+	if root != nil {
+		walkFocusables(scope, root, func(w Focusable) {
+			for _, existing := range widgets {
+				if existing == w {
+					return
+				}
+			}
+			widgets = append(widgets, w)
+		})
+	}
+	scope.widgets = widgets
+	scope.current = -1
+	for i, w := range widgets {
+		if w == current {
+			scope.current = i
+			return
+		}
+	}
+	if current != nil {
+		current.Blur()
+		if scope.onChange != nil {
+			scope.onChange(current, nil)
+		}
+	}
 }
 
 func registerFocusable(scope *FocusScope, widget Widget) {
+	walkFocusables(scope, widget, scope.Register)
+}
+
+// walkFocusables calls add for each focusable widget in the tree, stopping
+// at containers with their own scope.
+func walkFocusables(scope *FocusScope, widget Widget, add func(Focusable)) {
 	if widget == nil {
 		return
 	}
 	if focusable, ok := widget.(Focusable); ok {
-		scope.Register(focusable)
+		add(focusable)
+	}
+	if container, ok := widget.(FocusContainer); ok && container.ChildFocusScope() != nil && container.ChildFocusScope() != scope {
+		return
 	}
 	if container, ok := widget.(ChildProvider); ok {
 		for _, child := range container.ChildWidgets() {
-			registerFocusable(scope, child)
+			walkFocusables(scope, child, add)
 		}
 	}
 }
@@ -2,6 +2,8 @@ package runtime
 
 import (
 	"testing"
+
+	"github.com/odvcencio/fluffy-ui/terminal"
 )
 
 // focusableWidget is a test widget that can receive focus.
@@ -566,3 +568,117 @@ func TestFocusScope_ToggleFocusability(t *testing.T) {
 		t.Error("FocusPrev should stay at w2 (w1 is non-focusable)")
 	}
 }
+
+type boundedFocusable struct {
+	focusableWidget
+	bounds Rect
+}
+
+func (b *boundedFocusable) Bounds() Rect { return b.bounds }
+
+func newBoundedFocusable(id string, x, y, w, h int) *boundedFocusable {
+	return &boundedFocusable{
+		focusableWidget: focusableWidget{canFocus: true, id: id},
+		bounds:          Rect{X: x, Y: y, Width: w, Height: h},
+	}
+}
+
+func TestFocusScope_DirectionalDown(t *testing.T) {
+	fs := NewFocusScope()
+	fs.SetDirectional(true)
+	topLeft := newBoundedFocusable("tl", 0, 0, 10, 1)
+	topRight := newBoundedFocusable("tr", 12, 0, 10, 1)
+	bottomRight := newBoundedFocusable("br", 12, 2, 10, 1)
+	bottomLeft := newBoundedFocusable("bl", 0, 2, 10, 1)
+	fs.Register(topLeft)
+	fs.Register(topRight)
+	fs.Register(bottomRight)
+	fs.Register(bottomLeft)
+
+	result := fs.HandleMessage(KeyMsg{Key: terminal.KeyDown})
+	if !result.Handled {
+		t.Fatal("expected Down to be handled")
+	}
+	if fs.Current() != bottomLeft {
+		t.Errorf("Current() = %v, want bottom-left widget below", fs.Current())
+	}
+
+	fs.HandleMessage(KeyMsg{Key: terminal.KeyRight})
+	if fs.Current() != bottomRight {
+		t.Errorf("Current() = %v, want bottom-right widget", fs.Current())
+	}
+
+	if result := fs.HandleMessage(KeyMsg{Key: terminal.KeyDown}); result.Handled {
+		t.Error("expected Down with no widget below to be unhandled")
+	}
+}
+
+func TestFocusScope_DirectionalTieUsesTabOrder(t *testing.T) {
+	fs := NewFocusScope()
+	fs.SetDirectional(true)
+	origin := newBoundedFocusable("origin", 10, 0, 4, 1)
+	first := newBoundedFocusable("first", 6, 2, 4, 1)
+	second := newBoundedFocusable("second", 14, 2, 4, 1)
+	fs.Register(origin)
+	fs.Register(first)
+	fs.Register(second)
+
+	fs.FocusInDirection(FocusDown)
+	if fs.Current() != first {
+		t.Errorf("Current() = %v, want first registered on tie", fs.Current())
+	}
+}
+
+func TestFocusScope_DirectionalDisabled(t *testing.T) {
+	fs := NewFocusScope()
+	fs.Register(newBoundedFocusable("a", 0, 0, 10, 1))
+	fs.Register(newBoundedFocusable("b", 0, 2, 10, 1))
+
+	if result := fs.HandleMessage(KeyMsg{Key: terminal.KeyDown}); result.Handled {
+		t.Error("expected arrows to be unhandled when directional navigation is off")
+	}
+}
+
+func TestFocusScope_RegisterAllTabOrder(t *testing.T) {
+	w1 := newFocusable("w1")
+	w2 := newFocusable("w2")
+	w3 := newFocusable("w3")
+	root := VBox(Fixed(w1), Fixed(HBox(Fixed(w2), Fixed(newTestWidget(1, 1)))), Fixed(w3))
+
+	fs := NewFocusScope()
+	fs.RegisterAll(root)
+
+	if fs.Count() != 3 {
+		t.Fatalf("Count() = %d, want 3", fs.Count())
+	}
+	order := fs.TabOrder()
+	want := []Focusable{w1, w2, w3}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("TabOrder()[%d] = %v, want %v", i, order[i], want[i])
+		}
+	}
+
+	order[0] = nil
+	if fs.TabOrder()[0] != w1 {
+		t.Error("TabOrder should return a copy")
+	}
+}
+
+func TestFocusScope_NoWrap(t *testing.T) {
+	scope := NewFocusScope()
+	scope.SetWrap(false)
+	a, b := newFocusable("a"), newFocusable("b")
+	scope.Register(a)
+	scope.Register(b)
+
+	if !scope.FocusNext() || scope.Current() != b {
+		t.Fatal("expected focus to move to b")
+	}
+	if scope.FocusNext() || scope.Current() != b {
+		t.Fatal("FocusNext should stop at the last widget")
+	}
+	if !scope.FocusPrev() || scope.FocusPrev() || scope.Current() != a {
+		t.Fatal("FocusPrev should stop at the first widget")
+	}
+}
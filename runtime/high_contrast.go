@@ -0,0 +1,59 @@
+package runtime
+
+import (
+	"github.com/odvcencio/fluffy-ui/accessibility"
+	"github.com/odvcencio/fluffy-ui/backend"
+)
+
+// SetHighContrast turns high-contrast mode on or off and broadcasts a
+// HighContrastChangedMsg. An explicit call overrides the preference the
+// backend detects at startup.
+func (a *App) SetHighContrast(enabled bool) {
+	if a == nil {
+		return
+	}
+	a.highContrastSet.Store(true)
+	a.highContrast.Store(enabled)
+	a.Post(HighContrastChangedMsg{Enabled: enabled})
+}
+
+// detectHighContrast enables high-contrast mode when the backend reports
+// that the terminal prefers it and the app has not set it explicitly. It
+// runs only with AppConfig.DetectHighContrast.
+func (a *App) detectHighContrast() {
+	if a.highContrastSet.Load() {
+		return
+	}
+	detector, ok := a.backend.(backend.HighContrastDetector)
+	if !ok || !detector.PrefersHighContrast() {
+		return
+	}
+	a.highContrast.Store(true)
+	a.Post(HighContrastChangedMsg{Enabled: true})
+}
+
+// applyHighContrast calls ApplyHighContrast on every adapter in the screen.
+func (s *Screen) applyHighContrast(enabled bool) {
+	if s == nil {
+		return
+	}
+	for _, layer := range s.layers {
+		if layer != nil {
+			applyHighContrastWidget(layer.Root, enabled)
+		}
+	}
+}
+
+func applyHighContrastWidget(w Widget, enabled bool) {
+	if w == nil {
+		return
+	}
+	if adapter, ok := w.(accessibility.HighContrastAdapter); ok {
+		adapter.ApplyHighContrast(enabled)
+	}
+	if children, ok := w.(ChildProvider); ok {
+		for _, child := range children.ChildWidgets() {
+			applyHighContrastWidget(child, enabled)
+		}
+	}
+}
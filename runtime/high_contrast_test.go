@@ -0,0 +1,101 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+)
+
+type contrastWidget struct {
+	bindTestWidget
+	applied []bool
+}
+
+func (c *contrastWidget) ApplyHighContrast(enabled bool) {
+	c.applied = append(c.applied, enabled)
+}
+
+func TestApp_SetHighContrastReachesAdapters(t *testing.T) {
+	app := NewApp(AppConfig{})
+	app.screen = NewScreen(10, 5)
+	child := &contrastWidget{}
+	root := &bindTestWidget{children: []Widget{child}}
+	overlay := &contrastWidget{}
+	app.screen.SetRoot(root)
+	app.screen.PushLayer(overlay, false)
+
+	app.SetHighContrast(true)
+	if !app.Services().HighContrast() {
+		t.Fatal("Services().HighContrast() = false after SetHighContrast(true)")
+	}
+	msg := <-app.messages
+	if changed, ok := msg.(HighContrastChangedMsg); !ok || !changed.Enabled {
+		t.Fatalf("posted %#v, want HighContrastChangedMsg{Enabled: true}", msg)
+	}
+	if !DefaultUpdate(app, msg) {
+		t.Fatal("expected HighContrastChangedMsg to be handled")
+	}
+	if len(child.applied) != 1 || !child.applied[0] {
+		t.Fatalf("child applied = %v, want [true]", child.applied)
+	}
+	if len(overlay.applied) != 1 || !overlay.applied[0] {
+		t.Fatalf("overlay applied = %v, want [true]", overlay.applied)
+	}
+}
+
+func TestScreen_HighContrastFocusIndicator(t *testing.T) {
+	app := NewApp(AppConfig{})
+	screen := NewScreen(10, 3)
+	screen.SetServices(app.Services())
+	screen.SetRoot(&nonHandlingWidget{})
+	widget := newBoundedFocusable("field", 2, 1, 5, 1)
+	screen.FocusScope().Register(widget)
+	screen.FocusScope().SetFocus(widget)
+
+	screen.drawFocusIndicator()
+	if got := screen.Buffer().Get(1, 1).Rune; got == '>' {
+		t.Fatal("indicator drawn without a focus style or high contrast")
+	}
+
+	app.highContrast.Store(true)
+	screen.drawFocusIndicator()
+	cell := screen.Buffer().Get(1, 1)
+	if cell.Rune != '>' {
+		t.Fatalf("indicator = %q, want '>'", cell.Rune)
+	}
+	if cell.Style.Attributes()&backend.AttrBold == 0 {
+		t.Fatal("high-contrast indicator should be bold")
+	}
+}
+
+// contrastBackend is a simulated terminal that prefers high contrast.
+type contrastBackend struct {
+	*sim.Backend
+	asked bool
+}
+
+func (b *contrastBackend) PrefersHighContrast() bool {
+	b.asked = true
+	return true
+}
+
+func TestApp_DetectHighContrastIsOptIn(t *testing.T) {
+	for _, detect := range []bool{false, true} {
+		be := &contrastBackend{Backend: sim.New(5, 3)}
+		app := NewApp(AppConfig{Backend: be, Root: &bindTestWidget{}, DetectHighContrast: detect})
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		done := make(chan error, 1)
+		go func() {
+			done <- app.Run(ctx)
+		}()
+		waitForScreen(t, app)
+		cancel()
+		<-done
+		if be.asked != detect || app.Services().HighContrast() != detect {
+			t.Fatalf("DetectHighContrast=%v: asked=%v enabled=%v", detect, be.asked, app.Services().HighContrast())
+		}
+	}
+}
@@ -2,7 +2,9 @@ package runtime
 
 import "sync/atomic"
 
-// Invalidator posts an invalidate message with coalescing.
+// Invalidator posts an invalidate message with coalescing. At most one
+// InvalidateMsg is queued at a time; the event loop clears the pending flag
+// once it has processed the message.
 type Invalidator struct {
 	post    func(Message) bool
 	pending atomic.Bool
@@ -1,6 +1,9 @@
 package runtime
 
-import "testing"
+import (
+	"sync"
+	"testing"
+)
 
 func TestInvalidator_PostsInvalidate(t *testing.T) {
 	posted := 0
@@ -58,3 +61,30 @@ func TestInvalidator_Schedule(t *testing.T) {
 		t.Fatalf("expected invalidate post after schedule, got %d", posted)
 	}
 }
+
+func TestApp_InvalidateCoalescesInQueue(t *testing.T) {
+	app := NewApp(AppConfig{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			app.Invalidate()
+		}()
+	}
+	wg.Wait()
+	if got := len(app.messages); got != 1 {
+		t.Fatalf("queued messages = %d, want 1", got)
+	}
+
+	// Processing the message clears the pending flag, as the event loop does.
+	if _, ok := (<-app.messages).(InvalidateMsg); !ok {
+		t.Fatal("expected InvalidateMsg")
+	}
+	app.invalidator.resetPending()
+	app.Invalidate()
+	if got := len(app.messages); got != 1 {
+		t.Fatalf("queued messages after reset = %d, want 1", got)
+	}
+}
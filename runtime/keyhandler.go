@@ -4,3 +4,9 @@ package runtime
 type KeyHandler interface {
 	HandleKey(app *App, msg KeyMsg, focused Widget) bool
 }
+
+// MouseHandler is optionally implemented by a KeyHandler to see mouse events
+// before widget dispatch. Target is the widget under the pointer, or nil.
+type MouseHandler interface {
+	HandleMouse(app *App, msg MouseMsg, target Widget) bool
+}
@@ -0,0 +1,277 @@
+package runtime
+
+// FlowLayout places children left-to-right, wrapping to a new row when
+// the current row is full.
+type FlowLayout struct {
+	Children []Widget
+	Gap      int // Horizontal and vertical space between children
+
+	// Cached layout
+	bounds      Rect
+	childBounds []Rect
+}
+
+// Flow creates a wrapping flow layout.
+func Flow(children ...Widget) *FlowLayout {
+	return &FlowLayout{Children: children}
+}
+
+// WithGap sets the gap between children and rows.
+func (f *FlowLayout) WithGap(gap int) *FlowLayout {
+	f.Gap = gap
+	return f
+}
+
+// Add appends a child to the flow.
+func (f *FlowLayout) Add(child Widget) {
+	f.Children = append(f.Children, child)
+}
+
+// Measure calculates the size needed to wrap children within the max width.
+func (f *FlowLayout) Measure(constraints Constraints) Size {
+	if len(f.Children) == 0 {
+		return constraints.MinSize()
+	}
+	sizes := f.measureChildren(constraints.MaxWidth)
+	rows := flowRows(sizes, constraints.MaxWidth, f.Gap)
+	width, height := 0, 0
+	for i, row := range rows {
+		rowWidth, rowHeight := f.rowExtent(sizes, row)
+		width = max(width, rowWidth)
+		height += rowHeight
+		if i > 0 {
+			height += f.Gap
+		}
+	}
+	return constraints.Constrain(Size{Width: width, Height: height})
+}
+
+// Layout assigns rows and positions children within bounds.
+func (f *FlowLayout) Layout(bounds Rect) {
+	f.bounds = bounds
+	f.childBounds = make([]Rect, len(f.Children))
+	if len(f.Children) == 0 {
+		return
+	}
+	sizes := f.measureChildren(bounds.Width)
+	rows := flowRows(sizes, bounds.Width, f.Gap)
+	y := bounds.Y
+	for _, row := range rows {
+		_, rowHeight := f.rowExtent(sizes, row)
+		x := bounds.X
+		for _, i := range row {
+			f.childBounds[i] = Rect{X: x, Y: y, Width: sizes[i].Width, Height: sizes[i].Height}
+			x += sizes[i].Width + f.Gap
+		}
+		y += rowHeight + f.Gap
+	}
+	for i, child := range f.Children {
+		if child != nil {
+			child.Layout(f.childBounds[i])
+		}
+	}
+}
+
+// Bounds returns the assigned bounds for the flow container.
+func (f *FlowLayout) Bounds() Rect {
+	return f.bounds
+}
+
+// CacheKey implements Cacheable; the layout draws nothing of its own.
+func (f *FlowLayout) CacheKey() uint64 {
+	return rectsCacheKey(f.childBounds...)
+}
+
+// ChildWidgets returns all children.
+func (f *FlowLayout) ChildWidgets() []Widget {
+	children := make([]Widget, 0, len(f.Children))
+	for _, child := range f.Children {
+		if child != nil {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// Render draws all children.
+func (f *FlowLayout) Render(ctx RenderContext) {
+	for i, child := range f.Children {
+		if child != nil && i < len(f.childBounds) {
+			child.Render(ctx.Sub(f.childBounds[i]))
+		}
+	}
+}
+
+// HandleMessage dispatches to children; first handler wins.
+func (f *FlowLayout) HandleMessage(msg Message) HandleResult {
+	for _, child := range f.Children {
+		if child == nil {
+			continue
+		}
+		if result := child.HandleMessage(msg); result.Handled {
+			return result
+		}
+	}
+	return Unhandled()
+}
+
+func (f *FlowLayout) measureChildren(maxWidth int) []Size {
+	sizes := make([]Size, len(f.Children))
+	for i, child := range f.Children {
+		if child == nil {
+			continue
+		}
+		size := child.Measure(Constraints{MaxWidth: maxWidth, MaxHeight: maxInt})
+		size.Width = min(size.Width, maxWidth)
+		sizes[i] = size
+	}
+	return sizes
+}
+
+func (f *FlowLayout) rowExtent(sizes []Size, row []int) (width, height int) {
+	for j, i := range row {
+		width += sizes[i].Width
+		if j > 0 {
+			width += f.Gap
+		}
+		height = max(height, sizes[i].Height)
+	}
+	return width, height
+}
+
+// flowRows groups child indices into rows that fit within width.
+// A child wider than the row still gets a row of its own.
+func flowRows(sizes []Size, width, gap int) [][]int {
+	var rows [][]int
+	var row []int
+	used := 0
+	for i, size := range sizes {
+		next := used + size.Width
+		if len(row) > 0 {
+			next += gap
+		}
+		if len(row) > 0 && next > width {
+			rows = append(rows, row)
+			row = nil
+			next = size.Width
+		}
+		row = append(row, i)
+		used = next
+	}
+	if len(row) > 0 {
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// AlignH is a horizontal alignment.
+type AlignH int
+
+const (
+	AlignLeft AlignH = iota
+	AlignCenter
+	AlignRight
+)
+
+// AlignV is a vertical alignment.
+type AlignV int
+
+const (
+	AlignTop AlignV = iota
+	AlignMiddle
+	AlignBottom
+)
+
+// AlignRect positions a rect of the given size within bounds.
+// The size is clamped to bounds.
+func AlignRect(bounds Rect, size Size, h AlignH, v AlignV) Rect {
+	width := max(0, min(size.Width, bounds.Width))
+	height := max(0, min(size.Height, bounds.Height))
+	r := Rect{X: bounds.X, Y: bounds.Y, Width: width, Height: height}
+	switch h {
+	case AlignCenter:
+		r.X += (bounds.Width - width) / 2
+	case AlignRight:
+		r.X += bounds.Width - width
+	}
+	switch v {
+	case AlignMiddle:
+		r.Y += (bounds.Height - height) / 2
+	case AlignBottom:
+		r.Y += bounds.Height - height
+	}
+	return r
+}
+
+// AlignLayout positions a single child at its measured size within its bounds.
+type AlignLayout struct {
+	Child      Widget
+	Horizontal AlignH
+	Vertical   AlignV
+
+	bounds      Rect
+	childBounds Rect
+}
+
+// Align wraps a widget with the given alignment.
+func Align(child Widget, h AlignH, v AlignV) *AlignLayout {
+	return &AlignLayout{Child: child, Horizontal: h, Vertical: v}
+}
+
+// Center wraps a widget so it is centered in both directions.
+func Center(child Widget) *AlignLayout {
+	return Align(child, AlignCenter, AlignMiddle)
+}
+
+// Measure returns the child's measured size.
+func (a *AlignLayout) Measure(constraints Constraints) Size {
+	if a.Child == nil {
+		return constraints.MinSize()
+	}
+	return constraints.Constrain(a.Child.Measure(constraints))
+}
+
+// Layout measures the child and positions it within bounds.
+func (a *AlignLayout) Layout(bounds Rect) {
+	a.bounds = bounds
+	if a.Child == nil {
+		a.childBounds = Rect{}
+		return
+	}
+	size := a.Child.Measure(Loose(bounds.Width, bounds.Height))
+	a.childBounds = AlignRect(bounds, size, a.Horizontal, a.Vertical)
+	a.Child.Layout(a.childBounds)
+}
+
+// Bounds returns the assigned bounds for the align container.
+func (a *AlignLayout) Bounds() Rect {
+	return a.bounds
+}
+
+// CacheKey implements Cacheable; the layout draws nothing of its own.
+func (a *AlignLayout) CacheKey() uint64 {
+	return rectsCacheKey(a.childBounds)
+}
+
+// ChildWidgets returns the child.
+func (a *AlignLayout) ChildWidgets() []Widget {
+	if a.Child == nil {
+		return nil
+	}
+	return []Widget{a.Child}
+}
+
+// Render draws the child.
+func (a *AlignLayout) Render(ctx RenderContext) {
+	if a.Child != nil {
+		a.Child.Render(ctx.Sub(a.childBounds))
+	}
+}
+
+// HandleMessage forwards messages to the child.
+func (a *AlignLayout) HandleMessage(msg Message) HandleResult {
+	if a.Child == nil {
+		return Unhandled()
+	}
+	return a.Child.HandleMessage(msg)
+}
@@ -0,0 +1,76 @@
+package runtime
+
+import "testing"
+
+func TestFlowLayout_Wraps(t *testing.T) {
+	buttons := []*testWidget{
+		newTestWidget(10, 1),
+		newTestWidget(10, 3),
+		newTestWidget(10, 2),
+		newTestWidget(10, 1),
+	}
+	flow := Flow(buttons[0], buttons[1], buttons[2], buttons[3]).WithGap(1)
+
+	size := flow.Measure(Loose(25, 100))
+	if size.Height != 3+1+2 {
+		t.Errorf("Measure height = %d, want 6", size.Height)
+	}
+	if size.Width != 21 {
+		t.Errorf("Measure width = %d, want 21", size.Width)
+	}
+
+	flow.Layout(Rect{X: 0, Y: 0, Width: 25, Height: 10})
+
+	want := []Rect{
+		{X: 0, Y: 0, Width: 10, Height: 1},
+		{X: 11, Y: 0, Width: 10, Height: 3},
+		{X: 0, Y: 4, Width: 10, Height: 2},
+		{X: 11, Y: 4, Width: 10, Height: 1},
+	}
+	for i, b := range buttons {
+		if b.bounds != want[i] {
+			t.Errorf("button %d bounds = %v, want %v", i, b.bounds, want[i])
+		}
+	}
+	if got := len(flow.ChildWidgets()); got != 4 {
+		t.Errorf("ChildWidgets() = %d, want 4", got)
+	}
+}
+
+func TestFlowLayout_OversizedChild(t *testing.T) {
+	wide := newTestWidget(40, 1)
+	small := newTestWidget(5, 1)
+	flow := Flow(small, wide)
+
+	flow.Layout(Rect{Width: 20, Height: 5})
+	if wide.bounds != (Rect{X: 0, Y: 1, Width: 20, Height: 1}) {
+		t.Errorf("wide bounds = %v, want clamped to its own row", wide.bounds)
+	}
+}
+
+func TestAlignLayout(t *testing.T) {
+	child := newTestWidget(10, 3)
+	bounds := Rect{X: 0, Y: 0, Width: 40, Height: 24}
+
+	center := Center(child)
+	if size := center.Measure(Loose(40, 24)); size != (Size{Width: 10, Height: 3}) {
+		t.Errorf("Measure = %v, want 10x3", size)
+	}
+	center.Layout(bounds)
+	if want := (Rect{X: 15, Y: 10, Width: 10, Height: 3}); child.bounds != want {
+		t.Errorf("centered bounds = %v, want %v", child.bounds, want)
+	}
+
+	Align(child, AlignRight, AlignTop).Layout(bounds)
+	if want := (Rect{X: 30, Y: 0, Width: 10, Height: 3}); child.bounds != want {
+		t.Errorf("right-aligned bounds = %v, want %v", child.bounds, want)
+	}
+
+	Align(child, AlignLeft, AlignBottom).Layout(Rect{X: 5, Y: 5, Width: 8, Height: 10})
+	if want := (Rect{X: 5, Y: 12, Width: 8, Height: 3}); child.bounds != want {
+		t.Errorf("clamped bottom bounds = %v, want %v", child.bounds, want)
+	}
+	if got := len(center.ChildWidgets()); got != 1 {
+		t.Errorf("ChildWidgets() = %d, want 1", got)
+	}
+}
@@ -6,12 +6,15 @@ type Lifecycle interface {
 	Unmount()
 }
 
-// MountTree calls Mount on widgets that implement Lifecycle.
+// MountTree calls Mount on widgets that implement Lifecycle. Parents are
+// mounted before their children (depth-first pre-order).
 func MountTree(root Widget) {
 	mountWidget(root)
 }
 
-// UnmountTree calls Unmount on widgets that implement Lifecycle.
+// UnmountTree calls Unmount on widgets that implement Lifecycle. Children
+// are unmounted before their parents (depth-first post-order), so a child's
+// subscriptions are released while its parent is still intact.
 func UnmountTree(root Widget) {
 	unmountWidget(root)
 }
@@ -1,11 +1,16 @@
 package runtime
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 type lifecycleWidget struct {
+	name      string
 	children  []Widget
 	mounted   int
 	unmounted int
+	calls     *[]string
 }
 
 func (w *lifecycleWidget) Measure(constraints Constraints) Size {
@@ -26,10 +31,16 @@ func (w *lifecycleWidget) ChildWidgets() []Widget {
 
 func (w *lifecycleWidget) Mount() {
 	w.mounted++
+	if w.calls != nil {
+		*w.calls = append(*w.calls, "mount "+w.name)
+	}
 }
 
 func (w *lifecycleWidget) Unmount() {
 	w.unmounted++
+	if w.calls != nil {
+		*w.calls = append(*w.calls, "unmount "+w.name)
+	}
 }
 
 func TestScreen_LifecycleRoot(t *testing.T) {
@@ -69,3 +80,23 @@ func TestScreen_LifecycleLayer(t *testing.T) {
 		t.Fatalf("expected root to remain mounted, got %d", root.unmounted)
 	}
 }
+
+func TestLifecycle_TreeOrder(t *testing.T) {
+	var calls []string
+	grandchild := &lifecycleWidget{name: "grandchild", calls: &calls}
+	child := &lifecycleWidget{name: "child", calls: &calls, children: []Widget{grandchild}}
+	root := &lifecycleWidget{name: "root", calls: &calls, children: []Widget{child}}
+
+	MountTree(root)
+	want := []string{"mount root", "mount child", "mount grandchild"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("mount order = %v, want %v", calls, want)
+	}
+
+	calls = nil
+	UnmountTree(root)
+	want = []string{"unmount grandchild", "unmount child", "unmount root"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("unmount order = %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,114 @@
+package runtime
+
+import (
+	"sync"
+	"time"
+)
+
+// MacroStep is a recorded keystroke and the delay since the previous one.
+type MacroStep struct {
+	Key   KeyMsg        `json:"key"`
+	Delay time.Duration `json:"delay"`
+}
+
+// Macro is a recorded keystroke sequence. It marshals to JSON as-is.
+type Macro struct {
+	Steps []MacroStep `json:"steps"`
+}
+
+// Speed returns a copy of the macro with delays divided by factor.
+// A factor of 2 replays twice as fast; factors <= 0 return the macro unchanged.
+func (m Macro) Speed(factor float64) Macro {
+	if factor <= 0 {
+		return m
+	}
+	out := Macro{Steps: make([]MacroStep, len(m.Steps))}
+	for i, step := range m.Steps {
+		step.Delay = time.Duration(float64(step.Delay) / factor)
+		out.Steps[i] = step
+	}
+	return out
+}
+
+// MacroRecorder captures key messages handled by DefaultUpdate and replays
+// them into an app. Attach it with App.SetMacroRecorder.
+type MacroRecorder struct {
+	mu        sync.Mutex
+	recording bool
+	last      time.Time
+	steps     []MacroStep
+	now       func() time.Time
+	sleep     func(time.Duration)
+}
+
+// NewMacroRecorder creates an idle macro recorder.
+func NewMacroRecorder() *MacroRecorder {
+	return &MacroRecorder{now: time.Now, sleep: time.Sleep}
+}
+
+// StartRecording discards any previous steps and begins capturing keys.
+func (r *MacroRecorder) StartRecording() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recording = true
+	r.steps = nil
+	r.last = time.Time{}
+}
+
+// StopRecording stops capturing and returns the recorded macro.
+func (r *MacroRecorder) StopRecording() Macro {
+	if r == nil {
+		return Macro{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recording = false
+	macro := Macro{Steps: r.steps}
+	r.steps = nil
+	return macro
+}
+
+// Recording reports whether keys are being captured.
+func (r *MacroRecorder) Recording() bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.recording
+}
+
+// Replay posts each key in the macro to the app, waiting the recorded delay
+// before each one. It blocks until the macro has been posted.
+func (r *MacroRecorder) Replay(macro Macro, app *App) {
+	if r == nil || app == nil {
+		return
+	}
+	for _, step := range macro.Steps {
+		if step.Delay > 0 {
+			r.sleep(step.Delay)
+		}
+		app.Post(step.Key)
+	}
+}
+
+func (r *MacroRecorder) record(msg KeyMsg) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.recording {
+		return
+	}
+	now := r.now()
+	var delay time.Duration
+	if !r.last.IsZero() {
+		delay = now.Sub(r.last)
+	}
+	r.last = now
+	r.steps = append(r.steps, MacroStep{Key: msg, Delay: delay})
+}
@@ -0,0 +1,72 @@
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func TestMacroRecorder_RecordAndReplay(t *testing.T) {
+	app := NewApp(AppConfig{})
+	app.screen = NewScreen(10, 5)
+	recorder := NewMacroRecorder()
+	clock := time.Unix(0, 0)
+	recorder.now = func() time.Time { return clock }
+	var slept []time.Duration
+	recorder.sleep = func(d time.Duration) { slept = append(slept, d) }
+	app.SetMacroRecorder(recorder)
+
+	keys := []KeyMsg{
+		{Key: terminal.KeyRune, Rune: 'a'},
+		{Key: terminal.KeyRune, Rune: 'b'},
+		{Key: terminal.KeyEnter},
+	}
+	DefaultUpdate(app, InvalidateMsg{}) // Not a key; must not be recorded.
+	recorder.StartRecording()
+	for i, key := range keys {
+		clock = clock.Add(time.Duration(i) * 100 * time.Millisecond)
+		DefaultUpdate(app, key)
+	}
+	macro := recorder.StopRecording()
+	DefaultUpdate(app, KeyMsg{Key: terminal.KeyRune, Rune: 'z'})
+
+	if len(macro.Steps) != 3 {
+		t.Fatalf("recorded %d steps, want 3", len(macro.Steps))
+	}
+
+	data, err := json.Marshal(macro)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded Macro
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	recorder.Replay(decoded.Speed(2), app)
+	for i, want := range keys {
+		select {
+		case msg := <-app.messages:
+			if msg != want {
+				t.Fatalf("message %d = %#v, want %#v", i, msg, want)
+			}
+		default:
+			t.Fatalf("message %d missing", i)
+		}
+	}
+	if len(app.messages) != 0 {
+		t.Fatalf("unexpected extra messages: %d", len(app.messages))
+	}
+
+	want := []time.Duration{50 * time.Millisecond, 100 * time.Millisecond}
+	if len(slept) != len(want) {
+		t.Fatalf("slept %v, want %v", slept, want)
+	}
+	for i := range want {
+		if slept[i] != want[i] {
+			t.Fatalf("slept %v, want %v", slept, want)
+		}
+	}
+}
@@ -71,6 +71,12 @@ const (
 	MouseMove
 )
 
+// SelectAllMsg asks the focused widget to select all of its content.
+// DefaultUpdate posts it when Ctrl+A is not handled otherwise.
+type SelectAllMsg struct{}
+
+func (SelectAllMsg) isMessage() {}
+
 // TickMsg is sent on each frame tick for animations.
 type TickMsg struct {
 	Time time.Time
@@ -87,3 +93,28 @@ func (QueueFlushMsg) isMessage() {}
 type InvalidateMsg struct{}
 
 func (InvalidateMsg) isMessage() {}
+
+// ErrorMsg reports an error from a background effect or widget.
+// DefaultUpdate passes it to AppConfig.ErrorHandler.
+type ErrorMsg struct {
+	Err    error
+	Source string
+}
+
+func (ErrorMsg) isMessage() {}
+
+// HighContrastChangedMsg reports that high-contrast mode was turned on or
+// off. DefaultUpdate passes it to every widget that implements
+// accessibility.HighContrastAdapter.
+type HighContrastChangedMsg struct {
+	Enabled bool
+}
+
+func (HighContrastChangedMsg) isMessage() {}
+
+// ThemeChangedMsg reports that the active theme was replaced. DefaultUpdate
+// passes it to every widget that implements ThemeAdapter and redraws the
+// screen.
+type ThemeChangedMsg struct{}
+
+func (ThemeChangedMsg) isMessage() {}
@@ -0,0 +1,87 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Plugin extends an App with optional behaviour. The plugin package
+// provides a registry and helpers built on this interface.
+type Plugin interface {
+	Name() string
+	Init(app *App) error
+	Shutdown() error
+}
+
+// PluginFactory creates a plugin for an app.
+type PluginFactory func(app *App) Plugin
+
+// ObserveMessages registers fn to see every message the event loop
+// receives, including ticks, before the update function runs. fn is called
+// on the event loop goroutine. The returned function removes the observer.
+func (a *App) ObserveMessages(fn func(Message)) (remove func()) {
+	if a == nil || fn == nil {
+		return func() {}
+	}
+	a.observerMu.Lock()
+	id := a.nextObserver
+	a.nextObserver++
+	if a.observers == nil {
+		a.observers = make(map[int]func(Message))
+	}
+	a.observers[id] = fn
+	a.observerMu.Unlock()
+	return func() {
+		a.observerMu.Lock()
+		delete(a.observers, id)
+		a.observerMu.Unlock()
+	}
+}
+
+func (a *App) notifyObservers(msg Message) {
+	a.observerMu.Lock()
+	if len(a.observers) == 0 {
+		a.observerMu.Unlock()
+		return
+	}
+	observers := make([]func(Message), 0, len(a.observers))
+	for _, fn := range a.observers {
+		observers = append(observers, fn)
+	}
+	a.observerMu.Unlock()
+	for _, fn := range observers {
+		fn(msg)
+	}
+}
+
+// startPlugins creates and initialises the configured plugins. On failure the
+// plugins already started are shut down. The returned function shuts down
+// every started plugin in reverse order.
+func (a *App) startPlugins() (shutdown func() error, err error) {
+	var started []Plugin
+	shutdown = func() error {
+		var errs []error
+		for i := len(started) - 1; i >= 0; i-- {
+			if err := started[i].Shutdown(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		started = nil
+		return errors.Join(errs...)
+	}
+	for _, factory := range a.plugins {
+		if factory == nil {
+			continue
+		}
+		plugin := factory(a)
+		if plugin == nil {
+			continue
+		}
+		if err := plugin.Init(a); err != nil {
+			_ = shutdown()
+			return nil, fmt.Errorf("init plugin %s: %w", plugin.Name(), err)
+		}
+		started = append(started, plugin)
+	}
+	return shutdown, nil
+}
@@ -0,0 +1,81 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const pprofShutdownTimeout = 2 * time.Second
+
+// PProfURL returns the base URL of the pprof endpoint, or "" when
+// AppConfig.PProfAddr is unset or the app is not running.
+func (a *App) PProfURL() string {
+	if a == nil {
+		return ""
+	}
+	url, _ := a.pprofURL.Load().(string)
+	return url
+}
+
+// startPProf serves net/http/pprof on addr until ctx is done or the returned
+// stop function is called. stop waits for the server to shut down.
+func (a *App) startPProf(ctx context.Context, addr string) (stop func(), err error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.pprofURL.Store("")
+		}
+	}()
+	a.pprofURL.Store("http://" + pprofHost(listener.Addr()) + "/debug/pprof/")
+
+	var once sync.Once
+	shutdown := func() {
+		once.Do(func() {
+			a.pprofURL.Store("")
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), pprofShutdownTimeout)
+			defer cancel()
+			_ = server.Shutdown(shutdownCtx)
+			<-done
+		})
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			shutdown()
+		case <-done:
+		}
+	}()
+	return shutdown, nil
+}
+
+// pprofHost formats a listener address, replacing unspecified hosts with localhost.
+func pprofHost(addr net.Addr) string {
+	tcp, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return addr.String()
+	}
+	host := "localhost"
+	if !tcp.IP.IsUnspecified() {
+		host = tcp.IP.String()
+	}
+	return net.JoinHostPort(host, strconv.Itoa(tcp.Port))
+}
@@ -0,0 +1,47 @@
+package runtime
+
+import "testing"
+
+// benchmarkScreen lays out a 120x40 screen of rows, each split into cells
+// that fill their bounds, using keyed widgets when cached is true.
+func benchmarkScreen(cached bool) *Screen {
+	screen := NewScreen(120, 40)
+	rows := NewFlex(Column)
+	for y := 0; y < 40; y++ {
+		row := NewFlex(Row)
+		for x := 0; x < 12; x++ {
+			cell := cachedWidget{ch: rune('a' + (x+y)%26)}
+			if cached {
+				row.AddFixed(&keyedWidget{cell}, 10)
+			} else {
+				row.AddFixed(&cell, 10)
+			}
+		}
+		rows.AddFixed(row, 1)
+	}
+	screen.SetRoot(rows)
+	return screen
+}
+
+// BenchmarkScreen_RenderUncached measures a static screen without cache keys.
+func BenchmarkScreen_RenderUncached(b *testing.B) {
+	screen := benchmarkScreen(false)
+	screen.Render()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		screen.Render()
+	}
+}
+
+// BenchmarkScreen_RenderCached measures a static screen whose widgets all
+// report unchanged cache keys.
+func BenchmarkScreen_RenderCached(b *testing.B) {
+	screen := benchmarkScreen(true)
+	screen.Render()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		screen.Render()
+	}
+}
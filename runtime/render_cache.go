@@ -0,0 +1,111 @@
+package runtime
+
+// Cacheable widgets report a key that changes whenever their rendered output
+// would. The screen skips rendering a layer while every widget in it is
+// Cacheable and no key has changed, so cells it drew earlier stay in the
+// buffer and produce no dirty cells.
+//
+// The key must cover everything Render reads, including focus state. Widgets
+// that animate on TickMsg should fold their tick count or frame into the key.
+type Cacheable interface {
+	CacheKey() uint64
+}
+
+// layerCacheEntry records how a layer looked when it was last rendered.
+type layerCacheEntry struct {
+	layer   *Layer
+	key     uint64
+	focused bool
+	dimmed  bool
+	ok      bool
+}
+
+// InvalidateRenderCache makes the next Render redraw every layer. Call it
+// after writing to the buffer outside of Render.
+func (s *Screen) InvalidateRenderCache() {
+	if s == nil {
+		return
+	}
+	s.renderCache = nil
+}
+
+// renderCachedLayer renders layer unless its cache entry from the previous
+// frame still matches and redraw is false. It appends the new entry to cache
+// and reports whether the layers above must be redrawn.
+func (s *Screen) renderCachedLayer(layer *Layer, ctx RenderContext, prev []layerCacheEntry, cache *[]layerCacheEntry, redraw bool) bool {
+	entry := layerCacheEntry{layer: layer, focused: ctx.Focused}
+	if layer != nil {
+		entry.dimmed = layer.DimLayer
+		entry.key, entry.ok = subtreeCacheKey(layer.Root)
+	}
+	pos := len(*cache)
+	if !redraw && entry.ok && pos < len(prev) && prev[pos] == entry {
+		*cache = append(*cache, entry)
+		return false
+	}
+	failed := s.renderErr
+	s.renderLayer(layer, ctx)
+	if s.renderErr != failed {
+		entry.ok = false
+	}
+	*cache = append(*cache, entry)
+	return true
+}
+
+// rectsCacheKey hashes the child bounds a container clips its children to.
+func rectsCacheKey(rects ...Rect) uint64 {
+	key := uint64(cacheKeyOffset)
+	for _, r := range rects {
+		key = mixCacheKey(key, uint64(uint32(r.X))<<32|uint64(uint32(r.Y)))
+		key = mixCacheKey(key, uint64(uint32(r.Width))<<32|uint64(uint32(r.Height)))
+	}
+	return key
+}
+
+// FNV-1a parameters for combining cache keys.
+const (
+	cacheKeyOffset = 14695981039346656037
+	cacheKeyPrime  = 1099511628211
+)
+
+func mixCacheKey(key, v uint64) uint64 {
+	return (key ^ v) * cacheKeyPrime
+}
+
+// subtreeCacheKey combines the keys and bounds of w and its descendants.
+// ok is false when any widget in the subtree is not Cacheable.
+func subtreeCacheKey(w Widget) (key uint64, ok bool) {
+	key = cacheKeyOffset
+	mix := func(v uint64) {
+		key = mixCacheKey(key, v)
+	}
+	var walk func(w Widget) bool
+	walk = func(w Widget) bool {
+		if w == nil {
+			mix(0)
+			return true
+		}
+		cacheable, ok := w.(Cacheable)
+		if !ok {
+			return false
+		}
+		mix(cacheable.CacheKey())
+		if bounds, ok := w.(BoundsProvider); ok {
+			mix(rectsCacheKey(bounds.Bounds()))
+		}
+		if children, ok := w.(ChildProvider); ok {
+			kids := children.ChildWidgets()
+			mix(uint64(len(kids)))
+			for _, child := range kids {
+				if !walk(child) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if w == nil || !walk(w) {
+		return 0, false
+	}
+	return key, true
+}
@@ -0,0 +1,129 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+)
+
+// cachedWidget fills its bounds with ch and counts Render calls.
+type cachedWidget struct {
+	bounds    Rect
+	ch        rune
+	key       uint64
+	cacheable bool
+	renders   int
+}
+
+func (w *cachedWidget) Measure(c Constraints) Size { return c.MaxSize() }
+func (w *cachedWidget) Layout(bounds Rect)         { w.bounds = bounds }
+func (w *cachedWidget) Bounds() Rect               { return w.bounds }
+func (w *cachedWidget) Render(ctx RenderContext) {
+	w.renders++
+	ctx.Buffer.Fill(w.bounds, w.ch, backend.DefaultStyle())
+}
+func (w *cachedWidget) HandleMessage(msg Message) HandleResult { return Unhandled() }
+
+// keyedWidget is a cachedWidget that implements Cacheable.
+type keyedWidget struct {
+	cachedWidget
+}
+
+func (w *keyedWidget) CacheKey() uint64 { return w.key }
+
+func TestScreen_RenderSkipsUnchangedCacheKey(t *testing.T) {
+	screen := NewScreen(4, 2)
+	widget := &keyedWidget{cachedWidget{ch: 'a'}}
+	screen.SetRoot(widget)
+
+	screen.Render()
+	screen.Buffer().ClearDirty()
+	screen.Render()
+	if widget.renders != 1 {
+		t.Fatalf("renders = %d, want 1 while the key is unchanged", widget.renders)
+	}
+	if screen.Buffer().IsDirty() {
+		t.Fatal("skipped render should leave no dirty cells")
+	}
+
+	widget.key++
+	widget.ch = 'b'
+	screen.Render()
+	if widget.renders != 2 {
+		t.Fatalf("renders = %d, want 2 after the key changed", widget.renders)
+	}
+	if got := screen.Buffer().Get(0, 0).Rune; got != 'b' {
+		t.Fatalf("cell = %q, want 'b'", got)
+	}
+}
+
+func TestScreen_RenderWithoutCacheKeyAlwaysRenders(t *testing.T) {
+	screen := NewScreen(4, 2)
+	widget := &cachedWidget{ch: 'a'}
+	screen.SetRoot(widget)
+
+	screen.Render()
+	screen.Render()
+	if widget.renders != 2 {
+		t.Fatalf("renders = %d, want 2", widget.renders)
+	}
+}
+
+func TestScreen_RenderCacheRedrawsAfterLayerChanges(t *testing.T) {
+	screen := NewScreen(4, 2)
+	root := &keyedWidget{cachedWidget{ch: 'a'}}
+	overlay := &keyedWidget{cachedWidget{ch: 'o'}}
+	screen.SetRoot(root)
+	screen.Render()
+
+	// The root redraws once because its layer is no longer focused.
+	screen.PushLayer(overlay, false)
+	screen.Render()
+	screen.Render()
+	if root.renders != 2 || overlay.renders != 1 {
+		t.Fatalf("renders root=%d overlay=%d, want 2 and 1", root.renders, overlay.renders)
+	}
+
+	// A lower layer redraw forces the layers above it to draw again.
+	root.key++
+	screen.Render()
+	if overlay.renders != 2 {
+		t.Fatalf("overlay renders = %d, want 2 after the root redrew", overlay.renders)
+	}
+
+	screen.PopLayer()
+	screen.Render()
+	if root.renders != 4 {
+		t.Fatalf("root renders = %d, want 4 after the overlay closed", root.renders)
+	}
+	if got := screen.Buffer().Get(0, 0).Rune; got != 'a' {
+		t.Fatalf("cell = %q, want the root's 'a'", got)
+	}
+
+	screen.Resize(6, 3)
+	screen.Render()
+	if root.renders != 5 {
+		t.Fatalf("root renders = %d, want 5 after a resize", root.renders)
+	}
+}
+
+func TestScreen_RenderCacheRedrawsAfterPassiveLayerRemoved(t *testing.T) {
+	screen := NewScreen(4, 2)
+	root := &keyedWidget{cachedWidget{ch: 'a'}}
+	toast := &keyedWidget{cachedWidget{ch: 't'}}
+	screen.SetRoot(root)
+	screen.Render()
+
+	screen.PushPassiveLayer(toast)
+	toast.Layout(Rect{Width: 2, Height: 1})
+	screen.Render()
+	if got := screen.Buffer().Get(0, 0).Rune; got != 't' {
+		t.Fatalf("cell = %q, want the toast's 't'", got)
+	}
+
+	screen.RemoveLayer(toast)
+	screen.Render()
+	if got := screen.Buffer().Get(0, 0).Rune; got != 'a' {
+		t.Fatalf("cell = %q, want the root redrawn over the removed toast", got)
+	}
+}
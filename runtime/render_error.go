@@ -0,0 +1,64 @@
+package runtime
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+)
+
+// RenderError captures a panic recovered during widget rendering.
+type RenderError struct {
+	Widget Widget
+	Bounds Rect
+	Value  any
+	Stack  []byte
+}
+
+// Error implements the error interface.
+func (e *RenderError) Error() string {
+	if e == nil {
+		return ""
+	}
+	return fmt.Sprintf("render panic: %v", e.Value)
+}
+
+// renderRecovered renders a widget, converting a panic into a RenderError.
+// The widget's bounds are filled with the error text on failure.
+func renderRecovered(w Widget, ctx RenderContext) (renderErr *RenderError) {
+	defer func() {
+		value := recover()
+		if value == nil {
+			return
+		}
+		bounds := ctx.Bounds
+		if bp, ok := w.(BoundsProvider); ok {
+			if b := bp.Bounds(); b.Width > 0 && b.Height > 0 {
+				bounds = b
+			}
+		}
+		renderErr = &RenderError{
+			Widget: w,
+			Bounds: bounds,
+			Value:  value,
+			Stack:  debug.Stack(),
+		}
+		drawRenderError(ctx.Buffer, bounds, renderErr.Error())
+	}()
+	w.Render(ctx)
+	return nil
+}
+
+func drawRenderError(buf *Buffer, bounds Rect, text string) {
+	if buf == nil || bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	style := backend.DefaultStyle().Foreground(backend.ColorRed)
+	buf.Fill(bounds, ' ', style)
+	runes := []rune(text)
+	for row := 0; row < bounds.Height && len(runes) > 0; row++ {
+		n := min(bounds.Width, len(runes))
+		buf.SetString(bounds.X, bounds.Y+row, string(runes[:n]), style)
+		runes = runes[n:]
+	}
+}
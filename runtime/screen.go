@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"sort"
+
 	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend"
 )
@@ -11,18 +13,27 @@ type Layer struct {
 	Root       Widget
 	FocusScope *FocusScope
 	Modal      bool // If true, blocks input to layers below
+	DimLayer   bool // If true, the layer renders dimmed
+	Passive    bool // If true, the layer floats on top but never takes focus
 }
 
 // Screen manages the widget tree, modal stack, and rendering.
 type Screen struct {
 	width, height     int
 	layers            []*Layer
+	active            int // layer raised by CycleLayer; 0 means the top layer
 	buffer            *Buffer
 	hitGrid           *HitGrid
 	hitGridModal      bool
 	services          Services
 	autoRegisterFocus bool
 	hitGridDirty      bool
+	recoverRender     bool
+	renderErr         *RenderError
+	focusObservers    map[int]func(prev, next Focusable)
+	nextFocusObserver int
+	renderCache       []layerCacheEntry
+	lastFocusMark     focusMark
 }
 
 // NewScreen creates a new screen with the given dimensions.
@@ -77,6 +88,7 @@ func (s *Screen) Resize(w, h int) {
 	s.width = w
 	s.height = h
 	s.buffer.Resize(w, h)
+	s.InvalidateRenderCache()
 	if s.hitGrid != nil {
 		s.hitGrid.Resize(w, h)
 	}
@@ -138,15 +150,21 @@ func (s *Screen) Root() Widget {
 }
 
 // PushLayer adds a new layer on top of the stack.
-// If modal is true, input won't pass to layers below.
-func (s *Screen) PushLayer(root Widget, modal bool) {
+// If modal is true, input won't pass to layers below. An optional
+// initialFocus widget is focused instead of the first focusable one.
+func (s *Screen) PushLayer(root Widget, modal bool, initialFocus ...Focusable) {
 	layer := &Layer{
 		Root:       root,
 		FocusScope: NewFocusScope(),
 		Modal:      modal,
 	}
 	s.configureFocusScope(layer.FocusScope)
+	if len(initialFocus) > 0 && initialFocus[0] != nil {
+		target := initialFocus[0]
+		layer.FocusScope.SetInitialFocus(func(w Focusable) bool { return w == target })
+	}
 	s.layers = append(s.layers, layer)
+	s.resetLayerCycle()
 	s.hitGridDirty = true
 
 	// Layout the new layer
@@ -160,32 +178,87 @@ func (s *Screen) PushLayer(root Widget, modal bool) {
 	}
 }
 
-// PopLayer removes the top layer from the stack.
+// PushPassiveLayer adds a layer that is drawn above every other layer but
+// never takes focus or keyboard input, such as floating notifications. Its
+// widgets still receive mouse events through hit testing.
+func (s *Screen) PushPassiveLayer(root Widget) {
+	layer := &Layer{
+		Root:       root,
+		FocusScope: NewFocusScope(),
+		Passive:    true,
+	}
+	s.configureFocusScope(layer.FocusScope)
+	s.layers = append(s.layers, layer)
+	s.hitGridDirty = true
+
+	if root != nil {
+		BindTree(root, s.services)
+		root.Layout(Rect{0, 0, s.width, s.height})
+		MountTree(root)
+	}
+}
+
+// PopLayer removes the topmost non-passive layer from the stack.
 // Returns false if only the base layer remains (can't pop it).
 func (s *Screen) PopLayer() bool {
-	if len(s.layers) <= 1 {
+	top := s.topIndex()
+	if top <= 0 {
+		return false
+	}
+	s.removeLayerAt(top)
+	return true
+}
+
+// RemoveLayer removes the layer whose root is root, wherever it sits in the
+// stack. The base layer can't be removed. Returns false if no layer matches.
+func (s *Screen) RemoveLayer(root Widget) bool {
+	if s == nil || root == nil {
 		return false
 	}
+	for i := len(s.layers) - 1; i > 0; i-- {
+		if s.layers[i].Root == root {
+			s.removeLayerAt(i)
+			return true
+		}
+	}
+	return false
+}
 
-	// Clear focus on the layer being removed
-	top := s.layers[len(s.layers)-1]
-	top.FocusScope.ClearFocus()
-	if top.Root != nil {
-		UnmountTree(top.Root)
-		UnbindTree(top.Root)
+func (s *Screen) removeLayerAt(idx int) {
+	layer := s.layers[idx]
+	if !layer.Passive {
+		// Clear focus on the layer being removed
+		layer.FocusScope.ClearFocus()
+	}
+	if layer.Root != nil {
+		UnmountTree(layer.Root)
+		UnbindTree(layer.Root)
 	}
 
-	s.layers = s.layers[:len(s.layers)-1]
+	s.layers = append(s.layers[:idx], s.layers[idx+1:]...)
 	s.hitGridDirty = true
-	return true
+	// The removed layer's cells are still in the buffer; redraw what it covered.
+	s.InvalidateRenderCache()
+	if layer.Passive {
+		if idx < s.active {
+			s.active--
+		}
+		return
+	}
+	s.resetLayerCycle()
+	if scope := s.FocusScope(); scope != nil && scope.Current() != nil {
+		s.notifyFocusObservers(nil, scope.Current())
+	}
 }
 
-// TopLayer returns the topmost layer.
+// TopLayer returns the topmost layer that can take focus. Passive layers
+// are skipped.
 func (s *Screen) TopLayer() *Layer {
-	if len(s.layers) == 0 {
+	idx := s.topIndex()
+	if idx < 0 {
 		return nil
 	}
-	return s.layers[len(s.layers)-1]
+	return s.layers[idx]
 }
 
 // LayerCount returns the number of layers.
@@ -193,15 +266,100 @@ func (s *Screen) LayerCount() int {
 	return len(s.layers)
 }
 
-// FocusScope returns the focus scope of the top layer.
+// ActiveLayer returns the layer that currently receives focus.
+// This is the top layer unless CycleLayer moved focus elsewhere.
+func (s *Screen) ActiveLayer() *Layer {
+	idx := s.activeIndex()
+	if idx < 0 {
+		return nil
+	}
+	return s.layers[idx]
+}
+
+// FocusScope returns the focus scope of the active layer.
 func (s *Screen) FocusScope() *FocusScope {
-	if top := s.TopLayer(); top != nil {
-		return top.FocusScope
+	if layer := s.ActiveLayer(); layer != nil {
+		return layer.FocusScope
 	}
 	return nil
 }
 
-// Render draws all layers to the buffer.
+// CycleLayer moves focus to the next (direction > 0) or previous
+// (direction < 0) overlay layer, wrapping around. The base layer stays
+// underneath and is not part of the cycle. The active layer is drawn on
+// top and every other layer is marked DimLayer. Returns false when fewer
+// than two overlays are open.
+func (s *Screen) CycleLayer(direction int) bool {
+	if s == nil || direction == 0 {
+		return false
+	}
+	var cycle []int
+	for i := 1; i < len(s.layers); i++ {
+		if !s.layers[i].Passive {
+			cycle = append(cycle, i)
+		}
+	}
+	overlays := len(cycle)
+	if overlays < 2 {
+		return false
+	}
+	step := 1
+	if direction < 0 {
+		step = -1
+	}
+	var prev Focusable
+	if scope := s.FocusScope(); scope != nil {
+		prev = scope.Current()
+	}
+	pos := 0
+	for i, idx := range cycle {
+		if idx == s.activeIndex() {
+			pos = i
+		}
+	}
+	pos = ((pos+step)%overlays + overlays) % overlays
+	s.active = cycle[pos]
+	for i, layer := range s.layers {
+		layer.DimLayer = i != s.active && !layer.Passive
+	}
+	s.hitGridDirty = true
+	if scope := s.FocusScope(); scope != nil {
+		s.announceFocus(scope.Current())
+		s.notifyFocusObservers(prev, scope.Current())
+	}
+	return true
+}
+
+// activeIndex returns the index of the active layer, or -1 without layers.
+func (s *Screen) activeIndex() int {
+	if s.active <= 0 || s.active >= len(s.layers) {
+		return s.topIndex()
+	}
+	return s.active
+}
+
+// topIndex returns the index of the topmost non-passive layer, or -1
+// without layers.
+func (s *Screen) topIndex() int {
+	for i := len(s.layers) - 1; i > 0; i-- {
+		if !s.layers[i].Passive {
+			return i
+		}
+	}
+	return min(len(s.layers)-1, 0)
+}
+
+// resetLayerCycle makes the top layer active again and clears dimming.
+func (s *Screen) resetLayerCycle() {
+	s.active = 0
+	for _, layer := range s.layers {
+		layer.DimLayer = false
+	}
+}
+
+// Render draws all layers to the buffer. Layers whose widgets all implement
+// Cacheable are skipped while their keys are unchanged and no layer below
+// them was redrawn.
 func (s *Screen) Render() {
 	ctx := RenderContext{
 		Buffer:  s.buffer,
@@ -209,23 +367,85 @@ func (s *Screen) Render() {
 		Bounds:  Rect{0, 0, s.width, s.height},
 	}
 
-	// Render layers from bottom to top
+	mark := s.focusMark()
+	redraw := mark != s.lastFocusMark
+	s.lastFocusMark = mark
+
+	// Render layers from bottom to top; the active layer is drawn after the
+	// others so it stays visible when CycleLayer raised a lower layer, and
+	// passive layers float above everything.
+	active := s.activeIndex()
+	prev := s.renderCache
+	cache := make([]layerCacheEntry, 0, len(s.layers))
 	for i, layer := range s.layers {
-		if layer.Root == nil {
+		if i == active || layer.Passive {
 			continue
 		}
+		redraw = s.renderCachedLayer(layer, ctx, prev, &cache, redraw)
+	}
+	if active >= 0 {
+		ctx.Focused = true
+		redraw = s.renderCachedLayer(s.layers[active], ctx, prev, &cache, redraw)
+		ctx.Focused = false
+	}
+	for _, layer := range s.layers {
+		if layer.Passive {
+			redraw = s.renderCachedLayer(layer, ctx, prev, &cache, redraw)
+		}
+	}
+	s.renderCache = cache
 
-		// Determine if this layer contains focus
-		isTopLayer := i == len(s.layers)-1
-		ctx.Focused = isTopLayer
+	s.drawFocusMark(mark)
+	if s.hitGridDirty {
+		s.buildHitGrid()
+	}
+}
 
+// renderLayer draws a single layer, dimming the buffer afterwards when the
+// layer is marked DimLayer.
+func (s *Screen) renderLayer(layer *Layer, ctx RenderContext) {
+	if layer == nil || layer.Root == nil {
+		return
+	}
+	if s.recoverRender {
+		if err := renderRecovered(layer.Root, ctx); err != nil {
+			s.renderErr = err
+		}
+	} else {
 		layer.Root.Render(ctx)
 	}
+	if layer.DimLayer {
+		s.dimBuffer()
+	}
+}
 
-	s.drawFocusIndicator()
-	if s.hitGridDirty {
-		s.buildHitGrid()
+// dimBuffer applies the dim attribute to every cell drawn so far.
+func (s *Screen) dimBuffer() {
+	w, h := s.buffer.Size()
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			cell := s.buffer.Get(x, y)
+			s.buffer.Set(x, y, cell.Rune, cell.Style.Dim(true))
+		}
+	}
+}
+
+// SetRecoverRender enables recovery from panics raised by layer roots during Render.
+func (s *Screen) SetRecoverRender(enabled bool) {
+	if s == nil {
+		return
+	}
+	s.recoverRender = enabled
+}
+
+// takeRenderError returns and clears the most recent recovered render error.
+func (s *Screen) takeRenderError() *RenderError {
+	if s == nil {
+		return nil
 	}
+	err := s.renderErr
+	s.renderErr = nil
+	return err
 }
 
 func (s *Screen) configureFocusScope(scope *FocusScope) {
@@ -234,9 +454,44 @@ func (s *Screen) configureFocusScope(scope *FocusScope) {
 	}
 	scope.SetOnChange(func(prev Focusable, next Focusable) {
 		s.announceFocus(next)
+		s.notifyFocusObservers(prev, next)
 	})
 }
 
+// OnFocusChange registers fn to run whenever focus moves in any layer,
+// including when CycleLayer switches layers. The returned function removes
+// the observer.
+func (s *Screen) OnFocusChange(fn func(prev, next Focusable)) (remove func()) {
+	if s == nil || fn == nil {
+		return func() {}
+	}
+	if s.focusObservers == nil {
+		s.focusObservers = make(map[int]func(prev, next Focusable))
+	}
+	id := s.nextFocusObserver
+	s.nextFocusObserver++
+	s.focusObservers[id] = fn
+	return func() {
+		delete(s.focusObservers, id)
+	}
+}
+
+func (s *Screen) notifyFocusObservers(prev, next Focusable) {
+	if len(s.focusObservers) == 0 {
+		return
+	}
+	ids := make([]int, 0, len(s.focusObservers))
+	for id := range s.focusObservers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if fn, ok := s.focusObservers[id]; ok {
+			fn(prev, next)
+		}
+	}
+}
+
 func (s *Screen) refreshLayerFocusables(layer *Layer) {
 	if s == nil || layer == nil || layer.FocusScope == nil {
 		return
@@ -245,6 +500,10 @@ func (s *Screen) refreshLayerFocusables(layer *Layer) {
 	if layer.Root != nil {
 		RegisterFocusables(layer.FocusScope, layer.Root)
 	}
+	// Registration focuses silently; tell observers about the new focus.
+	if current := layer.FocusScope.Current(); current != nil && layer == s.ActiveLayer() {
+		s.notifyFocusObservers(nil, current)
+	}
 }
 
 func (s *Screen) announceFocus(next Focusable) {
@@ -263,35 +522,71 @@ func (s *Screen) announceFocus(next Focusable) {
 }
 
 func (s *Screen) drawFocusIndicator() {
+	s.drawFocusMark(s.focusMark())
+}
+
+// focusMark is the focus indicator text and where it is drawn.
+type focusMark struct {
+	text  string
+	x, y  int
+	style backend.Style
+}
+
+// focusMark returns the indicator for the focused widget, with empty text
+// when none should be drawn.
+func (s *Screen) focusMark() focusMark {
 	if s == nil || s.buffer == nil {
-		return
+		return focusMark{}
 	}
-	style := s.services.FocusStyle()
-	if style == nil || style.Indicator == "" {
-		return
+	indicator, indicatorStyle := s.focusIndicator()
+	if indicator == "" {
+		return focusMark{}
 	}
 	scope := s.FocusScope()
 	if scope == nil {
-		return
+		return focusMark{}
 	}
 	focused := scope.Current()
 	if focused == nil {
-		return
+		return focusMark{}
 	}
 	boundsProvider, ok := focused.(BoundsProvider)
 	if !ok {
-		return
+		return focusMark{}
 	}
 	bounds := boundsProvider.Bounds()
 	if bounds.Width <= 0 || bounds.Height <= 0 {
-		return
+		return focusMark{}
 	}
-	indicator := style.Indicator
 	x := bounds.X - len(indicator)
 	if x < 0 {
 		x = bounds.X
 	}
-	s.buffer.SetString(x, bounds.Y, indicator, style.Style)
+	return focusMark{text: indicator, x: x, y: bounds.Y, style: indicatorStyle}
+}
+
+func (s *Screen) drawFocusMark(mark focusMark) {
+	if mark.text == "" || s.buffer == nil {
+		return
+	}
+	s.buffer.SetString(mark.x, mark.y, mark.text, mark.style)
+}
+
+// focusIndicator returns the focus marker and its style. High-contrast mode
+// always uses a bold ">" so focus never relies on colour alone.
+func (s *Screen) focusIndicator() (string, backend.Style) {
+	style := s.services.FocusStyle()
+	if s.services.HighContrast() {
+		indicatorStyle := backend.DefaultStyle()
+		if style != nil && style.HighContrast != (backend.Style{}) {
+			indicatorStyle = style.HighContrast
+		}
+		return ">", indicatorStyle.Bold(true)
+	}
+	if style == nil {
+		return "", backend.Style{}
+	}
+	return style.Indicator, style.Style
 }
 
 // HandleMessage dispatches a message to the appropriate layer.
@@ -315,10 +610,29 @@ func (s *Screen) HandleMessage(msg Message) HandleResult {
 		}
 	}
 
+	if _, ok := msg.(KeyMsg); ok {
+		if result := s.FocusScope().HandleMessage(msg); result.Handled {
+			return result
+		}
+	}
+
+	// A layer raised by CycleLayer owns input until the stack changes.
+	if active := s.activeIndex(); active >= 0 && active != s.topIndex() {
+		layer := s.layers[active]
+		if layer.Root == nil {
+			return Unhandled()
+		}
+		result := layer.Root.HandleMessage(msg)
+		for _, cmd := range result.Commands {
+			s.handleCommand(cmd)
+		}
+		return result
+	}
+
 	// Process from top to bottom
 	for i := len(s.layers) - 1; i >= 0; i-- {
 		layer := s.layers[i]
-		if layer.Root == nil {
+		if layer.Root == nil || layer.Passive {
 			continue
 		}
 
@@ -353,14 +667,29 @@ func (s *Screen) handleCommand(cmd Command) {
 		if scope := s.FocusScope(); scope != nil {
 			scope.FocusPrev()
 		}
+	case FocusWidget:
+		if scope := s.FocusScope(); scope != nil && c.Widget != nil {
+			scope.SetFocus(c.Widget)
+		}
 	case PopOverlay:
 		s.PopLayer()
 	case PushOverlay:
-		s.PushLayer(c.Widget, c.Modal)
+		s.PushLayer(c.Widget, c.Modal, c.InitialFocus)
 	}
 	// Other commands bubble up to App
 }
 
+// WidgetAt returns the widget that receives mouse events at (x, y), or nil.
+func (s *Screen) WidgetAt(x, y int) Widget {
+	if s == nil || s.hitGrid == nil {
+		return nil
+	}
+	if s.hitGridDirty {
+		s.buildHitGrid()
+	}
+	return s.hitGrid.WidgetAt(x, y)
+}
+
 func (s *Screen) buildHitGrid() {
 	if s.hitGrid == nil {
 		s.hitGrid = NewHitGrid(s.width, s.height)
@@ -374,18 +703,28 @@ func (s *Screen) buildHitGrid() {
 		return
 	}
 
-	start := 0
-	if top := s.layers[len(s.layers)-1]; top != nil && top.Modal {
-		start = len(s.layers) - 1
+	start, end := 0, len(s.layers)
+	top := s.topIndex()
+	if active := s.activeIndex(); active != top {
+		start, end = active, active+1
+		s.hitGridModal = true
+	} else if layer := s.layers[top]; layer != nil && layer.Modal {
+		start = top
 		s.hitGridModal = true
 	}
-	for i := start; i < len(s.layers); i++ {
+	for i := start; i < end; i++ {
 		layer := s.layers[i]
-		if layer == nil || layer.Root == nil {
+		if layer == nil || layer.Root == nil || layer.Passive {
 			continue
 		}
 		s.addHitWidgets(layer.Root)
 	}
+	// Passive layers are drawn on top, so they win hit testing too.
+	for _, layer := range s.layers {
+		if layer != nil && layer.Root != nil && layer.Passive {
+			s.addHitWidgets(layer.Root)
+		}
+	}
 }
 
 func (s *Screen) addHitWidgets(widget Widget) {
@@ -420,9 +759,16 @@ type RenderContext struct {
 }
 
 // Sub creates a new context for a child widget with adjusted bounds.
+// The child's buffer is clipped to bounds, so writes outside it are dropped.
 func (ctx RenderContext) Sub(bounds Rect) RenderContext {
+	return ctx.WithBounds(bounds)
+}
+
+// WithBounds returns a context whose buffer is clipped to bounds.
+// Coordinates stay absolute; only the writable region shrinks.
+func (ctx RenderContext) WithBounds(bounds Rect) RenderContext {
 	return RenderContext{
-		Buffer:  ctx.Buffer,
+		Buffer:  ctx.Buffer.Clip(bounds),
 		Focused: ctx.Focused,
 		Bounds:  bounds,
 	}
@@ -442,6 +442,24 @@ func TestScreen_FocusNextCommand(t *testing.T) {
 	}
 }
 
+func TestScreen_FocusWidgetCommand(t *testing.T) {
+	s := NewScreen(80, 24)
+
+	focusable1 := &mockWidget{}
+	focusable2 := &mockWidget{}
+	w := &mockWidget{}
+	w.commands = []Command{FocusWidget{Widget: focusable2}}
+	s.SetRoot(w)
+	s.FocusScope().Register(focusable1)
+	s.FocusScope().Register(focusable2)
+
+	s.HandleMessage(KeyMsg{Key: terminal.KeyRune, Rune: 'x'})
+
+	if !focusable2.focused || focusable1.focused {
+		t.Error("FocusWidget command should focus focusable2")
+	}
+}
+
 func TestScreen_FocusPrevCommand(t *testing.T) {
 	s := NewScreen(80, 24)
 
@@ -485,14 +503,35 @@ func TestRenderContext_Sub(t *testing.T) {
 	if subCtx.Bounds.Width != 20 || subCtx.Bounds.Height != 20 {
 		t.Error("Sub context should have reduced size")
 	}
-	if subCtx.Buffer != buf {
-		t.Error("Sub context should share buffer")
+	subCtx.Buffer.Set(12, 12, 'x', backend.DefaultStyle())
+	if buf.Get(12, 12).Rune != 'x' {
+		t.Error("Sub context should write through to the parent buffer")
 	}
 	if !subCtx.Focused {
 		t.Error("Sub context should inherit Focused")
 	}
 }
 
+func TestRenderContext_SubClipsWrites(t *testing.T) {
+	buf := NewBuffer(10, 5)
+	buf.Fill(Rect{0, 0, 10, 5}, '.', backend.DefaultStyle())
+	ctx := RenderContext{Buffer: buf, Bounds: Rect{0, 0, 10, 5}}
+
+	sub := ctx.Sub(Rect{2, 1, 4, 2})
+	sub.Buffer.Fill(Rect{0, 0, 10, 5}, '#', backend.DefaultStyle())
+	sub.Buffer.SetString(0, 2, "abcdefgh", backend.DefaultStyle())
+	sub.Buffer.Set(8, 4, '!', backend.DefaultStyle())
+
+	// Nested contexts intersect with the parent clip.
+	inner := sub.WithBounds(Rect{4, 0, 6, 5})
+	inner.Buffer.Fill(Rect{0, 0, 10, 5}, '+', backend.DefaultStyle())
+
+	want := "..........\n..##++....\n..cd++....\n..........\n.........."
+	if got := buf.SnapshotText(); got != want {
+		t.Fatalf("buffer =\n%s\nwant\n%s", got, want)
+	}
+}
+
 func TestRenderContext_SubBuffer(t *testing.T) {
 	buf := NewBuffer(100, 50)
 	ctx := RenderContext{
@@ -507,3 +546,186 @@ func TestRenderContext_SubBuffer(t *testing.T) {
 		t.Errorf("SubBuffer size = %dx%d, want 20x20", w, h)
 	}
 }
+
+func TestScreen_DirectionalFocusBeforeWidget(t *testing.T) {
+	s := NewScreen(80, 24)
+	s.SetAutoRegisterFocus(true)
+	top := newBoundedFocusable("top", 0, 0, 10, 1)
+	bottom := newBoundedFocusable("bottom", 0, 2, 10, 1)
+	s.SetRoot(VBox(Fixed(top), Fixed(bottom)))
+	s.FocusScope().SetDirectional(true)
+
+	if result := s.HandleMessage(KeyMsg{Key: terminal.KeyDown}); !result.Handled {
+		t.Fatal("expected Down to be handled by the focus scope")
+	}
+	if !bottom.focused || top.focused {
+		t.Errorf("expected focus to move down, top=%v bottom=%v", top.focused, bottom.focused)
+	}
+}
+
+func TestScreen_PushLayerInitialFocus(t *testing.T) {
+	s := NewScreen(80, 24)
+	s.SetAutoRegisterFocus(true)
+	s.SetRoot(newFocusable("base"))
+
+	first := newFocusable("first")
+	second := newFocusable("second")
+	ok := newFocusable("ok")
+	s.PushLayer(VBox(Fixed(first), Fixed(second), Fixed(ok)), true, ok)
+
+	if s.FocusScope().Current() != ok {
+		t.Fatalf("focused = %v, want ok", s.FocusScope().Current())
+	}
+	if first.focused || second.focused || !ok.focused {
+		t.Fatalf("focus flags = %v %v %v, want only ok", first.focused, second.focused, ok.focused)
+	}
+}
+
+func TestFocusScope_SetInitialFocusFallsBack(t *testing.T) {
+	scope := NewFocusScope()
+	scope.SetInitialFocus(func(w Focusable) bool {
+		return w.(*focusableWidget).id == "third"
+	})
+	first := newFocusable("first")
+	second := newFocusable("second")
+	third := newFocusable("third")
+	RegisterFocusables(scope, VBox(Fixed(first), Fixed(second), Fixed(third)))
+	if scope.Current() != third || first.focused {
+		t.Fatalf("focused = %v, want third", scope.Current())
+	}
+
+	scope.Reset()
+	RegisterFocusables(scope, VBox(Fixed(first), Fixed(second)))
+	if scope.Current() != first {
+		t.Fatalf("focused = %v, want first when nothing matches", scope.Current())
+	}
+}
+
+func TestScreen_CycleLayer(t *testing.T) {
+	s := NewScreen(80, 24)
+	s.SetAutoRegisterFocus(true)
+	s.SetRoot(newFocusable("base"))
+	first := newFocusable("first")
+	second := newFocusable("second")
+	s.PushLayer(first, false)
+	s.PushLayer(second, false)
+
+	if s.FocusScope().Current() != second {
+		t.Fatalf("focused = %v, want second", s.FocusScope().Current())
+	}
+	if !s.CycleLayer(1) {
+		t.Fatal("expected CycleLayer to switch layers")
+	}
+	if s.ActiveLayer().Root != first || s.FocusScope().Current() != first {
+		t.Fatalf("active = %v, want first", s.ActiveLayer().Root)
+	}
+	s.CycleLayer(1)
+	if s.ActiveLayer() != s.TopLayer() || s.FocusScope().Current() != second {
+		t.Fatalf("active = %v, want second after a full cycle", s.ActiveLayer().Root)
+	}
+	s.CycleLayer(-1)
+	if s.ActiveLayer().Root != first {
+		t.Fatalf("active = %v, want first after cycling back", s.ActiveLayer().Root)
+	}
+
+	s.PopLayer()
+	if s.ActiveLayer().Root != first || s.ActiveLayer().DimLayer || s.layers[0].DimLayer {
+		t.Fatal("expected PopLayer to reset the cycle and clear dimming")
+	}
+}
+
+func TestScreen_CycleLayerSingleLayer(t *testing.T) {
+	s := NewScreen(80, 24)
+	s.SetRoot(newFocusable("base"))
+	if s.CycleLayer(1) {
+		t.Fatal("expected CycleLayer to be a no-op with one layer")
+	}
+	if s.ActiveLayer() != s.TopLayer() || s.TopLayer().DimLayer {
+		t.Fatal("expected the single layer to stay active and undimmed")
+	}
+}
+
+func TestScreen_CycleLayerDimsInactiveLayers(t *testing.T) {
+	s := NewScreen(10, 1)
+	base := &fillingWidget{char: 'B'}
+	first := &fillingWidget{char: 'A'}
+	second := &fillingWidget{char: 'C'}
+	s.SetRoot(base)
+	s.PushLayer(first, false)
+	s.PushLayer(second, false)
+	base.bounds = Rect{0, 0, 2, 1}
+	first.bounds = Rect{2, 0, 4, 1}
+	second.bounds = Rect{4, 0, 4, 1}
+
+	s.CycleLayer(1)
+	s.Render()
+
+	buf := s.Buffer()
+	dim := func(x int) bool { return buf.Get(x, 0).Style.Attributes()&backend.AttrDim != 0 }
+	if got := buf.Get(4, 0).Rune; got != 'A' {
+		t.Fatalf("overlap cell = %q, want the active layer drawn on top", got)
+	}
+	if dim(2) || dim(4) {
+		t.Error("expected the active layer to render undimmed")
+	}
+	if !dim(0) || !dim(7) {
+		t.Error("expected inactive layers to render dimmed")
+	}
+}
+
+func TestScreen_PassiveLayerKeepsFocus(t *testing.T) {
+	s := NewScreen(10, 1)
+	s.SetAutoRegisterFocus(true)
+	base := newFocusable("base")
+	dialog := newFocusable("dialog")
+	s.SetRoot(base)
+	floating := &fillingWidget{char: 'T'}
+	s.PushPassiveLayer(floating)
+
+	if s.FocusScope().Current() != base || s.TopLayer().Root != base {
+		t.Fatalf("focused = %v, want the base layer to stay active", s.FocusScope().Current())
+	}
+	s.PushLayer(dialog, true)
+	if s.FocusScope().Current() != dialog {
+		t.Fatalf("focused = %v, want the dialog", s.FocusScope().Current())
+	}
+	s.Render()
+	if got := s.Buffer().Get(0, 0).Rune; got != 'T' {
+		t.Fatalf("cell = %q, want the passive layer drawn on top", got)
+	}
+
+	if !s.PopLayer() || s.LayerCount() != 2 || s.FocusScope().Current() != base {
+		t.Fatal("expected PopLayer to remove the dialog and leave the passive layer")
+	}
+	if s.PopLayer() {
+		t.Fatal("expected PopLayer to refuse popping past passive layers")
+	}
+	if !s.RemoveLayer(floating) || s.LayerCount() != 1 {
+		t.Fatal("expected RemoveLayer to drop the passive layer")
+	}
+	if s.RemoveLayer(floating) || s.RemoveLayer(base) {
+		t.Fatal("expected RemoveLayer to reject missing and base layers")
+	}
+}
+
+func TestScreen_PassiveLayerHitTesting(t *testing.T) {
+	s := NewScreen(10, 5)
+	root := &hitWidget{}
+	s.SetRoot(root)
+	floating := &hitWidget{}
+	s.PushPassiveLayer(floating)
+	floating.bounds = Rect{6, 0, 4, 1}
+	s.PushLayer(&hitWidget{}, true)
+	s.RemoveLayer(s.TopLayer().Root)
+
+	if got := s.WidgetAt(7, 0); got != floating {
+		t.Fatalf("WidgetAt = %v, want the passive widget", got)
+	}
+	if got := s.WidgetAt(2, 2); got != root {
+		t.Fatalf("WidgetAt = %v, want the base widget outside the passive one", got)
+	}
+	s.HandleMessage(KeyMsg{Key: terminal.KeyEnter})
+	if floating.handleCalls != 0 {
+		t.Fatal("expected key messages to skip passive layers")
+	}
+}
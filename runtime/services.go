@@ -6,6 +6,7 @@ import (
 	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/clipboard"
 	"github.com/odvcencio/fluffy-ui/state"
+	"github.com/odvcencio/fluffy-ui/terminal"
 )
 
 // Services exposes app-level scheduling and messaging helpers.
@@ -30,6 +31,18 @@ func (s Services) Announcer() accessibility.Announcer {
 	return s.app.announcer
 }
 
+// RegisterLiveRegion attaches the app announcer to region so its
+// announcements are spoken. The returned function detaches it.
+func (s Services) RegisterLiveRegion(region *accessibility.LiveRegion) (remove func()) {
+	if s.app == nil || region == nil {
+		return func() {}
+	}
+	region.SetAnnouncer(s.app.announcer)
+	return func() {
+		region.SetAnnouncer(nil)
+	}
+}
+
 // FocusStyle returns the global focus style.
 func (s Services) FocusStyle() *accessibility.FocusStyle {
 	if s.app == nil {
@@ -38,6 +51,23 @@ func (s Services) FocusStyle() *accessibility.FocusStyle {
 	return s.app.focusStyle
 }
 
+// HighContrast reports whether high-contrast mode is on.
+func (s Services) HighContrast() bool {
+	if s.app == nil {
+		return false
+	}
+	return s.app.highContrast.Load()
+}
+
+// Capabilities returns the terminal features reported for the app.
+// Without an app it reports no optional features.
+func (s Services) Capabilities() terminal.Capabilities {
+	if s.app == nil {
+		return terminal.Capabilities{}
+	}
+	return s.app.capabilities
+}
+
 // Clipboard returns the app clipboard.
 func (s Services) Clipboard() clipboard.Clipboard {
 	if s.app == nil {
@@ -46,6 +76,21 @@ func (s Services) Clipboard() clipboard.Clipboard {
 	return s.app.clipboard
 }
 
+// ImageClipboard returns the app image clipboard.
+// Falls back to the text clipboard when it also supports images.
+func (s Services) ImageClipboard() clipboard.ImageClipboard {
+	if s.app == nil {
+		return nil
+	}
+	if s.app.imageClipboard != nil {
+		return s.app.imageClipboard
+	}
+	if images, ok := s.app.clipboard.(clipboard.ImageClipboard); ok {
+		return images
+	}
+	return nil
+}
+
 // Scheduler returns the app state scheduler.
 func (s Services) Scheduler() state.Scheduler {
 	if s.app == nil {
@@ -70,6 +115,41 @@ func (s Services) Invalidate() {
 	s.app.Invalidate()
 }
 
+// PostError reports err to the app's ErrorHandler via an ErrorMsg.
+func (s Services) PostError(err error, source string) bool {
+	if s.app == nil || err == nil {
+		return false
+	}
+	return s.app.tryPost(ErrorMsg{Err: err, Source: source})
+}
+
+// OnFocusChange registers fn to run on the event loop whenever focus moves.
+// The returned function removes the observer.
+func (s Services) OnFocusChange(fn func(prev, next Focusable)) (remove func()) {
+	if s.app == nil || s.app.screen == nil {
+		return func() {}
+	}
+	return s.app.screen.OnFocusChange(fn)
+}
+
+// ObserveMessages registers fn to see every message the event loop
+// receives. The returned function removes the observer.
+func (s Services) ObserveMessages(fn func(Message)) (remove func()) {
+	if s.app == nil {
+		return func() {}
+	}
+	return s.app.ObserveMessages(fn)
+}
+
+// Screen returns the app screen, or nil before the app runs. Use it only
+// on the event loop.
+func (s Services) Screen() *Screen {
+	if s.app == nil {
+		return nil
+	}
+	return s.app.screen
+}
+
 // Post sends a message into the app loop.
 func (s Services) Post(msg Message) bool {
 	if s.app == nil {
@@ -101,3 +181,11 @@ func (s Services) Every(interval time.Duration, fn func(time.Time) Message) {
 	}
 	s.app.Every(interval, fn)
 }
+
+// EveryAfter schedules a recurring message whose first tick fires after delay.
+func (s Services) EveryAfter(delay, interval time.Duration, fn func(time.Time) Message) {
+	if s.app == nil {
+		return
+	}
+	s.app.EveryAfter(delay, interval, fn)
+}
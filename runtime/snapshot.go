@@ -1,6 +1,10 @@
 package runtime
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+)
 
 // SnapshotText returns a snapshot of the current screen buffer as plain text.
 // The snapshot is taken under the render lock to avoid tearing.
@@ -43,6 +47,9 @@ func (b *Buffer) SnapshotText() string {
 		row := b.cells[rowStart : rowStart+w]
 		for _, cell := range row {
 			r := cell.Rune
+			if r == backend.CellContinuation {
+				continue
+			}
 			if r == 0 {
 				r = ' '
 			}
@@ -0,0 +1,35 @@
+package runtime
+
+// ThemeAdapter is implemented by widgets that restyle themselves when the
+// active theme changes, such as widgets styled by theme role.
+type ThemeAdapter interface {
+	ApplyTheme()
+}
+
+// applyTheme calls ApplyTheme on every adapter in the screen and redraws
+// all layers, since cached widgets may have drawn with the old theme.
+func (s *Screen) applyTheme() {
+	if s == nil {
+		return
+	}
+	for _, layer := range s.layers {
+		if layer != nil {
+			applyThemeWidget(layer.Root)
+		}
+	}
+	s.InvalidateRenderCache()
+}
+
+func applyThemeWidget(w Widget) {
+	if w == nil {
+		return
+	}
+	if adapter, ok := w.(ThemeAdapter); ok {
+		adapter.ApplyTheme()
+	}
+	if children, ok := w.(ChildProvider); ok {
+		for _, child := range children.ChildWidgets() {
+			applyThemeWidget(child)
+		}
+	}
+}
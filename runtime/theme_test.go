@@ -0,0 +1,36 @@
+package runtime
+
+import "testing"
+
+type themeWidget struct {
+	bindTestWidget
+	applied int
+}
+
+func (t *themeWidget) ApplyTheme() {
+	t.applied++
+}
+
+func TestApp_ThemeChangedReachesAdapters(t *testing.T) {
+	app := NewApp(AppConfig{})
+	app.screen = NewScreen(10, 5)
+	child := &themeWidget{}
+	root := &bindTestWidget{children: []Widget{child}}
+	overlay := &themeWidget{}
+	app.screen.SetRoot(root)
+	app.screen.PushLayer(overlay, false)
+	app.screen.Render()
+	if app.screen.renderCache == nil {
+		t.Fatal("expected Render to fill the render cache")
+	}
+
+	if !DefaultUpdate(app, ThemeChangedMsg{}) {
+		t.Fatal("expected ThemeChangedMsg to be handled")
+	}
+	if child.applied != 1 || overlay.applied != 1 {
+		t.Fatalf("applied child=%d overlay=%d, want 1 each", child.applied, overlay.applied)
+	}
+	if app.screen.renderCache != nil {
+		t.Fatal("expected a theme change to invalidate the render cache")
+	}
+}
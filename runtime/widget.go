@@ -202,6 +202,12 @@ type Focusable interface {
 	IsFocused() bool
 }
 
+// DescribedWidget is implemented by widgets that carry a short tooltip.
+// TooltipRegion widgets show the tooltip of the focused widget.
+type DescribedWidget interface {
+	Tooltip() string
+}
+
 // HandleResult is returned from HandleMessage.
 type HandleResult struct {
 	Handled  bool      // Was the message consumed?
@@ -0,0 +1,41 @@
+package scroll
+
+// EasingFunc maps normalised time t in [0,1] to a position fraction.
+type EasingFunc func(t float64) float64
+
+// EaseLinear moves at a constant rate.
+func EaseLinear(t float64) float64 {
+	return clampUnit(t)
+}
+
+// EaseInCubic starts slowly and accelerates.
+func EaseInCubic(t float64) float64 {
+	t = clampUnit(t)
+	return t * t * t
+}
+
+// EaseOutCubic starts quickly and decelerates.
+func EaseOutCubic(t float64) float64 {
+	t = clampUnit(t) - 1
+	return t*t*t + 1
+}
+
+// EaseInOutCubic accelerates through the first half and decelerates through the second.
+func EaseInOutCubic(t float64) float64 {
+	t = clampUnit(t)
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	t = 2*t - 2
+	return t*t*t/2 + 1
+}
+
+func clampUnit(t float64) float64 {
+	if t < 0 {
+		return 0
+	}
+	if t > 1 {
+		return 1
+	}
+	return t
+}
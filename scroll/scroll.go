@@ -183,6 +183,30 @@ func (v *Viewport) VisibleRect() runtime.Rect {
 	}
 }
 
+// EnsureVisible scrolls the minimum amount needed to bring rect, in content
+// coordinates, fully into view. On an axis where rect is larger than the
+// view, its top or left edge is aligned with the view's.
+func (v *Viewport) EnsureVisible(rect runtime.Rect) {
+	if v == nil {
+		return
+	}
+	x := ensureAxis(v.offset.X, v.viewSize.Width, rect.X, rect.Width)
+	y := ensureAxis(v.offset.Y, v.viewSize.Height, rect.Y, rect.Height)
+	v.SetOffset(x, y)
+}
+
+// ensureAxis returns the offset along one axis that shows [start, start+size).
+func ensureAxis(offset, view, start, size int) int {
+	switch {
+	case size >= view || start < offset:
+		return start
+	case start+size > offset+view:
+		return start + size - view
+	default:
+		return offset
+	}
+}
+
 func clampOffset(offset image.Point, content runtime.Size, view runtime.Size) image.Point {
 	maxX := content.Width - view.Width
 	maxY := content.Height - view.Height
@@ -226,6 +250,19 @@ type VirtualIndexer interface {
 	OffsetForIndex(index int) int
 }
 
+// VirtualSelection optionally reports the selected item so the view can keep
+// it visible after input. A negative index means no selection.
+type VirtualSelection interface {
+	SelectedItemIndex() int
+}
+
+// VirtualStickyHeaders optionally pins section headers to the top of the
+// view. StickyHeader returns the header item for the section containing
+// index, or -1.
+type VirtualStickyHeaders interface {
+	StickyHeader(index int) int
+}
+
 // Scrollbar configures scrollbar rendering.
 type Scrollbar struct {
 	Orientation  Orientation
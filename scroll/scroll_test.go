@@ -38,6 +38,40 @@ func TestViewportMaxOffsetAndVisibleRect(t *testing.T) {
 	}
 }
 
+func TestViewportEnsureVisible(t *testing.T) {
+	newViewport := func() *Viewport {
+		vp := NewViewport(nil)
+		vp.SetContentSize(runtime.Size{Width: 100, Height: 100})
+		vp.SetViewSize(runtime.Size{Width: 10, Height: 10})
+		vp.SetOffset(20, 20)
+		return vp
+	}
+
+	vp := newViewport()
+	vp.EnsureVisible(runtime.Rect{X: 22, Y: 22, Width: 5, Height: 5})
+	if off := vp.Offset(); off != (image.Point{X: 20, Y: 20}) {
+		t.Fatalf("in view offset = %v, want (20,20)", off)
+	}
+
+	vp = newViewport()
+	vp.EnsureVisible(runtime.Rect{X: 22, Y: 28, Width: 2, Height: 5})
+	if off := vp.Offset(); off != (image.Point{X: 20, Y: 23}) {
+		t.Fatalf("below offset = %v, want (20,23)", off)
+	}
+
+	vp = newViewport()
+	vp.EnsureVisible(runtime.Rect{X: 35, Y: 22, Width: 3, Height: 1})
+	if off := vp.Offset(); off != (image.Point{X: 28, Y: 20}) {
+		t.Fatalf("right offset = %v, want (28,20)", off)
+	}
+
+	vp = newViewport()
+	vp.EnsureVisible(runtime.Rect{X: 40, Y: 50, Width: 30, Height: 20})
+	if off := vp.Offset(); off != (image.Point{X: 40, Y: 50}) {
+		t.Fatalf("large rect offset = %v, want (40,50)", off)
+	}
+}
+
 func TestFixedHeightIndex(t *testing.T) {
 	index := FixedHeightIndex{
 		Height: 2,
@@ -64,3 +98,28 @@ func TestFixedHeightIndex(t *testing.T) {
 		t.Fatalf("offset for index 10 = %d, want 8", got)
 	}
 }
+
+func TestEasingFuncs(t *testing.T) {
+	if got := EaseLinear(0.5); got != 0.5 {
+		t.Fatalf("EaseLinear(0.5) = %v, want 0.5", got)
+	}
+	if got := EaseOutCubic(0.5); got <= 0.5 {
+		t.Fatalf("EaseOutCubic(0.5) = %v, want > 0.5", got)
+	}
+	if got := EaseInCubic(0.5); got >= 0.5 {
+		t.Fatalf("EaseInCubic(0.5) = %v, want < 0.5", got)
+	}
+	for name, fn := range map[string]EasingFunc{
+		"linear": EaseLinear,
+		"in":     EaseInCubic,
+		"out":    EaseOutCubic,
+		"in-out": EaseInOutCubic,
+	} {
+		if got := fn(0); got != 0 {
+			t.Fatalf("%s(0) = %v, want 0", name, got)
+		}
+		if got := fn(1); got != 1 {
+			t.Fatalf("%s(1) = %v, want 1", name, got)
+		}
+	}
+}
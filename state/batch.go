@@ -0,0 +1,61 @@
+package state
+
+import "sync"
+
+// batch collects notifications while Batch runs. It is global, so a batch
+// also defers notifications from signals set on other goroutines.
+var batch struct {
+	mu      sync.Mutex
+	depth   int
+	pending []*subscriber
+	seen    map[*subscriber]struct{}
+}
+
+// Batch runs fn with signal notifications deferred, then notifies each
+// subscriber of the changed signals once. Nested batches join the outermost
+// one, which does the final flush.
+func Batch(fn func()) {
+	if fn == nil {
+		return
+	}
+	batch.mu.Lock()
+	batch.depth++
+	batch.mu.Unlock()
+	defer endBatch()
+	fn()
+}
+
+func endBatch() {
+	batch.mu.Lock()
+	batch.depth--
+	if batch.depth > 0 {
+		batch.mu.Unlock()
+		return
+	}
+	pending := batch.pending
+	batch.pending = nil
+	batch.seen = nil
+	batch.mu.Unlock()
+	runSubscribers(pending)
+}
+
+// deferNotify queues subs for the active batch, skipping subscribers that
+// are already queued, and reports whether a batch was active.
+func deferNotify(subs []*subscriber) bool {
+	batch.mu.Lock()
+	defer batch.mu.Unlock()
+	if batch.depth == 0 {
+		return false
+	}
+	if batch.seen == nil {
+		batch.seen = make(map[*subscriber]struct{})
+	}
+	for _, sub := range subs {
+		if _, ok := batch.seen[sub]; ok {
+			continue
+		}
+		batch.seen[sub] = struct{}{}
+		batch.pending = append(batch.pending, sub)
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package state
+
+import "testing"
+
+func TestBatch_NestedNotifiesOncePerSubscriber(t *testing.T) {
+	cash := NewSignal(0)
+	debt := NewSignal(0)
+	cashCalls, debtCalls := 0, 0
+	cash.Subscribe(func() { cashCalls++ })
+	debt.Subscribe(func() { debtCalls++ })
+
+	Batch(func() {
+		cash.Set(1)
+		Batch(func() {
+			cash.Set(2)
+			debt.Set(5)
+		})
+		if cashCalls != 0 || debtCalls != 0 {
+			t.Fatalf("inner batch flushed early: cash=%d debt=%d", cashCalls, debtCalls)
+		}
+		if cash.Get() != 2 {
+			t.Fatalf("cash = %d inside batch, want 2", cash.Get())
+		}
+		cash.Set(3)
+	})
+
+	if cashCalls != 1 || debtCalls != 1 {
+		t.Fatalf("calls cash=%d debt=%d, want 1 each", cashCalls, debtCalls)
+	}
+	cash.Set(4)
+	if cashCalls != 2 {
+		t.Fatalf("expected immediate notify after batch, got %d", cashCalls)
+	}
+}
+
+func TestBatch_SkipsUnsubscribed(t *testing.T) {
+	sig := NewSignal(0)
+	calls := 0
+	unsub := sig.Subscribe(func() { calls++ })
+
+	Batch(func() {
+		sig.Set(1)
+		unsub()
+	})
+	if calls != 0 {
+		t.Fatalf("unsubscribed listener called %d times", calls)
+	}
+}
+
+func TestBatch_ComputedSeesFinalValues(t *testing.T) {
+	a := NewSignal(1)
+	b := NewSignal(2)
+	sum := NewComputed(func() int {
+		return a.Get() + b.Get()
+	}, a, b)
+
+	Batch(func() {
+		a.Set(10)
+		b.Set(20)
+		if sum.Get() != 3 {
+			t.Fatalf("sum recomputed inside batch: %d", sum.Get())
+		}
+	})
+	if sum.Get() != 30 {
+		t.Fatalf("sum = %d, want 30", sum.Get())
+	}
+}
@@ -41,6 +41,16 @@ func NewComputedWithScheduler[T any](scheduler Scheduler, compute func() T, deps
 	return c
 }
 
+// Map derives a computed value by applying fn to a readable source.
+func Map[T, U any](source Readable[T], fn func(T) U) *Computed[U] {
+	if source == nil || fn == nil {
+		return NewComputed[U](nil)
+	}
+	return NewComputed(func() U {
+		return fn(source.Get())
+	}, source)
+}
+
 // SetEqualFunc configures the equality check used to suppress redundant updates.
 func (c *Computed[T]) SetEqualFunc(fn EqualFunc[T]) {
 	if c == nil {
@@ -97,3 +97,17 @@ func TestComputed_Scheduler(t *testing.T) {
 		t.Fatalf("expected computed to update after flush, got %d", got)
 	}
 }
+
+func TestMap_ReadonlySource(t *testing.T) {
+	sig := NewSignal(2)
+	doubled := Map(sig.AsReadonly(), func(v int) int { return v * 2 })
+	defer doubled.Stop()
+
+	if doubled.Get() != 4 {
+		t.Fatalf("expected 4, got %d", doubled.Get())
+	}
+	sig.Set(5)
+	if doubled.Get() != 10 {
+		t.Fatalf("expected 10, got %d", doubled.Get())
+	}
+}
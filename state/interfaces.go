@@ -13,3 +13,26 @@ type Writable[T any] interface {
 	Set(value T) bool
 	Update(fn func(T) T) bool
 }
+
+// readonly hides the write methods of a signal.
+type readonly[T any] struct {
+	signal *Signal[T]
+}
+
+func (r readonly[T]) Get() T {
+	return r.signal.Get()
+}
+
+func (r readonly[T]) Subscribe(fn func()) func() {
+	return r.signal.Subscribe(fn)
+}
+
+func (r readonly[T]) SubscribeWithScheduler(scheduler Scheduler, fn func()) func() {
+	return r.signal.SubscribeWithScheduler(scheduler, fn)
+}
+
+var (
+	_ Readable[int] = readonly[int]{}
+	_ Writable[int] = (*Signal[int])(nil)
+	_ Readable[int] = (*Computed[int])(nil)
+)
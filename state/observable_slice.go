@@ -0,0 +1,202 @@
+package state
+
+import "sync"
+
+// SliceOp identifies the mutation described by a SliceEvent.
+type SliceOp int
+
+const (
+	// SliceAppend adds Items at the end, starting at Index.
+	SliceAppend SliceOp = iota
+	// SliceRemove deletes the single item in Items from Index.
+	SliceRemove
+	// SliceSet replaces the item at Index with the single item in Items.
+	SliceSet
+	// SliceReplace swaps the whole slice for Items; Index is 0.
+	SliceReplace
+)
+
+// String returns the operation name.
+func (op SliceOp) String() string {
+	switch op {
+	case SliceAppend:
+		return "append"
+	case SliceRemove:
+		return "remove"
+	case SliceSet:
+		return "set"
+	case SliceReplace:
+		return "replace"
+	}
+	return "unknown"
+}
+
+// SliceEvent describes one ObservableSlice mutation. Items does not alias
+// the slice's storage, but it is shared by all subscribers, which should not
+// modify it.
+type SliceEvent[T any] struct {
+	Op    SliceOp
+	Index int
+	Items []T
+}
+
+// ObservableSlice holds a slice and reports each mutation to subscribers as
+// a SliceEvent, so they can apply the change instead of reloading the slice.
+type ObservableSlice[T any] struct {
+	mu    sync.Mutex
+	items []T
+	subs  map[int]*sliceSubscriber[T]
+	next  int
+}
+
+type sliceSubscriber[T any] struct {
+	fn func(SliceEvent[T])
+}
+
+// NewObservableSlice creates an observable slice holding a copy of initial.
+func NewObservableSlice[T any](initial []T) *ObservableSlice[T] {
+	return &ObservableSlice[T]{items: append([]T(nil), initial...)}
+}
+
+// Get returns a copy of the current items.
+func (s *ObservableSlice[T]) Get() []T {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]T(nil), s.items...)
+}
+
+// Len returns the number of items.
+func (s *ObservableSlice[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+// At returns the item at index and whether it exists.
+func (s *ObservableSlice[T]) At(index int) (T, bool) {
+	var zero T
+	if s == nil {
+		return zero, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if index < 0 || index >= len(s.items) {
+		return zero, false
+	}
+	return s.items[index], true
+}
+
+// Append adds items to the end and notifies subscribers. Appending nothing
+// is a no-op.
+func (s *ObservableSlice[T]) Append(items ...T) {
+	if s == nil || len(items) == 0 {
+		return
+	}
+	s.mu.Lock()
+	index := len(s.items)
+	s.items = append(s.items, items...)
+	subs := s.copySubscribersLocked()
+	s.mu.Unlock()
+	notifySlice(subs, SliceEvent[T]{Op: SliceAppend, Index: index, Items: append([]T(nil), items...)})
+}
+
+// Remove deletes the item at index and notifies subscribers.
+func (s *ObservableSlice[T]) Remove(index int) error {
+	if s == nil {
+		return ErrIndexOutOfRange
+	}
+	s.mu.Lock()
+	if index < 0 || index >= len(s.items) {
+		s.mu.Unlock()
+		return ErrIndexOutOfRange
+	}
+	removed := s.items[index]
+	var zero T
+	copy(s.items[index:], s.items[index+1:])
+	s.items[len(s.items)-1] = zero
+	s.items = s.items[:len(s.items)-1]
+	subs := s.copySubscribersLocked()
+	s.mu.Unlock()
+	notifySlice(subs, SliceEvent[T]{Op: SliceRemove, Index: index, Items: []T{removed}})
+	return nil
+}
+
+// Set replaces the item at index and notifies subscribers.
+func (s *ObservableSlice[T]) Set(index int, item T) error {
+	if s == nil {
+		return ErrIndexOutOfRange
+	}
+	s.mu.Lock()
+	if index < 0 || index >= len(s.items) {
+		s.mu.Unlock()
+		return ErrIndexOutOfRange
+	}
+	s.items[index] = item
+	subs := s.copySubscribersLocked()
+	s.mu.Unlock()
+	notifySlice(subs, SliceEvent[T]{Op: SliceSet, Index: index, Items: []T{item}})
+	return nil
+}
+
+// Replace swaps in a copy of items and notifies subscribers.
+func (s *ObservableSlice[T]) Replace(items []T) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.items = append([]T(nil), items...)
+	subs := s.copySubscribersLocked()
+	s.mu.Unlock()
+	notifySlice(subs, SliceEvent[T]{Op: SliceReplace, Items: append([]T(nil), items...)})
+}
+
+// Subscribe registers a listener for mutations. Listeners run synchronously
+// on the mutating goroutine after the slice lock is released.
+func (s *ObservableSlice[T]) Subscribe(fn func(SliceEvent[T])) func() {
+	if s == nil || fn == nil {
+		return func() {}
+	}
+	s.mu.Lock()
+	if s.subs == nil {
+		s.subs = make(map[int]*sliceSubscriber[T])
+	}
+	id := s.next
+	s.next++
+	s.subs[id] = &sliceSubscriber[T]{fn: fn}
+	s.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			delete(s.subs, id)
+			s.mu.Unlock()
+		})
+	}
+}
+
+// copySubscribersLocked returns the subscribers in subscription order.
+func (s *ObservableSlice[T]) copySubscribersLocked() []*sliceSubscriber[T] {
+	if len(s.subs) == 0 {
+		return nil
+	}
+	subs := make([]*sliceSubscriber[T], 0, len(s.subs))
+	for id := 0; id < s.next; id++ {
+		if sub, ok := s.subs[id]; ok {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+func notifySlice[T any](subs []*sliceSubscriber[T], event SliceEvent[T]) {
+	for _, sub := range subs {
+		sub.fn(event)
+	}
+}
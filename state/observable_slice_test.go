@@ -0,0 +1,59 @@
+package state
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestObservableSlice_Events(t *testing.T) {
+	s := NewObservableSlice([]string{"a", "b"})
+	var events []SliceEvent[string]
+	unsub := s.Subscribe(func(e SliceEvent[string]) { events = append(events, e) })
+	defer unsub()
+
+	s.Append("c", "d")
+	if err := s.Set(0, "A"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := s.Remove(1); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	s.Replace([]string{"x"})
+
+	want := []SliceEvent[string]{
+		{Op: SliceAppend, Index: 2, Items: []string{"c", "d"}},
+		{Op: SliceSet, Index: 0, Items: []string{"A"}},
+		{Op: SliceRemove, Index: 1, Items: []string{"b"}},
+		{Op: SliceReplace, Index: 0, Items: []string{"x"}},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("events = %+v, want %+v", events, want)
+	}
+	if got := s.Get(); !reflect.DeepEqual(got, []string{"x"}) {
+		t.Fatalf("Get() = %v, want [x]", got)
+	}
+}
+
+func TestObservableSlice_ErrorsAndUnsubscribe(t *testing.T) {
+	s := NewObservableSlice([]int{1})
+	calls := 0
+	unsub := s.Subscribe(func(SliceEvent[int]) { calls++ })
+
+	if err := s.Remove(3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("Remove(3) = %v, want ErrIndexOutOfRange", err)
+	}
+	if err := s.Set(-1, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("Set(-1) = %v, want ErrIndexOutOfRange", err)
+	}
+	s.Append()
+	if calls != 0 {
+		t.Fatalf("failed mutations notified %d times", calls)
+	}
+
+	unsub()
+	s.Append(2)
+	if calls != 0 || s.Len() != 2 {
+		t.Fatalf("calls = %d, len = %d after unsubscribe", calls, s.Len())
+	}
+}
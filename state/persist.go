@@ -0,0 +1,160 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Persist defaults.
+const (
+	DefaultPersistDebounce = 500 * time.Millisecond
+	DefaultPersistPerm     = fs.FileMode(0o644)
+)
+
+// PersistOptions configures Persist.
+type PersistOptions struct {
+	// Debounce delays each write until the value has stopped changing for
+	// this long. Zero uses DefaultPersistDebounce.
+	Debounce time.Duration
+	// Perm is the mode of the written file. Zero uses DefaultPersistPerm.
+	Perm fs.FileMode
+	// OnError receives read, decode, encode, and write errors. They are
+	// dropped when nil.
+	OnError func(error)
+}
+
+// Persist loads sig from the JSON file at path, if it exists, and then
+// writes the value back to path shortly after each change. Writes go to a
+// temporary file that is renamed over path, so readers never see a partial
+// file. The returned function stops persisting and writes any pending change
+// before returning.
+func Persist[T any](sig *Signal[T], path string, opts PersistOptions) func() {
+	if sig == nil {
+		return func() {}
+	}
+	if opts.Debounce <= 0 {
+		opts.Debounce = DefaultPersistDebounce
+	}
+	if opts.Perm == 0 {
+		opts.Perm = DefaultPersistPerm
+	}
+	p := &persister[T]{sig: sig, path: path, opts: opts}
+	p.load()
+	unsub := sig.Subscribe(p.schedule)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			unsub()
+			p.mu.Lock()
+			p.stopped = true
+			if p.timer != nil {
+				p.timer.Stop()
+			}
+			p.mu.Unlock()
+			p.flush()
+		})
+	}
+}
+
+type persister[T any] struct {
+	sig  *Signal[T]
+	path string
+	opts PersistOptions
+
+	mu      sync.Mutex
+	timer   *time.Timer
+	pending bool
+	stopped bool
+
+	// writeMu serializes writes so a flush on stop waits for one in flight.
+	writeMu sync.Mutex
+}
+
+func (p *persister[T]) load() {
+	data, err := os.ReadFile(p.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		p.report(err)
+		return
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		p.report(err)
+		return
+	}
+	p.sig.Set(value)
+}
+
+// schedule restarts the debounce timer after a change.
+func (p *persister[T]) schedule() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped {
+		return
+	}
+	p.pending = true
+	if p.timer == nil {
+		p.timer = time.AfterFunc(p.opts.Debounce, p.flush)
+		return
+	}
+	p.timer.Reset(p.opts.Debounce)
+}
+
+// flush writes the current value if a change is pending.
+func (p *persister[T]) flush() {
+	p.writeMu.Lock()
+	defer p.writeMu.Unlock()
+	p.mu.Lock()
+	pending := p.pending
+	p.pending = false
+	p.mu.Unlock()
+	if !pending {
+		return
+	}
+	data, err := json.Marshal(p.sig.Get())
+	if err != nil {
+		p.report(err)
+		return
+	}
+	if err := writeFileAtomic(p.path, data, p.opts.Perm); err != nil {
+		p.report(err)
+	}
+}
+
+func (p *persister[T]) report(err error) {
+	if p.opts.OnError != nil {
+		p.opts.OnError(err)
+	}
+}
+
+// writeFileAtomic writes data to a temporary file beside path and renames it
+// into place.
+func writeFileAtomic(path string, data []byte, perm fs.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	name := tmp.Name()
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Chmod(perm)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(name, path)
+	}
+	if err != nil {
+		_ = os.Remove(name)
+	}
+	return err
+}
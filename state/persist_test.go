@@ -0,0 +1,72 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type persistedPrefs struct {
+	Theme string   `json:"theme"`
+	Tabs  []string `json:"tabs"`
+}
+
+func TestPersist_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prefs.json")
+	prefs := NewSignal(persistedPrefs{Theme: "dark"})
+	stop := Persist(prefs, path, PersistOptions{Debounce: time.Hour})
+
+	prefs.Set(persistedPrefs{Theme: "light"})
+	prefs.Set(persistedPrefs{Theme: "solarized", Tabs: []string{"a", "b"}})
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("file written before debounce elapsed: %v", err)
+	}
+	stop()
+
+	restored := NewSignal(persistedPrefs{})
+	defer Persist(restored, path, PersistOptions{})()
+	got := restored.Get()
+	if got.Theme != "solarized" || len(got.Tabs) != 2 || got.Tabs[1] != "b" {
+		t.Fatalf("restored = %+v", got)
+	}
+}
+
+func TestPersist_DebouncedWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "count.json")
+	count := NewSignal(0)
+	stop := Persist(count, path, PersistOptions{Debounce: 5 * time.Millisecond, Perm: 0o600})
+	defer stop()
+
+	count.Set(7)
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		data, err := os.ReadFile(path)
+		if err == nil && string(data) == "7" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("file = %q, %v; want 7", data, err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("mode = %v, %v; want 0600", info.Mode().Perm(), err)
+	}
+}
+
+func TestPersist_ReportsDecodeError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(path, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var reported error
+	sig := NewSignal(3)
+	defer Persist(sig, path, PersistOptions{OnError: func(err error) { reported = err }})()
+	if reported == nil {
+		t.Fatal("decode error not reported")
+	}
+	if sig.Get() != 3 {
+		t.Fatalf("value = %d, want initial 3 kept", sig.Get())
+	}
+}
@@ -36,10 +36,19 @@ func (AsyncScheduler) Schedule(fn func()) {
 	go fn()
 }
 
+// Queue priority levels. Lower values run first within a Flush.
+const (
+	PriorityHigh = iota
+	PriorityNormal
+	PriorityLow
+
+	queuePriorities = PriorityLow + 1
+)
+
 // Queue batches callbacks for explicit flushing.
 type Queue struct {
 	mu      sync.Mutex
-	pending []func()
+	pending [queuePriorities][]func()
 }
 
 // NewQueue creates an empty queue.
@@ -47,27 +56,40 @@ func NewQueue() *Queue {
 	return &Queue{}
 }
 
-// Schedule enqueues a callback for later flushing.
+// Schedule enqueues a callback at PriorityNormal.
 func (q *Queue) Schedule(fn func()) {
+	q.ScheduleWithPriority(fn, PriorityNormal)
+}
+
+// ScheduleWithPriority enqueues a callback at the given priority. Values
+// outside PriorityHigh..PriorityLow are clamped. Callbacks of equal
+// priority run in the order they were scheduled.
+func (q *Queue) ScheduleWithPriority(fn func(), priority int) {
 	if q == nil || fn == nil {
 		return
 	}
+	priority = min(max(priority, PriorityHigh), PriorityLow)
 	q.mu.Lock()
-	q.pending = append(q.pending, fn)
+	q.pending[priority] = append(q.pending[priority], fn)
 	q.mu.Unlock()
 }
 
-// Flush executes queued callbacks and returns the count.
+// Flush executes queued callbacks, highest priority first, and returns the
+// total count.
 func (q *Queue) Flush() int {
 	if q == nil {
 		return 0
 	}
 	q.mu.Lock()
 	pending := q.pending
-	q.pending = nil
+	q.pending = [queuePriorities][]func(){}
 	q.mu.Unlock()
-	for _, fn := range pending {
-		fn()
+	count := 0
+	for _, level := range pending {
+		for _, fn := range level {
+			fn()
+		}
+		count += len(level)
 	}
-	return len(pending)
+	return count
 }
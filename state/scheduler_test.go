@@ -23,3 +23,26 @@ func TestQueue_Flush(t *testing.T) {
 		t.Fatalf("expected empty flush, got %d", flushed)
 	}
 }
+
+func TestQueue_FlushByPriority(t *testing.T) {
+	queue := NewQueue()
+	var calls []string
+
+	queue.ScheduleWithPriority(func() { calls = append(calls, "low") }, PriorityLow)
+	queue.Schedule(func() { calls = append(calls, "normal") })
+	queue.ScheduleWithPriority(func() { calls = append(calls, "high") }, PriorityHigh)
+	queue.ScheduleWithPriority(func() { calls = append(calls, "high2") }, -5)
+
+	if flushed := queue.Flush(); flushed != 4 {
+		t.Fatalf("expected 4 callbacks flushed, got %d", flushed)
+	}
+	want := []string{"high", "high2", "normal", "low"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", calls, want)
+		}
+	}
+}
@@ -1,7 +1,11 @@
 // Package state provides minimal reactive primitives for terminal UIs.
 package state
 
-import "sync"
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
 
 // EqualFunc compares two values for equality.
 type EqualFunc[T any] func(a, b T) bool
@@ -19,13 +23,14 @@ type Subscribable interface {
 type subscriber struct {
 	fn        func()
 	scheduler Scheduler
+	stopped   atomic.Bool
 }
 
 // Signal holds a value and notifies subscribers on change.
 type Signal[T any] struct {
 	mu    sync.Mutex
 	value T
-	subs  map[int]subscriber
+	subs  map[int]*subscriber
 	next  int
 	equal EqualFunc[T]
 }
@@ -45,6 +50,52 @@ func (s *Signal[T]) SetEqualFunc(fn EqualFunc[T]) {
 	s.mu.Unlock()
 }
 
+// SetDeepEqual suppresses updates whose value is reflect.DeepEqual to the
+// current value.
+func (s *Signal[T]) SetDeepEqual() {
+	s.SetEqualFunc(func(a, b T) bool {
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+// SetAutoEqual picks an equality check from T: == for comparable types and
+// reflect.DeepEqual otherwise, including for types holding interfaces whose
+// dynamic values may not be comparable.
+func (s *Signal[T]) SetAutoEqual() {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if !typ.Comparable() || holdsInterface(typ) {
+		s.SetDeepEqual()
+		return
+	}
+	s.SetEqualFunc(func(a, b T) bool {
+		return any(a) == any(b)
+	})
+}
+
+// holdsInterface reports whether typ is or contains an interface, in which
+// case == panics when the dynamic values are not comparable.
+func holdsInterface(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return holdsInterface(typ.Elem())
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if holdsInterface(typ.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AsReadonly returns a read-only view of the signal.
+// The returned value cannot be type-asserted back to *Signal[T].
+func (s *Signal[T]) AsReadonly() Readable[T] {
+	return readonly[T]{signal: s}
+}
+
 // Get returns the current value.
 func (s *Signal[T]) Get() T {
 	if s == nil {
@@ -99,16 +150,18 @@ func (s *Signal[T]) SubscribeWithScheduler(scheduler Scheduler, fn func()) func(
 	}
 	s.mu.Lock()
 	if s.subs == nil {
-		s.subs = make(map[int]subscriber)
+		s.subs = make(map[int]*subscriber)
 	}
 	id := s.next
 	s.next++
-	s.subs[id] = subscriber{fn: fn, scheduler: scheduler}
+	sub := &subscriber{fn: fn, scheduler: scheduler}
+	s.subs[id] = sub
 	s.mu.Unlock()
 
 	var once sync.Once
 	return func() {
 		once.Do(func() {
+			sub.stopped.Store(true)
 			s.mu.Lock()
 			delete(s.subs, id)
 			s.mu.Unlock()
@@ -116,20 +169,27 @@ func (s *Signal[T]) SubscribeWithScheduler(scheduler Scheduler, fn func()) func(
 	}
 }
 
-func (s *Signal[T]) copySubscribersLocked() []subscriber {
+func (s *Signal[T]) copySubscribersLocked() []*subscriber {
 	if len(s.subs) == 0 {
 		return nil
 	}
-	subs := make([]subscriber, 0, len(s.subs))
+	subs := make([]*subscriber, 0, len(s.subs))
 	for _, sub := range s.subs {
 		subs = append(subs, sub)
 	}
 	return subs
 }
 
-func (s *Signal[T]) notify(subs []subscriber) {
+func (s *Signal[T]) notify(subs []*subscriber) {
+	if deferNotify(subs) {
+		return
+	}
+	runSubscribers(subs)
+}
+
+func runSubscribers(subs []*subscriber) {
 	for _, sub := range subs {
-		if sub.fn == nil {
+		if sub.fn == nil || sub.stopped.Load() {
 			continue
 		}
 		if sub.scheduler == nil {
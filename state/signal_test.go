@@ -39,6 +39,48 @@ func TestSignal_SetEqualFunc(t *testing.T) {
 	}
 }
 
+func TestSignal_SetAutoEqual(t *testing.T) {
+	ints := NewSignal(5)
+	ints.SetAutoEqual()
+	if ints.Set(5) {
+		t.Fatalf("expected duplicate int set to report no change")
+	}
+	if !ints.Set(6) {
+		t.Fatalf("expected new int to report change")
+	}
+
+	calls := 0
+	words := NewSignal([]string{"a", "b"})
+	words.SetAutoEqual()
+	words.Subscribe(func() { calls++ })
+	if words.Set([]string{"a", "b"}) {
+		t.Fatalf("expected duplicate slice set to report no change")
+	}
+	if !words.Set([]string{"a", "c"}) || calls != 1 {
+		t.Fatalf("expected changed slice to notify once, calls=%d", calls)
+	}
+
+	anys := NewSignal[any]([]int{1})
+	anys.SetAutoEqual()
+	if anys.Set([]int{1}) {
+		t.Fatalf("expected interface signal to compare dynamic slices deeply")
+	}
+
+	// The struct is comparable, but == panics on the slice in Value.
+	type field struct {
+		Name  string
+		Value any
+	}
+	fields := NewSignal(field{Name: "tags", Value: []string{"a"}})
+	fields.SetAutoEqual()
+	if fields.Set(field{Name: "tags", Value: []string{"a"}}) {
+		t.Fatalf("expected struct with equal interface field to report no change")
+	}
+	if !fields.Set(field{Name: "tags", Value: []string{"b"}}) {
+		t.Fatalf("expected struct with changed interface field to report change")
+	}
+}
+
 func TestSignal_Update(t *testing.T) {
 	sig := NewSignal(1)
 	sig.SetEqualFunc(EqualComparable[int])
@@ -79,3 +121,23 @@ func TestSignal_SubscribeWithScheduler(t *testing.T) {
 		t.Fatalf("expected callback after flush, got %d", calls)
 	}
 }
+
+func TestSignal_AsReadonly(t *testing.T) {
+	sig := NewSignal(1)
+	ro := sig.AsReadonly()
+
+	if _, ok := ro.(*Signal[int]); ok {
+		t.Fatalf("expected readonly view not to expose *Signal")
+	}
+	if _, ok := ro.(Writable[int]); ok {
+		t.Fatalf("expected readonly view not to be writable")
+	}
+
+	calls := 0
+	unsub := ro.Subscribe(func() { calls++ })
+	sig.Set(2)
+	if calls != 1 || ro.Get() != 2 {
+		t.Fatalf("expected subscription to fire with value 2, got calls=%d value=%d", calls, ro.Get())
+	}
+	unsub()
+}
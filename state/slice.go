@@ -0,0 +1,122 @@
+package state
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrIndexOutOfRange is returned by SliceSignal mutations given a bad index.
+var ErrIndexOutOfRange = errors.New("state: index out of range")
+
+// SliceSignal holds a slice and notifies subscribers on each mutation.
+// Mutations happen under the signal lock, so callers never need to copy the
+// slice themselves, and Get returns a copy that is safe to modify.
+type SliceSignal[T any] struct {
+	mu      sync.Mutex
+	items   []T
+	changed Signal[struct{}]
+}
+
+// NewSliceSignal creates a slice signal holding a copy of initial.
+func NewSliceSignal[T any](initial []T) *SliceSignal[T] {
+	return &SliceSignal[T]{items: append([]T(nil), initial...)}
+}
+
+// Get returns a copy of the current items.
+func (s *SliceSignal[T]) Get() []T {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]T(nil), s.items...)
+}
+
+// Len returns the number of items.
+func (s *SliceSignal[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+// At returns the item at index and whether it exists.
+func (s *SliceSignal[T]) At(index int) (T, bool) {
+	var zero T
+	if s == nil {
+		return zero, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if index < 0 || index >= len(s.items) {
+		return zero, false
+	}
+	return s.items[index], true
+}
+
+// Append adds item to the end and notifies subscribers.
+func (s *SliceSignal[T]) Append(item T) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.items = append(s.items, item)
+	s.mu.Unlock()
+	s.changed.Set(struct{}{})
+}
+
+// Remove deletes the item at index and notifies subscribers.
+func (s *SliceSignal[T]) Remove(index int) error {
+	if s == nil {
+		return ErrIndexOutOfRange
+	}
+	s.mu.Lock()
+	if index < 0 || index >= len(s.items) {
+		s.mu.Unlock()
+		return ErrIndexOutOfRange
+	}
+	var zero T
+	copy(s.items[index:], s.items[index+1:])
+	s.items[len(s.items)-1] = zero
+	s.items = s.items[:len(s.items)-1]
+	s.mu.Unlock()
+	s.changed.Set(struct{}{})
+	return nil
+}
+
+// Set replaces the item at index and notifies subscribers.
+func (s *SliceSignal[T]) Set(index int, item T) error {
+	if s == nil {
+		return ErrIndexOutOfRange
+	}
+	s.mu.Lock()
+	if index < 0 || index >= len(s.items) {
+		s.mu.Unlock()
+		return ErrIndexOutOfRange
+	}
+	s.items[index] = item
+	s.mu.Unlock()
+	s.changed.Set(struct{}{})
+	return nil
+}
+
+// Subscribe registers a listener for mutations.
+func (s *SliceSignal[T]) Subscribe(fn func()) func() {
+	return s.SubscribeWithScheduler(nil, fn)
+}
+
+// SubscribeWithScheduler registers a listener using a scheduler.
+// If scheduler is nil, callbacks run synchronously.
+func (s *SliceSignal[T]) SubscribeWithScheduler(scheduler Scheduler, fn func()) func() {
+	if s == nil {
+		return func() {}
+	}
+	return s.changed.SubscribeWithScheduler(scheduler, fn)
+}
+
+var (
+	_ Subscribable    = (*SliceSignal[int])(nil)
+	_ Readable[[]int] = (*SliceSignal[int])(nil)
+)
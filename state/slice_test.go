@@ -0,0 +1,83 @@
+package state
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestSliceSignal_Mutations(t *testing.T) {
+	initial := []string{"a", "b"}
+	s := NewSliceSignal(initial)
+	initial[0] = "changed"
+
+	calls := 0
+	unsub := s.Subscribe(func() { calls++ })
+	defer unsub()
+
+	s.Append("c")
+	if calls != 1 {
+		t.Fatalf("calls after Append = %d, want 1", calls)
+	}
+	if err := s.Set(1, "B"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := s.Remove(0); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("calls after Set and Remove = %d, want 3", calls)
+	}
+
+	got := s.Get()
+	if len(got) != 2 || got[0] != "B" || got[1] != "c" || s.Len() != 2 {
+		t.Fatalf("Get() = %v, want [B c]", got)
+	}
+	got[0] = "mutated"
+	if item, _ := s.At(0); item != "B" {
+		t.Fatalf("Get result aliases internal slice: At(0) = %q", item)
+	}
+	if calls != 3 {
+		t.Fatalf("Get should not notify, calls = %d", calls)
+	}
+}
+
+func TestSliceSignal_OutOfRange(t *testing.T) {
+	s := NewSliceSignal([]int{1})
+	calls := 0
+	s.Subscribe(func() { calls++ })
+
+	if err := s.Remove(1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("Remove(1) error = %v, want ErrIndexOutOfRange", err)
+	}
+	if err := s.Set(-1, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("Set(-1) error = %v, want ErrIndexOutOfRange", err)
+	}
+	if calls != 0 || s.Len() != 1 {
+		t.Fatalf("failed mutations changed state: calls = %d, len = %d", calls, s.Len())
+	}
+}
+
+func TestSliceSignal_ConcurrentAppend(t *testing.T) {
+	s := NewSliceSignal[int](nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s.Append(j)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = s.Get()
+			}
+		}()
+	}
+	wg.Wait()
+	if s.Len() != 800 {
+		t.Fatalf("Len() = %d, want 800", s.Len())
+	}
+}
@@ -0,0 +1,154 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrTransactionConflict is the panic value when a ConflictPanic transaction
+// writes the same signal twice.
+var ErrTransactionConflict = errors.New("state: signal written twice in transaction")
+
+// ConflictPolicy decides what a transaction does when one signal is written
+// more than once.
+type ConflictPolicy int
+
+const (
+	// ConflictKeepLast applies the last value written (default).
+	ConflictKeepLast ConflictPolicy = iota
+	// ConflictKeepFirst ignores writes after the first.
+	ConflictKeepFirst
+	// ConflictPanic panics with ErrTransactionConflict.
+	ConflictPanic
+)
+
+// Transactional is a signal that can be written inside a Transaction.
+type Transactional interface {
+	setInTx(tx *Transaction, value any)
+}
+
+// Transaction collects signal writes so they apply together. Reads inside
+// the transaction still see the committed values.
+type Transaction struct {
+	mu     sync.Mutex
+	policy ConflictPolicy
+	writes []txWrite
+	index  map[Transactional]int
+	done   bool
+}
+
+type txWrite struct {
+	apply func()
+}
+
+// RunTransaction calls fn with a new transaction, then applies its writes in
+// the order the signals were first written, inside one Batch. If fn panics,
+// nothing is applied and the panic propagates.
+func RunTransaction(fn func(tx *Transaction)) {
+	if fn == nil {
+		return
+	}
+	tx := &Transaction{}
+	defer tx.finish()
+	fn(tx)
+	tx.mu.Lock()
+	writes := tx.writes
+	tx.writes = nil
+	tx.mu.Unlock()
+	Batch(func() {
+		for _, w := range writes {
+			w.apply()
+		}
+	})
+}
+
+// SetConflictPolicy sets how repeated writes to one signal are handled.
+func (tx *Transaction) SetConflictPolicy(policy ConflictPolicy) {
+	if tx == nil {
+		return
+	}
+	tx.mu.Lock()
+	tx.policy = policy
+	tx.mu.Unlock()
+}
+
+// Set stages a write of value to sig. value must be assignable to the
+// signal's type, or nil for the zero value; Set panics otherwise. Use
+// Signal.SetTx for a type-checked write.
+func (tx *Transaction) Set(sig Transactional, value any) {
+	if sig == nil {
+		return
+	}
+	sig.setInTx(tx, value)
+}
+
+// Written reports whether sig has a staged write.
+func (tx *Transaction) Written(sig Transactional) bool {
+	if tx == nil || sig == nil {
+		return false
+	}
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	_, ok := tx.index[sig]
+	return ok
+}
+
+// stage records a write to sig, resolving conflicts by the policy.
+func (tx *Transaction) stage(sig Transactional, apply func()) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		panic("state: write to finished transaction")
+	}
+	if i, ok := tx.index[sig]; ok {
+		switch tx.policy {
+		case ConflictKeepFirst:
+		case ConflictPanic:
+			panic(ErrTransactionConflict)
+		default:
+			tx.writes[i].apply = apply
+		}
+		return
+	}
+	if tx.index == nil {
+		tx.index = make(map[Transactional]int)
+	}
+	tx.index[sig] = len(tx.writes)
+	tx.writes = append(tx.writes, txWrite{apply: apply})
+}
+
+func (tx *Transaction) finish() {
+	tx.mu.Lock()
+	tx.done = true
+	tx.writes = nil
+	tx.mu.Unlock()
+}
+
+// SetTx stages value in tx, to be applied when the transaction commits. A
+// nil tx sets the value immediately.
+func (s *Signal[T]) SetTx(tx *Transaction, value T) {
+	if s == nil {
+		return
+	}
+	if tx == nil {
+		s.Set(value)
+		return
+	}
+	tx.stage(s, func() {
+		s.Set(value)
+	})
+}
+
+func (s *Signal[T]) setInTx(tx *Transaction, value any) {
+	var typed T
+	if value != nil {
+		v, ok := value.(T)
+		if !ok {
+			panic(fmt.Sprintf("state: cannot set %T in a Signal[%v]", value, reflect.TypeOf((*T)(nil)).Elem()))
+		}
+		typed = v
+	}
+	s.SetTx(tx, typed)
+}
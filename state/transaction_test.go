@@ -0,0 +1,84 @@
+package state
+
+import "testing"
+
+func TestRunTransaction_AppliesTogether(t *testing.T) {
+	cash := NewSignal(100)
+	debt := NewSignal(50)
+	calls := 0
+	var seen [2]int
+	cash.Subscribe(func() {
+		calls++
+		seen = [2]int{cash.Get(), debt.Get()}
+	})
+
+	RunTransaction(func(tx *Transaction) {
+		tx.Set(cash, 80)
+		cash.SetTx(tx, 70)
+		debt.SetTx(tx, 30)
+		if cash.Get() != 100 || !tx.Written(cash) {
+			t.Fatalf("write applied early or not tracked: cash=%d", cash.Get())
+		}
+	})
+
+	if cash.Get() != 70 || debt.Get() != 30 {
+		t.Fatalf("cash=%d debt=%d, want 70 and 30", cash.Get(), debt.Get())
+	}
+	if calls != 1 || seen != [2]int{70, 30} {
+		t.Fatalf("calls=%d seen=%v, want one notification after both writes", calls, seen)
+	}
+}
+
+func TestRunTransaction_PanicDiscardsWrites(t *testing.T) {
+	sig := NewSignal("before")
+	defer func() {
+		if recover() == nil {
+			t.Fatal("panic did not propagate")
+		}
+		if sig.Get() != "before" {
+			t.Fatalf("value = %q after panic, want before", sig.Get())
+		}
+	}()
+	RunTransaction(func(tx *Transaction) {
+		tx.Set(sig, "after")
+		panic("boom")
+	})
+}
+
+func TestRunTransaction_ConflictPolicies(t *testing.T) {
+	sig := NewSignal(0)
+	RunTransaction(func(tx *Transaction) {
+		tx.SetConflictPolicy(ConflictKeepFirst)
+		sig.SetTx(tx, 1)
+		sig.SetTx(tx, 2)
+	})
+	if sig.Get() != 1 {
+		t.Fatalf("KeepFirst value = %d, want 1", sig.Get())
+	}
+
+	defer func() {
+		if r := recover(); r != ErrTransactionConflict {
+			t.Fatalf("recovered %v, want ErrTransactionConflict", r)
+		}
+		if sig.Get() != 1 {
+			t.Fatalf("conflicting transaction applied: %d", sig.Get())
+		}
+	}()
+	RunTransaction(func(tx *Transaction) {
+		tx.SetConflictPolicy(ConflictPanic)
+		sig.SetTx(tx, 3)
+		sig.SetTx(tx, 4)
+	})
+}
+
+func TestTransaction_SetWrongTypePanics(t *testing.T) {
+	sig := NewSignal(0)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for mismatched type")
+		}
+	}()
+	RunTransaction(func(tx *Transaction) {
+		tx.Set(sig, "zero")
+	})
+}
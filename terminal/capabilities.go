@@ -0,0 +1,109 @@
+package terminal
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Capabilities describes optional terminal features.
+type Capabilities struct {
+	Unicode       bool
+	TrueColor     bool
+	Color256      bool
+	Sixel         bool
+	KittyGraphics bool
+	Hyperlinks    bool // OSC 8 hyperlinks
+}
+
+// Detect inspects the environment ($TERM, $COLORTERM, $VTE_VERSION,
+// $TERM_PROGRAM and the locale) to guess what the terminal supports.
+// Features that can only be discovered by querying the terminal, such as
+// Sixel, can be added afterwards with ApplyDA.
+func Detect() Capabilities {
+	return DetectFrom(os.Getenv)
+}
+
+// DetectFrom is Detect with a custom environment lookup.
+func DetectFrom(getenv func(string) string) Capabilities {
+	term := strings.ToLower(getenv("TERM"))
+	colorTerm := strings.ToLower(getenv("COLORTERM"))
+	program := getenv("TERM_PROGRAM")
+	vte, _ := strconv.Atoi(getenv("VTE_VERSION"))
+
+	var caps Capabilities
+	switch program {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty":
+		caps.TrueColor = true
+		caps.Unicode = true
+		caps.Hyperlinks = true
+	case "Apple_Terminal":
+		caps.Color256 = true
+		caps.Unicode = true
+	}
+	if colorTerm == "truecolor" || colorTerm == "24bit" || strings.HasSuffix(term, "-direct") {
+		caps.TrueColor = true
+	}
+	// VTE 0.36 was the first release with 24-bit colour.
+	if vte >= 3600 {
+		caps.TrueColor = true
+		caps.Unicode = true
+	}
+	// VTE 0.50 added OSC 8 hyperlinks.
+	if vte >= 5000 || getenv("WT_SESSION") != "" {
+		caps.Hyperlinks = true
+	}
+	if term == "xterm-kitty" || getenv("KITTY_WINDOW_ID") != "" || program == "WezTerm" || program == "ghostty" {
+		caps.KittyGraphics = true
+		caps.TrueColor = true
+		caps.Unicode = true
+		caps.Hyperlinks = true
+	}
+	if caps.TrueColor || strings.Contains(term, "256color") ||
+		strings.HasPrefix(term, "xterm") || strings.HasPrefix(term, "screen") ||
+		strings.HasPrefix(term, "tmux") {
+		caps.Color256 = true
+	}
+	if isUTF8Locale(getenv) {
+		caps.Unicode = true
+	}
+	if term == "dumb" {
+		return Capabilities{}
+	}
+	return caps
+}
+
+// ApplyDA updates caps from a primary device attributes response such as
+// "\x1b[?62;4;22c". The response may follow other replies, as it does for
+// ColorSchemeQuery. Attribute 4 advertises Sixel graphics.
+func (c *Capabilities) ApplyDA(response string) {
+	if c == nil {
+		return
+	}
+	start := strings.LastIndex(response, "\x1b[?")
+	if start < 0 {
+		return
+	}
+	body := response[start+len("\x1b[?"):]
+	body, ok := strings.CutSuffix(body, "c")
+	if !ok {
+		return
+	}
+	for i, attr := range strings.Split(body, ";") {
+		if i > 0 && attr == "4" {
+			c.Sixel = true
+		}
+	}
+}
+
+func isUTF8Locale(getenv func(string) string) bool {
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		value := getenv(key)
+		if value == "" {
+			continue
+		}
+		value = strings.ToLower(value)
+		return strings.Contains(value, "utf-8") || strings.Contains(value, "utf8")
+	}
+	return false
+}
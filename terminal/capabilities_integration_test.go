@@ -0,0 +1,28 @@
+//go:build integration
+
+package terminal
+
+import "testing"
+
+func TestDetect_ColorTermTrueColor(t *testing.T) {
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("COLORTERM", "truecolor")
+	if caps := Detect(); !caps.TrueColor || !caps.Color256 {
+		t.Fatalf("Detect() = %+v, want TrueColor and Color256", caps)
+	}
+}
+
+func TestDetect_XtermColor256(t *testing.T) {
+	t.Setenv("TERM", "xterm")
+	t.Setenv("COLORTERM", "")
+	t.Setenv("TERM_PROGRAM", "")
+	t.Setenv("VTE_VERSION", "")
+	t.Setenv("KITTY_WINDOW_ID", "")
+	caps := Detect()
+	if !caps.Color256 {
+		t.Fatalf("Detect() = %+v, want Color256", caps)
+	}
+	if caps.TrueColor {
+		t.Fatalf("Detect() = %+v, want no TrueColor", caps)
+	}
+}
@@ -0,0 +1,109 @@
+package terminal
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ColorSchemeQuery asks the terminal for its default foreground (OSC 10) and
+// background (OSC 11) colours, followed by a primary device attributes
+// request. Every terminal answers the last one, so its reply marks the end of
+// the response even when the colour queries are ignored.
+const ColorSchemeQuery = "\x1b]10;?\x1b\\\x1b]11;?\x1b\\\x1b[c"
+
+// RGB is a 24-bit colour reported by the terminal.
+type RGB struct {
+	R, G, B uint8
+}
+
+// ColorScheme is the terminal's default foreground and background colour.
+type ColorScheme struct {
+	Foreground RGB
+	Background RGB
+}
+
+// Dark reports whether the background is darker than the foreground.
+func (c ColorScheme) Dark() bool {
+	return luma(c.Background) < luma(c.Foreground)
+}
+
+// HighContrast reports whether the scheme is pure white on black or pure
+// black on white.
+func (c ColorScheme) HighContrast() bool {
+	return (isBlack(c.Foreground) && isWhite(c.Background)) ||
+		(isWhite(c.Foreground) && isBlack(c.Background))
+}
+
+// ParseColorScheme extracts the scheme from a terminal's reply to
+// ColorSchemeQuery, such as
+// "\x1b]10;rgb:ffff/ffff/ffff\x1b\\\x1b]11;rgb:0000/0000/0000\x1b\\\x1b[?62c".
+// ok is false unless both colours were reported.
+func ParseColorScheme(response string) (scheme ColorScheme, ok bool) {
+	var haveFG, haveBG bool
+	for {
+		start := strings.Index(response, "\x1b]")
+		if start < 0 {
+			break
+		}
+		response = response[start+2:]
+		end := strings.IndexAny(response, "\x07\x1b")
+		if end < 0 {
+			break
+		}
+		body := response[:end]
+		response = response[end:]
+		code, value, found := strings.Cut(body, ";")
+		if !found {
+			continue
+		}
+		color, parsed := parseOSCColor(value)
+		if !parsed {
+			continue
+		}
+		switch code {
+		case "10":
+			scheme.Foreground, haveFG = color, true
+		case "11":
+			scheme.Background, haveBG = color, true
+		}
+	}
+	return scheme, haveFG && haveBG
+}
+
+// parseOSCColor parses an X11 colour spec such as "rgb:ffff/8080/0000",
+// which may use one to four hex digits per channel.
+func parseOSCColor(spec string) (RGB, bool) {
+	body, ok := strings.CutPrefix(spec, "rgb:")
+	if !ok {
+		return RGB{}, false
+	}
+	channels := strings.Split(body, "/")
+	if len(channels) != 3 {
+		return RGB{}, false
+	}
+	var out [3]uint8
+	for i, channel := range channels {
+		if len(channel) == 0 || len(channel) > 4 {
+			return RGB{}, false
+		}
+		value, err := strconv.ParseUint(channel, 16, 16)
+		if err != nil {
+			return RGB{}, false
+		}
+		max := uint64(1)<<(4*len(channel)) - 1
+		out[i] = uint8(value * 255 / max)
+	}
+	return RGB{R: out[0], G: out[1], B: out[2]}, true
+}
+
+func luma(c RGB) int {
+	return 299*int(c.R) + 587*int(c.G) + 114*int(c.B)
+}
+
+func isBlack(c RGB) bool {
+	return c.R <= 0x10 && c.G <= 0x10 && c.B <= 0x10
+}
+
+func isWhite(c RGB) bool {
+	return c.R >= 0xf0 && c.G >= 0xf0 && c.B >= 0xf0
+}
@@ -105,4 +105,6 @@ const (
 	KeyCtrlV
 	KeyCtrlX
 	KeyCtrlZ
+	KeyCtrlA
+	KeyCtrlY
 )
@@ -10,7 +10,7 @@ func TestKeyConstants(t *testing.T) {
 		KeyPageUp, KeyPageDown, KeyDelete, KeyInsert,
 		KeyF1, KeyF2, KeyF3, KeyF4, KeyF5, KeyF6,
 		KeyF7, KeyF8, KeyF9, KeyF10, KeyF11, KeyF12,
-		KeyCtrlC, KeyCtrlD, KeyCtrlV, KeyCtrlX, KeyCtrlZ,
+		KeyCtrlA, KeyCtrlC, KeyCtrlD, KeyCtrlV, KeyCtrlX, KeyCtrlY, KeyCtrlZ,
 	}
 
 	// Ensure all are unique
@@ -65,3 +65,67 @@ func TestResizeEvent(t *testing.T) {
 		t.Errorf("expected Height=40, got %d", ev.Height)
 	}
 }
+
+func TestDetectFrom(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+	tests := []struct {
+		name string
+		vars map[string]string
+		want Capabilities
+	}{
+		{"dumb", map[string]string{"TERM": "dumb", "LANG": "en_US.UTF-8"}, Capabilities{}},
+		{"xterm", map[string]string{"TERM": "xterm"}, Capabilities{Color256: true}},
+		{"utf8 locale", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8"}, Capabilities{Unicode: true, Color256: true}},
+		{"truecolor", map[string]string{"TERM": "screen", "COLORTERM": "24bit"}, Capabilities{TrueColor: true, Color256: true}},
+		{"kitty", map[string]string{"TERM": "xterm-kitty"}, Capabilities{Unicode: true, TrueColor: true, Color256: true, KittyGraphics: true, Hyperlinks: true}},
+		{"vte hyperlinks", map[string]string{"TERM": "xterm-256color", "VTE_VERSION": "6003"}, Capabilities{TrueColor: true, Color256: true, Unicode: true, Hyperlinks: true}},
+	}
+	for _, tt := range tests {
+		if got := DetectFrom(env(tt.vars)); got != tt.want {
+			t.Errorf("%s: DetectFrom() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCapabilities_ApplyDA(t *testing.T) {
+	var caps Capabilities
+	caps.ApplyDA("\x1b[?62;22c")
+	if caps.Sixel {
+		t.Fatal("DA without attribute 4 should not enable Sixel")
+	}
+	caps.ApplyDA("\x1b[?62;4;22c")
+	if !caps.Sixel {
+		t.Fatal("DA with attribute 4 should enable Sixel")
+	}
+
+	caps = Capabilities{}
+	caps.ApplyDA("\x1b]11;rgb:0000/0000/0000\x1b\\\x1b[?62;4c")
+	if !caps.Sixel {
+		t.Fatal("DA following a colour reply should enable Sixel")
+	}
+}
+
+func TestParseColorScheme(t *testing.T) {
+	response := "\x1b]10;rgb:ffff/ffff/ffff\x1b\\\x1b]11;rgb:00/00/00\x07\x1b[?62;22c"
+	scheme, ok := ParseColorScheme(response)
+	if !ok {
+		t.Fatal("expected both colours to parse")
+	}
+	want := ColorScheme{Foreground: RGB{255, 255, 255}, Background: RGB{0, 0, 0}}
+	if scheme != want {
+		t.Fatalf("scheme = %+v, want %+v", scheme, want)
+	}
+	if !scheme.HighContrast() {
+		t.Fatal("white on black should be high contrast")
+	}
+
+	scheme, ok = ParseColorScheme("\x1b]11;rgb:2828/2c2c/3434\x1b\\\x1b[?62c")
+	if ok {
+		t.Fatalf("background-only reply parsed as %+v", scheme)
+	}
+	if _, ok := ParseColorScheme("\x1b[?62c"); ok {
+		t.Fatal("reply without colours should not parse")
+	}
+}
@@ -0,0 +1,29 @@
+package theme
+
+import "github.com/odvcencio/fluffy-ui/state"
+
+var active = state.NewSignal(DefaultTheme())
+
+// Active returns the current application theme.
+func Active() *Theme {
+	return active.Get()
+}
+
+// Set replaces the active theme and notifies subscribers. A nil theme
+// restores DefaultTheme.
+func Set(t *Theme) {
+	if t == nil {
+		t = DefaultTheme()
+	}
+	active.Set(t)
+}
+
+// ActiveSignal exposes the active theme for subscription.
+func ActiveSignal() state.Readable[*Theme] {
+	return active.AsReadonly()
+}
+
+// Apply makes t the active theme; it is an alias for Set.
+func Apply(t *Theme) {
+	Set(t)
+}
@@ -0,0 +1,163 @@
+package theme
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/compositor"
+	"github.com/odvcencio/fluffy-ui/style"
+)
+
+// MinContrastRatio is the WCAG AA minimum for normal text.
+const MinContrastRatio = 4.5
+
+// MinEnhancedContrastRatio is the WCAG AAA minimum for normal text, which
+// HighContrast meets for every token.
+const MinEnhancedContrastRatio = 7.0
+
+// ContrastWarning describes a theme token whose colours fail MinContrastRatio.
+type ContrastWarning struct {
+	Token string
+	FG    backend.Color
+	BG    backend.Color
+	Ratio float64
+}
+
+// ContrastRatio returns the WCAG contrast ratio between two colours,
+// from 1 (identical luminance) to 21 (black on white).
+// Default colours are treated as white foreground on a black background.
+func ContrastRatio(fg, bg backend.Color) float64 {
+	l1 := relativeLuminance(fg, backend.ColorBrightWhite)
+	l2 := relativeLuminance(bg, backend.ColorBlack)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// ValidateContrast returns every token whose foreground fails MinContrastRatio
+// against its own background, or the theme Background when it has none.
+func ValidateContrast(t *Theme) []ContrastWarning {
+	return validateContrast(t, MinContrastRatio)
+}
+
+// validateContrast returns every token whose foreground falls below minRatio.
+func validateContrast(t *Theme, minRatio float64) []ContrastWarning {
+	if t == nil {
+		return nil
+	}
+	base := backendColor(t.Background.BG)
+	var warnings []ContrastWarning
+	value := reflect.ValueOf(t).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		token, ok := value.Field(i).Interface().(compositor.Style)
+		if !ok || !hasColor(token.FG) {
+			continue
+		}
+		fg := backendColor(token.FG)
+		bg := base
+		if hasColor(token.BG) {
+			bg = backendColor(token.BG)
+		}
+		if ratio := ContrastRatio(fg, bg); ratio < minRatio {
+			warnings = append(warnings, ContrastWarning{
+				Token: value.Type().Field(i).Name,
+				FG:    fg,
+				BG:    bg,
+				Ratio: ratio,
+			})
+		}
+	}
+	return warnings
+}
+
+// HighContrast returns a theme that uses only white on black, or black on
+// white for inverted tokens such as selections. Emphasis comes from bold,
+// italic, and underline; no token is dimmed. Every token meets
+// MinEnhancedContrastRatio.
+func HighContrast() *Theme {
+	plain := compositor.DefaultStyle().WithFG(compositor.ColorWhite).WithBG(compositor.ColorBlack)
+	inverse := compositor.DefaultStyle().WithFG(compositor.ColorBlack).WithBG(compositor.ColorWhite)
+	bold := plain.WithBold(true)
+	return &Theme{
+		Background:    plain,
+		Surface:       plain,
+		SurfaceRaised: plain,
+		SurfaceDim:    plain,
+
+		Primary:     bold,
+		Secondary:   plain,
+		OnPrimary:   inverse,
+		OnSecondary: inverse,
+		OnSurface:   plain,
+
+		TextPrimary:   plain,
+		TextSecondary: plain,
+		TextMuted:     plain.WithItalic(true),
+		TextInverse:   inverse,
+
+		Accent:       bold,
+		AccentDim:    plain,
+		AccentGlow:   bold,
+		ElectricBlue: plain,
+		Coral:        plain,
+		Teal:         plain,
+
+		BlueGlow:   plain,
+		PurpleGlow: plain,
+		CoralGlow:  plain,
+
+		Success: bold,
+		Warning: bold.WithUnderline(true),
+		Error:   inverse.WithBold(true),
+		Info:    plain,
+
+		User:      bold,
+		Assistant: plain,
+		System:    plain.WithItalic(true),
+		Tool:      plain.WithUnderline(true),
+		Thinking:  plain.WithItalic(true),
+
+		Border:      plain,
+		BorderFocus: bold,
+		Selection:   inverse,
+		SearchMatch: inverse.WithUnderline(true),
+		Scrollbar:   plain,
+		ScrollThumb: inverse,
+
+		ModeNormal: plain,
+		ModeShell:  bold,
+		ModeEnv:    bold,
+		ModeSearch: bold.WithUnderline(true),
+
+		Logo:    bold,
+		Spinner: plain,
+	}
+}
+
+func hasColor(c compositor.Color) bool {
+	return c.Mode != compositor.ColorModeNone && c.Mode != compositor.ColorModeDefault
+}
+
+func backendColor(c compositor.Color) backend.Color {
+	return style.ToBackend(compositor.DefaultStyle().WithFG(c)).FG()
+}
+
+// relativeLuminance implements the WCAG 2.x relative luminance formula.
+// Colours the palette cannot resolve, such as ColorDefault, use fallback.
+func relativeLuminance(c, fallback backend.Color) float64 {
+	r, g, b, ok := c.Resolve()
+	if !ok {
+		r, g, b, _ = fallback.Resolve()
+	}
+	return 0.2126*linearize(r) + 0.7152*linearize(g) + 0.0722*linearize(b)
+}
+
+func linearize(v uint8) float64 {
+	s := float64(v) / 255
+	if s <= 0.03928 {
+		return s / 12.92
+	}
+	return math.Pow((s+0.055)/1.055, 2.4)
+}
@@ -0,0 +1,104 @@
+package theme
+
+import (
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/compositor"
+	"github.com/odvcencio/fluffy-ui/style"
+)
+
+// Role names a semantic color of a theme, so widgets can be styled by
+// purpose rather than by a fixed color.
+type Role int
+
+const (
+	RoleBackground Role = iota
+	RoleSurface
+	RolePrimary
+	RoleSecondary
+	RoleOnPrimary
+	RoleOnSecondary
+	RoleOnSurface
+	RoleText
+	RoleTextMuted
+	RoleSuccess
+	RoleWarning
+	RoleError
+	RoleInfo
+	RoleBorder
+	RoleBorderFocus
+	RoleSelection
+)
+
+var roleNames = [...]string{
+	RoleBackground:  "Background",
+	RoleSurface:     "Surface",
+	RolePrimary:     "Primary",
+	RoleSecondary:   "Secondary",
+	RoleOnPrimary:   "OnPrimary",
+	RoleOnSecondary: "OnSecondary",
+	RoleOnSurface:   "OnSurface",
+	RoleText:        "TextPrimary",
+	RoleTextMuted:   "TextMuted",
+	RoleSuccess:     "Success",
+	RoleWarning:     "Warning",
+	RoleError:       "Error",
+	RoleInfo:        "Info",
+	RoleBorder:      "Border",
+	RoleBorderFocus: "BorderFocus",
+	RoleSelection:   "Selection",
+}
+
+// String returns the name of the Theme field the role reads.
+func (r Role) String() string {
+	if r < 0 || int(r) >= len(roleNames) {
+		return "Unknown"
+	}
+	return roleNames[r]
+}
+
+// Style returns the token for role, or the default style for unknown roles.
+func (t *Theme) Style(role Role) compositor.Style {
+	if t == nil {
+		return compositor.DefaultStyle()
+	}
+	switch role {
+	case RoleBackground:
+		return t.Background
+	case RoleSurface:
+		return t.Surface
+	case RolePrimary:
+		return t.Primary
+	case RoleSecondary:
+		return t.Secondary
+	case RoleOnPrimary:
+		return t.OnPrimary
+	case RoleOnSecondary:
+		return t.OnSecondary
+	case RoleOnSurface:
+		return t.OnSurface
+	case RoleText:
+		return t.TextPrimary
+	case RoleTextMuted:
+		return t.TextMuted
+	case RoleSuccess:
+		return t.Success
+	case RoleWarning:
+		return t.Warning
+	case RoleError:
+		return t.Error
+	case RoleInfo:
+		return t.Info
+	case RoleBorder:
+		return t.Border
+	case RoleBorderFocus:
+		return t.BorderFocus
+	case RoleSelection:
+		return t.Selection
+	}
+	return compositor.DefaultStyle()
+}
+
+// Style returns the active theme's token for role as a backend.Style.
+func Style(role Role) backend.Style {
+	return style.ToBackend(Active().Style(role))
+}
@@ -14,6 +14,13 @@ type Theme struct {
 	SurfaceRaised compositor.Style // Higher elevation
 	SurfaceDim    compositor.Style // Recessed areas
 
+	// Brand colors
+	Primary     compositor.Style // Main brand color
+	Secondary   compositor.Style // Complementary brand color
+	OnPrimary   compositor.Style // Content on Primary fills
+	OnSecondary compositor.Style // Content on Secondary fills
+	OnSurface   compositor.Style // Content on Surface fills
+
 	// Text hierarchy
 	TextPrimary   compositor.Style // Main content
 	TextSecondary compositor.Style // Supporting text
@@ -74,6 +81,13 @@ func DefaultTheme() *Theme {
 		SurfaceRaised: compositor.DefaultStyle().WithBG(compositor.RGB(32, 32, 40)),
 		SurfaceDim:    compositor.DefaultStyle().WithBG(compositor.RGB(8, 8, 10)),
 
+		// Brand - amber with a teal complement
+		Primary:     compositor.DefaultStyle().WithFG(compositor.RGB(255, 183, 77)),
+		Secondary:   compositor.DefaultStyle().WithFG(compositor.RGB(77, 182, 172)),
+		OnPrimary:   compositor.DefaultStyle().WithFG(compositor.RGB(12, 12, 16)).WithBG(compositor.RGB(255, 183, 77)),
+		OnSecondary: compositor.DefaultStyle().WithFG(compositor.RGB(12, 12, 16)).WithBG(compositor.RGB(77, 182, 172)),
+		OnSurface:   compositor.DefaultStyle().WithFG(compositor.RGB(240, 238, 232)).WithBG(compositor.RGB(22, 22, 28)),
+
 		// Text hierarchy - warm whites
 		TextPrimary:   compositor.DefaultStyle().WithFG(compositor.RGB(240, 238, 232)),
 		TextSecondary: compositor.DefaultStyle().WithFG(compositor.RGB(160, 158, 150)),
@@ -1,8 +1,11 @@
 package theme
 
 import (
+	"math"
+	"reflect"
 	"testing"
 
+	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/compositor"
 )
 
@@ -251,3 +254,91 @@ func BenchmarkDefaultTheme(b *testing.B) {
 		_ = DefaultTheme()
 	}
 }
+
+func TestContrastRatio(t *testing.T) {
+	ratio := ContrastRatio(backend.ColorBlack, backend.ColorBrightWhite)
+	if math.Abs(ratio-21) > 0.01 {
+		t.Fatalf("black on white ratio = %.2f, want ~21", ratio)
+	}
+	if got := ContrastRatio(backend.ColorBrightWhite, backend.ColorBlack); math.Abs(got-ratio) > 1e-9 {
+		t.Fatalf("ratio should be symmetric, got %.2f", got)
+	}
+	if got := ContrastRatio(backend.ColorRGB(40, 40, 40), backend.ColorRGB(40, 40, 40)); got != 1 {
+		t.Fatalf("identical colours ratio = %.2f, want 1", got)
+	}
+}
+
+func TestValidateContrast_DarkOnDarkFails(t *testing.T) {
+	th := HighContrast()
+	th.TextMuted = compositor.DefaultStyle().WithFG(compositor.RGB(30, 30, 30))
+
+	warnings := ValidateContrast(th)
+	if len(warnings) != 1 || warnings[0].Token != "TextMuted" {
+		t.Fatalf("warnings = %+v, want one TextMuted warning", warnings)
+	}
+	if warnings[0].Ratio >= MinContrastRatio {
+		t.Fatalf("ratio = %.2f, want below %.1f", warnings[0].Ratio, MinContrastRatio)
+	}
+}
+
+func TestHighContrastPassesValidation(t *testing.T) {
+	if warnings := ValidateContrast(HighContrast()); len(warnings) != 0 {
+		t.Fatalf("HighContrast warnings = %+v", warnings)
+	}
+}
+
+func TestHighContrastMeetsEnhancedContrast(t *testing.T) {
+	if warnings := validateContrast(HighContrast(), MinEnhancedContrastRatio); len(warnings) != 0 {
+		t.Fatalf("HighContrast below %.0f:1: %+v", MinEnhancedContrastRatio, warnings)
+	}
+}
+
+func TestHighContrastUsesOnlyWhiteAndBlack(t *testing.T) {
+	th := HighContrast()
+	value := reflect.ValueOf(th).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		token, ok := value.Field(i).Interface().(compositor.Style)
+		if !ok {
+			continue
+		}
+		name := value.Type().Field(i).Name
+		if token.Dim {
+			t.Errorf("%s is dim", name)
+		}
+		for _, c := range []compositor.Color{token.FG, token.BG} {
+			if c != compositor.ColorWhite && c != compositor.ColorBlack {
+				t.Errorf("%s uses %+v, want white or black", name, c)
+			}
+		}
+	}
+}
+
+func TestBuiltinThemesSetRoles(t *testing.T) {
+	themes := map[string]*Theme{
+		"Default":      Default(),
+		"Dark":         Dark(),
+		"Solarized":    Solarized(),
+		"HighContrast": HighContrast(),
+	}
+	for name, th := range themes {
+		for role := RoleBackground; role <= RoleSelection; role++ {
+			if th.Style(role) == (compositor.Style{}) {
+				t.Errorf("%s: %s not set", name, role)
+			}
+		}
+	}
+}
+
+func TestStyleUsesAppliedTheme(t *testing.T) {
+	defer Set(nil)
+	Apply(Solarized())
+
+	got := Style(RolePrimary).FG()
+	if want := backend.ColorRGB(0x26, 0x8b, 0xd2); got != want {
+		t.Fatalf("Style(RolePrimary).FG() = %v, want %v", got, want)
+	}
+	onPrimary := Style(RoleOnPrimary)
+	if onPrimary.BG() != got {
+		t.Fatalf("OnPrimary background = %v, want primary %v", onPrimary.BG(), got)
+	}
+}
@@ -0,0 +1,133 @@
+package theme
+
+import "github.com/odvcencio/fluffy-ui/compositor"
+
+// Default returns the built-in default theme, DefaultTheme.
+func Default() *Theme {
+	return DefaultTheme()
+}
+
+// Dark returns a neutral dark theme with a blue primary color.
+func Dark() *Theme {
+	return fromPalette(palette{
+		background:    compositor.Hex(0x0d1117),
+		surface:       compositor.Hex(0x161b22),
+		surfaceRaised: compositor.Hex(0x21262d),
+		surfaceDim:    compositor.Hex(0x010409),
+		text:          compositor.Hex(0xe6edf3),
+		textSecondary: compositor.Hex(0xb1bac4),
+		textMuted:     compositor.Hex(0x8b949e),
+		primary:       compositor.Hex(0x58a6ff),
+		secondary:     compositor.Hex(0xbc8cff),
+		success:       compositor.Hex(0x3fb950),
+		warning:       compositor.Hex(0xd29922),
+		error:         compositor.Hex(0xf85149),
+		info:          compositor.Hex(0x39c5cf),
+		border:        compositor.Hex(0x30363d),
+		selection:     compositor.Hex(0x264f78),
+	})
+}
+
+// Solarized returns Ethan Schoonover's Solarized dark palette.
+func Solarized() *Theme {
+	return fromPalette(palette{
+		background:    compositor.Hex(0x002b36),
+		surface:       compositor.Hex(0x073642),
+		surfaceRaised: compositor.Hex(0x0a4050),
+		surfaceDim:    compositor.Hex(0x00212b),
+		text:          compositor.Hex(0x93a1a1),
+		textSecondary: compositor.Hex(0x839496),
+		textMuted:     compositor.Hex(0x657b83),
+		primary:       compositor.Hex(0x268bd2),
+		secondary:     compositor.Hex(0x2aa198),
+		success:       compositor.Hex(0x859900),
+		warning:       compositor.Hex(0xb58900),
+		error:         compositor.Hex(0xdc322f),
+		info:          compositor.Hex(0x6c71c4),
+		border:        compositor.Hex(0x586e75),
+		selection:     compositor.Hex(0x073642),
+	})
+}
+
+// palette is the small set of colors the built-in themes derive every token
+// from.
+type palette struct {
+	background    compositor.Color
+	surface       compositor.Color
+	surfaceRaised compositor.Color
+	surfaceDim    compositor.Color
+	text          compositor.Color
+	textSecondary compositor.Color
+	textMuted     compositor.Color
+	primary       compositor.Color
+	secondary     compositor.Color
+	success       compositor.Color
+	warning       compositor.Color
+	error         compositor.Color
+	info          compositor.Color
+	border        compositor.Color
+	selection     compositor.Color
+}
+
+func fromPalette(p palette) *Theme {
+	fg := func(c compositor.Color) compositor.Style {
+		return compositor.DefaultStyle().WithFG(c)
+	}
+	bg := func(c compositor.Color) compositor.Style {
+		return compositor.DefaultStyle().WithBG(c)
+	}
+	return &Theme{
+		Background:    fg(p.text).WithBG(p.background),
+		Surface:       bg(p.surface),
+		SurfaceRaised: bg(p.surfaceRaised),
+		SurfaceDim:    bg(p.surfaceDim),
+
+		Primary:     fg(p.primary),
+		Secondary:   fg(p.secondary),
+		OnPrimary:   fg(p.background).WithBG(p.primary),
+		OnSecondary: fg(p.background).WithBG(p.secondary),
+		OnSurface:   fg(p.text).WithBG(p.surface),
+
+		TextPrimary:   fg(p.text),
+		TextSecondary: fg(p.textSecondary),
+		TextMuted:     fg(p.textMuted),
+		TextInverse:   fg(p.background),
+
+		Accent:       fg(p.primary),
+		AccentDim:    fg(p.primary).WithDim(true),
+		AccentGlow:   fg(p.primary).WithBold(true),
+		ElectricBlue: fg(p.primary),
+		Coral:        fg(p.error),
+		Teal:         fg(p.info),
+
+		BlueGlow:   fg(p.primary).WithDim(true),
+		PurpleGlow: fg(p.secondary).WithDim(true),
+		CoralGlow:  fg(p.error).WithDim(true),
+
+		Success: fg(p.success),
+		Warning: fg(p.warning),
+		Error:   fg(p.error),
+		Info:    fg(p.info),
+
+		User:      fg(p.success),
+		Assistant: fg(p.primary),
+		System:    fg(p.textSecondary).WithItalic(true),
+		Tool:      fg(p.secondary),
+		Thinking:  fg(p.textMuted).WithItalic(true),
+
+		Border:      fg(p.border),
+		BorderFocus: fg(p.primary),
+		Selection:   bg(p.selection),
+		SearchMatch: fg(p.background).WithBG(p.warning),
+		Scrollbar:   fg(p.border),
+		ScrollThumb: fg(p.textMuted),
+
+		ModeNormal: fg(p.textSecondary),
+		ModeShell:  fg(p.success).WithBold(true),
+		ModeEnv:    fg(p.primary).WithBold(true),
+		ModeSearch: fg(p.warning).WithBold(true),
+
+		Logo:    fg(p.primary).WithBold(true),
+		Spinner: fg(p.primary),
+	}
+}
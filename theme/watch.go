@@ -0,0 +1,188 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/compositor"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// watchInterval is how often WatchFile polls the theme file.
+var watchInterval = 100 * time.Millisecond
+
+// StyleSpec is the JSON form of a theme token.
+type StyleSpec struct {
+	FG            string `json:"fg,omitempty"`
+	BG            string `json:"bg,omitempty"`
+	Bold          bool   `json:"bold,omitempty"`
+	Dim           bool   `json:"dim,omitempty"`
+	Italic        bool   `json:"italic,omitempty"`
+	Underline     bool   `json:"underline,omitempty"`
+	Blink         bool   `json:"blink,omitempty"`
+	Reverse       bool   `json:"reverse,omitempty"`
+	Strikethrough bool   `json:"strikethrough,omitempty"`
+}
+
+var namedColors = map[string]compositor.Color{
+	"default":        compositor.ColorDefault,
+	"none":           compositor.ColorNone,
+	"black":          compositor.ColorBlack,
+	"red":            compositor.ColorRed,
+	"green":          compositor.ColorGreen,
+	"yellow":         compositor.ColorYellow,
+	"blue":           compositor.ColorBlue,
+	"magenta":        compositor.ColorMagenta,
+	"cyan":           compositor.ColorCyan,
+	"white":          compositor.ColorWhite,
+	"bright-black":   compositor.ColorBrightBlack,
+	"bright-red":     compositor.ColorBrightRed,
+	"bright-green":   compositor.ColorBrightGreen,
+	"bright-yellow":  compositor.ColorBrightYellow,
+	"bright-blue":    compositor.ColorBrightBlue,
+	"bright-magenta": compositor.ColorBrightMagenta,
+	"bright-cyan":    compositor.ColorBrightCyan,
+	"bright-white":   compositor.ColorBrightWhite,
+}
+
+// ParseJSON builds a theme from a JSON object keyed by Theme field name.
+// Tokens missing from the file keep their DefaultTheme values. Colors are
+// "#rrggbb", a palette index ("0"-"255"), or a name such as "bright-red".
+func ParseJSON(data []byte) (*Theme, error) {
+	var specs map[string]StyleSpec
+	if err := json.Unmarshal(data, &specs); err != nil {
+		return nil, err
+	}
+	t := DefaultTheme()
+	v := reflect.ValueOf(t).Elem()
+	for name, spec := range specs {
+		field := v.FieldByName(name)
+		if !field.IsValid() || field.Type() != reflect.TypeOf(compositor.Style{}) {
+			return nil, fmt.Errorf("theme: unknown token %q", name)
+		}
+		style, err := spec.style()
+		if err != nil {
+			return nil, fmt.Errorf("theme: token %s: %w", name, err)
+		}
+		field.Set(reflect.ValueOf(style))
+	}
+	return t, nil
+}
+
+func (s StyleSpec) style() (compositor.Style, error) {
+	fg, err := parseColor(s.FG)
+	if err != nil {
+		return compositor.Style{}, err
+	}
+	bg, err := parseColor(s.BG)
+	if err != nil {
+		return compositor.Style{}, err
+	}
+	return compositor.Style{
+		FG:            fg,
+		BG:            bg,
+		Bold:          s.Bold,
+		Dim:           s.Dim,
+		Italic:        s.Italic,
+		Underline:     s.Underline,
+		Blink:         s.Blink,
+		Reverse:       s.Reverse,
+		Strikethrough: s.Strikethrough,
+	}, nil
+}
+
+func parseColor(value string) (compositor.Color, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return compositor.ColorDefault, nil
+	}
+	if c, ok := namedColors[value]; ok {
+		return c, nil
+	}
+	if hex, ok := strings.CutPrefix(value, "#"); ok {
+		if len(hex) != 6 {
+			return compositor.Color{}, fmt.Errorf("invalid color %q", value)
+		}
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return compositor.Color{}, fmt.Errorf("invalid color %q", value)
+		}
+		return compositor.Hex(uint32(n)), nil
+	}
+	n, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return compositor.Color{}, fmt.Errorf("invalid color %q", value)
+	}
+	return compositor.Color256(uint8(n)), nil
+}
+
+// WatchFile loads a JSON theme from path, makes it active, and reloads it
+// whenever the file changes. Reloads are applied on app's event loop
+// through its state scheduler, and each change posts a
+// runtime.ThemeChangedMsg so role-based widget styles follow it; with a nil
+// app they call Set from the polling goroutine. Files that fail to parse
+// after the first load are ignored until they are fixed. Call stop to end
+// the watch.
+func WatchFile(path string, app *runtime.App) (stop func(), err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t, err := ParseJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	Set(t)
+	app.Post(runtime.ThemeChangedMsg{})
+
+	apply := Set
+	if scheduler := app.StateScheduler(); scheduler != nil {
+		apply = func(t *Theme) {
+			scheduler.Schedule(func() {
+				Set(t)
+				app.Post(runtime.ThemeChangedMsg{})
+			})
+		}
+	}
+
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		ticker := time.NewTicker(watchInterval)
+		defer ticker.Stop()
+		last := data
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			next, err := os.ReadFile(path)
+			if err != nil || bytes.Equal(next, last) {
+				continue
+			}
+			last = next
+			t, err := ParseJSON(next)
+			if err != nil {
+				continue
+			}
+			apply(t)
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			<-finished
+		})
+	}, nil
+}
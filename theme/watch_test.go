@@ -0,0 +1,106 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/compositor"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/state"
+)
+
+func TestParseJSON(t *testing.T) {
+	th, err := ParseJSON([]byte(`{"Accent": {"fg": "#102030", "bold": true}, "Border": {"fg": "bright-red", "bg": "236"}}`))
+	if err != nil {
+		t.Fatalf("ParseJSON: %v", err)
+	}
+	if th.Accent.FG != compositor.RGB(0x10, 0x20, 0x30) || !th.Accent.Bold {
+		t.Fatalf("Accent = %+v", th.Accent)
+	}
+	if th.Border.FG != compositor.ColorBrightRed || th.Border.BG != compositor.Color256(236) {
+		t.Fatalf("Border = %+v", th.Border)
+	}
+	if th.Surface != DefaultTheme().Surface {
+		t.Fatal("unset tokens should keep default values")
+	}
+
+	if _, err := ParseJSON([]byte(`{"NotAToken": {}}`)); err == nil {
+		t.Fatal("expected error for unknown token")
+	}
+	if _, err := ParseJSON([]byte(`{"Accent": {"fg": "#12"}}`)); err == nil {
+		t.Fatal("expected error for invalid color")
+	}
+}
+
+func TestWatchFile_ReloadsOnChange(t *testing.T) {
+	defer Set(nil)
+	path := filepath.Join(t.TempDir(), "theme.json")
+	if err := os.WriteFile(path, []byte(`{"Accent": {"fg": "#ff0000"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := make(chan struct{}, 4)
+	unsub := ActiveSignal().Subscribe(func() { changed <- struct{}{} })
+	defer unsub()
+
+	stop, err := WatchFile(path, nil)
+	if err != nil {
+		t.Fatalf("WatchFile: %v", err)
+	}
+	defer stop()
+	if got := Active().Accent.FG; got != compositor.Hex(0xff0000) {
+		t.Fatalf("initial Accent FG = %+v, want #ff0000", got)
+	}
+	<-changed
+
+	if err := os.WriteFile(path, []byte(`{"Accent": {"fg": "#00ff00"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-changed:
+	case <-time.After(200 * time.Millisecond):
+		t.Fatal("theme signal did not update within 200ms")
+	}
+	if got := Active().Accent.FG; got != compositor.Hex(0x00ff00) {
+		t.Fatalf("reloaded Accent FG = %+v, want #00ff00", got)
+	}
+}
+
+func TestWatchFile_QueuesReloadsForApp(t *testing.T) {
+	defer Set(nil)
+	path := filepath.Join(t.TempDir(), "theme.json")
+	if err := os.WriteFile(path, []byte(`{"Accent": {"fg": "#ff0000"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	queue := state.NewQueue()
+	app := runtime.NewApp(runtime.AppConfig{Backend: sim.New(10, 2), StateQueue: queue})
+
+	stop, err := WatchFile(path, app)
+	if err != nil {
+		t.Fatalf("WatchFile: %v", err)
+	}
+	defer stop()
+	if err := os.WriteFile(path, []byte(`{"Accent": {"fg": "#00ff00"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if got := Active().Accent.FG; got != compositor.Hex(0xff0000) {
+			t.Fatalf("Accent FG = %+v before the queue flushed, want #ff0000", got)
+		}
+		if queue.Flush() > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("reload was not queued")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := Active().Accent.FG; got != compositor.Hex(0x00ff00) {
+		t.Fatalf("reloaded Accent FG = %+v, want #00ff00", got)
+	}
+}
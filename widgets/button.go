@@ -8,6 +8,7 @@ import (
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/state"
 	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/theme"
 )
 
 // Variant controls button styling.
@@ -29,9 +30,12 @@ type Button struct {
 	disabled *state.Signal[bool]
 	loading  *state.Signal[bool]
 	onClick  func()
+	tooltip  string
 
 	style       backend.Style
 	focusStyle  backend.Style
+	styleRole   themeRole
+	focusRole   themeRole
 	disabledSty backend.Style
 }
 
@@ -93,6 +97,23 @@ func WithOnClick(fn func()) ButtonOption {
 	}
 }
 
+// SetTooltip sets the tooltip text and returns for chaining.
+func (b *Button) SetTooltip(text string) *Button {
+	if b == nil {
+		return nil
+	}
+	b.tooltip = text
+	return b
+}
+
+// Tooltip returns the tooltip text.
+func (b *Button) Tooltip() string {
+	if b == nil {
+		return ""
+	}
+	return b.tooltip
+}
+
 // SetLabel updates the button label.
 func (b *Button) SetLabel(label string) {
 	if b == nil || b.label == nil {
@@ -110,6 +131,14 @@ func (b *Button) SetStyle(style backend.Style) {
 	b.style = style
 }
 
+// SetRole sets the button style to the active theme's token for role.
+func (b *Button) SetRole(role theme.Role) {
+	if b == nil {
+		return
+	}
+	b.SetStyle(b.styleRole.set(role))
+}
+
 // SetFocusStyle updates the focus style.
 func (b *Button) SetFocusStyle(style backend.Style) {
 	if b == nil {
@@ -118,6 +147,28 @@ func (b *Button) SetFocusStyle(style backend.Style) {
 	b.focusStyle = style
 }
 
+// SetFocusRole sets the focus style to the active theme's token for role.
+func (b *Button) SetFocusRole(role theme.Role) {
+	if b == nil {
+		return
+	}
+	b.SetFocusStyle(b.focusRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: styles set by role follow the
+// new active theme.
+func (b *Button) ApplyTheme() {
+	if b == nil {
+		return
+	}
+	if style, ok := b.styleRole.refresh(b.style); ok {
+		b.SetStyle(style)
+	}
+	if style, ok := b.focusRole.refresh(b.focusStyle); ok {
+		b.SetFocusStyle(style)
+	}
+}
+
 // Measure returns the size needed by the button.
 func (b *Button) Measure(constraints runtime.Constraints) runtime.Size {
 	label := ""
@@ -0,0 +1,89 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// Canvas is a freeform drawing surface. Its Draw callback runs on every
+// render with a buffer clipped to the canvas bounds.
+type Canvas struct {
+	Base
+	draw       func(buf *runtime.Buffer, bounds runtime.Rect)
+	background backend.Style
+	fill       bool
+	services   runtime.Services
+}
+
+// NewCanvas creates a canvas with the given draw callback.
+func NewCanvas(draw func(buf *runtime.Buffer, bounds runtime.Rect)) *Canvas {
+	return &Canvas{draw: draw}
+}
+
+// Draw sets the callback invoked on every render. Writes outside bounds are
+// discarded.
+func (c *Canvas) Draw(fn func(buf *runtime.Buffer, bounds runtime.Rect)) {
+	if c == nil {
+		return
+	}
+	c.draw = fn
+	c.Invalidate()
+}
+
+// SetBackground fills the canvas with style before each Draw.
+func (c *Canvas) SetBackground(style backend.Style) {
+	if c == nil {
+		return
+	}
+	c.background = style
+	c.fill = true
+	c.Invalidate()
+}
+
+// Bind attaches app services.
+func (c *Canvas) Bind(services runtime.Services) {
+	c.services = services
+}
+
+// Unbind releases app services.
+func (c *Canvas) Unbind() {
+	c.services = runtime.Services{}
+}
+
+// Invalidate marks the canvas for redraw and requests a render pass.
+func (c *Canvas) Invalidate() {
+	if c == nil {
+		return
+	}
+	c.Base.Invalidate()
+	c.services.Invalidate()
+}
+
+// Measure fills the available space.
+func (c *Canvas) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Render fills the background and calls the draw callback.
+func (c *Canvas) Render(ctx runtime.RenderContext) {
+	if c == nil || ctx.Buffer == nil {
+		return
+	}
+	bounds := c.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	buf := ctx.Buffer.Clip(bounds)
+	if c.fill {
+		buf.Fill(bounds, ' ', c.background)
+	}
+	if c.draw != nil {
+		c.draw(buf, bounds)
+	}
+	c.ClearInvalidation()
+}
+
+// ChildWidgets returns nil; a canvas has no children.
+func (c *Canvas) ChildWidgets() []runtime.Widget {
+	return nil
+}
@@ -0,0 +1,79 @@
+package widgets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+func TestCanvas_DrawOncePerRenderAndClipped(t *testing.T) {
+	calls := 0
+	canvas := NewCanvas(func(buf *runtime.Buffer, bounds runtime.Rect) {
+		calls++
+		buf.Set(bounds.X-1, bounds.Y, 'L', backend.DefaultStyle())
+		buf.Set(bounds.X, bounds.Y, '*', backend.DefaultStyle())
+		buf.Set(bounds.X+bounds.Width, bounds.Y, 'R', backend.DefaultStyle())
+	})
+	canvas.SetBackground(backend.DefaultStyle())
+
+	buf := runtime.NewBuffer(6, 1)
+	buf.SetString(0, 0, "abcdef", backend.DefaultStyle())
+	canvas.Layout(runtime.Rect{X: 2, Y: 0, Width: 2, Height: 1})
+	canvas.Render(runtime.RenderContext{Buffer: buf})
+	canvas.Render(runtime.RenderContext{Buffer: buf})
+
+	if calls != 2 {
+		t.Fatalf("Draw calls = %d, want 2", calls)
+	}
+	got := ""
+	for x := 0; x < 6; x++ {
+		got += string(buf.Get(x, 0).Rune)
+	}
+	if got != "ab* ef" {
+		t.Fatalf("buffer = %q, want %q", got, "ab* ef")
+	}
+	if canvas.ChildWidgets() != nil {
+		t.Fatal("ChildWidgets should be nil")
+	}
+}
+
+func TestCanvas_InvalidateTriggersRender(t *testing.T) {
+	drawn := make(chan int, 8)
+	frames := 0
+	var canvas *Canvas
+	canvas = NewCanvas(func(buf *runtime.Buffer, bounds runtime.Rect) {
+		frames++
+		if frames == 1 {
+			// Widgets are not goroutine-safe, so invalidate from the loop.
+			canvas.Invalidate()
+		}
+		drawn <- frames
+	})
+	app := runtime.NewApp(runtime.AppConfig{Backend: sim.New(10, 3), Root: canvas})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+
+	// The first frame is rendered once the loop processes a message.
+	app.Post(runtime.InvalidateMsg{})
+	for want := 1; want <= 2; want++ {
+		select {
+		case got := <-drawn:
+			if got != want {
+				t.Fatalf("frame = %d, want %d", got, want)
+			}
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("frame %d was not drawn", want)
+		}
+	}
+
+	cancel()
+	<-done
+}
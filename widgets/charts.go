@@ -9,13 +9,21 @@ import (
 )
 
 // Sparkline renders a compact single-line chart.
+// It draws with block elements when the terminal supports Unicode and falls
+// back to an ASCII ramp otherwise.
 type Sparkline struct {
 	Base
-	Data  *state.Signal[[]float64]
-	Width int
-	Style backend.Style
+	Data     *state.Signal[[]float64]
+	Width    int
+	Style    backend.Style
+	services runtime.Services
 }
 
+var (
+	sparklineASCII   = []rune{' ', '.', ':', '-', '=', '+', '*', '#', '@'}
+	sparklineUnicode = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+)
+
 // NewSparkline creates a sparkline.
 func NewSparkline(data *state.Signal[[]float64]) *Sparkline {
 	return &Sparkline{
@@ -24,6 +32,16 @@ func NewSparkline(data *state.Signal[[]float64]) *Sparkline {
 	}
 }
 
+// Bind attaches app services.
+func (s *Sparkline) Bind(services runtime.Services) {
+	s.services = services
+}
+
+// Unbind releases app services.
+func (s *Sparkline) Unbind() {
+	s.services = runtime.Services{}
+}
+
 // Measure returns desired size.
 func (s *Sparkline) Measure(constraints runtime.Constraints) runtime.Size {
 	width := s.Width
@@ -49,7 +67,10 @@ func (s *Sparkline) Render(ctx runtime.RenderContext) {
 	if len(values) == 0 {
 		return
 	}
-	chars := []rune{' ', '.', ':', '-', '=', '+', '*', '#', '@'}
+	chars := sparklineASCII
+	if s.services.Capabilities().Unicode {
+		chars = sparklineUnicode
+	}
 	min, max := values[0], values[0]
 	for _, v := range values {
 		if v < min {
@@ -0,0 +1,285 @@
+package widgets
+
+import (
+	"github.com/mattn/go-runewidth"
+
+	"github.com/odvcencio/fluffy-ui/keybind"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// ContextMenu is a popup menu shown in a modal layer at a screen position.
+// It closes on Escape, on a click outside it, or when an item is chosen.
+type ContextMenu struct {
+	Base
+	menu      *Menu
+	anchorX   int
+	anchorY   int
+	open      bool
+	onClose   func()
+	onCommand func(id string)
+	// onKey lets an owner such as Menubar handle keys first.
+	onKey func(msg runtime.KeyMsg) (runtime.HandleResult, bool)
+}
+
+// NewContextMenu creates a context menu.
+func NewContextMenu(items ...*MenuItem) *ContextMenu {
+	return &ContextMenu{menu: NewMenu(items...)}
+}
+
+// Menu returns the menu drawn inside the popup.
+func (c *ContextMenu) Menu() *Menu {
+	if c == nil {
+		return nil
+	}
+	return c.menu
+}
+
+// OnClose registers a handler called when the menu closes.
+func (c *ContextMenu) OnClose(fn func()) {
+	if c == nil {
+		return
+	}
+	c.onClose = fn
+}
+
+// OnCommand registers a handler called with the ID of each chosen item.
+func (c *ContextMenu) OnCommand(fn func(id string)) {
+	if c == nil {
+		return
+	}
+	c.onCommand = fn
+}
+
+// IsOpen reports whether the menu is showing.
+func (c *ContextMenu) IsOpen() bool {
+	return c != nil && c.open
+}
+
+// ShowAt returns the command that opens the menu with its top-left corner at
+// (x, y). Return it from HandleMessage, e.g. runtime.WithCommand(menu.ShowAt(x, y)).
+func (c *ContextMenu) ShowAt(x, y int) runtime.Command {
+	if c == nil {
+		return nil
+	}
+	c.prepare(x, y)
+	return runtime.PushOverlay{Widget: c, Modal: true}
+}
+
+// prepare resets the selection and records the anchor.
+func (c *ContextMenu) prepare(x, y int) {
+	c.anchorX, c.anchorY = x, y
+	c.open = true
+	c.menu.ScrollToStart()
+	c.menu.Focus()
+}
+
+// close hides the menu and returns the command that pops its layer.
+func (c *ContextMenu) close() runtime.HandleResult {
+	if !c.open {
+		return runtime.Handled()
+	}
+	c.open = false
+	c.menu.Blur()
+	if c.onClose != nil {
+		c.onClose()
+	}
+	return runtime.WithCommand(runtime.PopOverlay{})
+}
+
+// Measure fills the screen; only the menu area is drawn.
+func (c *ContextMenu) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Layout records the screen bounds and places the menu.
+func (c *ContextMenu) Layout(bounds runtime.Rect) {
+	c.Base.Layout(bounds)
+	c.place()
+}
+
+// place sizes the menu to its longest item and keeps it on screen, flipping
+// it above the anchor when there is not enough room below.
+func (c *ContextMenu) place() {
+	screen := c.bounds
+	rows := len(c.menu.flatten())
+	width := min(c.naturalWidth(), screen.Width)
+	height := min(rows, screen.Height)
+	x := min(c.anchorX, screen.X+screen.Width-width)
+	y := c.anchorY
+	if y+height > screen.Y+screen.Height {
+		y = c.anchorY - height + 1
+	}
+	y = min(y, screen.Y+screen.Height-height)
+	c.menu.Layout(runtime.Rect{X: max(x, screen.X), Y: max(y, screen.Y), Width: width, Height: height})
+}
+
+// naturalWidth returns the width of the widest item, including submenu
+// indentation and shortcut labels.
+func (c *ContextMenu) naturalWidth() int {
+	width := 0
+	var walk func(items []*MenuItem, depth int)
+	walk = func(items []*MenuItem, depth int) {
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+			w := 2*depth + 2 + runewidth.StringWidth(item.Title) + 1
+			if shortcut := c.menu.shortcutLabel(item); shortcut != "" {
+				w += runewidth.StringWidth(shortcut) + 3
+			}
+			width = max(width, w)
+			walk(item.Children, depth+1)
+		}
+	}
+	walk(c.menu.Items, 0)
+	return width
+}
+
+// Render draws the menu.
+func (c *ContextMenu) Render(ctx runtime.RenderContext) {
+	if c == nil || c.menu.bounds.Width <= 0 || c.menu.bounds.Height <= 0 {
+		return
+	}
+	c.menu.Render(ctx)
+}
+
+// HandleMessage navigates the menu and closes it on Escape, an outside
+// click, or a chosen item.
+func (c *ContextMenu) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if c == nil || !c.open {
+		return runtime.Unhandled()
+	}
+	switch msg := msg.(type) {
+	case runtime.MouseMsg:
+		if msg.Action != runtime.MousePress {
+			return runtime.Handled()
+		}
+		area := c.menu.bounds
+		if !area.Contains(msg.X, msg.Y) {
+			return c.close()
+		}
+		row := c.menu.offset + msg.Y - area.Y
+		c.menu.setSelected(row, len(c.menu.flatten()))
+		c.menu.Invalidate()
+		if msg.Button == runtime.MouseLeft {
+			return c.choose()
+		}
+		return runtime.Handled()
+	case runtime.KeyMsg:
+		if c.onKey != nil {
+			if result, ok := c.onKey(msg); ok {
+				return result
+			}
+		}
+		if item := c.menu.shortcutItem(keybind.KeyPressFromKeyMsg(msg)); item != nil {
+			return c.activate(item)
+		}
+		switch msg.Key {
+		case terminal.KeyEscape:
+			return c.close()
+		case terminal.KeyEnter:
+			return c.choose()
+		}
+		c.menu.HandleMessage(msg)
+		c.place()
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// choose activates the selected item, or toggles it when it has children.
+func (c *ContextMenu) choose() runtime.HandleResult {
+	row := c.menu.selectedRow(c.menu.flatten())
+	if row == nil || row.item.Disabled {
+		return runtime.Handled()
+	}
+	if len(row.item.Children) > 0 {
+		row.item.Expanded = !row.item.Expanded
+		c.menu.flatDirty = true
+		c.place()
+		return runtime.Handled()
+	}
+	return c.activate(row.item)
+}
+
+// activate closes the menu and calls the item's OnSelect and the
+// OnCommand handler.
+func (c *ContextMenu) activate(item *MenuItem) runtime.HandleResult {
+	result := c.close()
+	if item.OnSelect != nil {
+		item.OnSelect()
+	}
+	if c.onCommand != nil {
+		c.onCommand(item.ID)
+	}
+	return result
+}
+
+// ContextMenuTrigger is a runtime.KeyHandler that opens context menus on a
+// right-click or a key press. Set it as AppConfig.KeyHandler.
+type ContextMenuTrigger struct {
+	// Build returns the menu for target, the widget under the pointer or the
+	// focused widget, or nil for no menu. X and Y are where it will open.
+	Build func(target runtime.Widget, x, y int) *ContextMenu
+	// Key opens a menu for the focused widget (default Shift+F10).
+	Key keybind.KeyPress
+	// Next handles keys the trigger does not use.
+	Next runtime.KeyHandler
+}
+
+// NewContextMenuTrigger creates a trigger that falls back to next for keys.
+func NewContextMenuTrigger(build func(target runtime.Widget, x, y int) *ContextMenu, next runtime.KeyHandler) *ContextMenuTrigger {
+	return &ContextMenuTrigger{
+		Build: build,
+		Key:   keybind.KeyPress{Key: terminal.KeyF10, Shift: true},
+		Next:  next,
+	}
+}
+
+// HandleKey opens a menu at the focused widget's top-left corner when Key is
+// pressed.
+func (t *ContextMenuTrigger) HandleKey(app *runtime.App, msg runtime.KeyMsg, focused runtime.Widget) bool {
+	if t == nil {
+		return false
+	}
+	if t.Build != nil && focused != nil && keybind.KeyPressFromKeyMsg(msg).Equal(t.Key) {
+		x, y := 0, 0
+		if bounded, ok := focused.(runtime.BoundsProvider); ok {
+			bounds := bounded.Bounds()
+			x, y = bounds.X, bounds.Y
+		}
+		if t.show(app, t.Build(focused, x, y), x, y) {
+			return true
+		}
+	}
+	if t.Next != nil {
+		return t.Next.HandleKey(app, msg, focused)
+	}
+	return false
+}
+
+// HandleMouse opens a menu at the pointer on a right-click.
+func (t *ContextMenuTrigger) HandleMouse(app *runtime.App, msg runtime.MouseMsg, target runtime.Widget) bool {
+	if t == nil || t.Build == nil || msg.Button != runtime.MouseRight || msg.Action != runtime.MousePress {
+		return false
+	}
+	if _, open := target.(*ContextMenu); open {
+		return false
+	}
+	return t.show(app, t.Build(target, msg.X, msg.Y), msg.X, msg.Y)
+}
+
+func (t *ContextMenuTrigger) show(app *runtime.App, menu *ContextMenu, x, y int) bool {
+	if menu == nil || app == nil || app.Screen() == nil {
+		return false
+	}
+	menu.prepare(x, y)
+	app.Screen().PushLayer(menu, true)
+	return true
+}
+
+var (
+	_ runtime.KeyHandler   = (*ContextMenuTrigger)(nil)
+	_ runtime.MouseHandler = (*ContextMenuTrigger)(nil)
+)
@@ -0,0 +1,70 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// contextMenuHost opens its menu on a right-click.
+type contextMenuHost struct {
+	Base
+	menu *ContextMenu
+}
+
+func (h *contextMenuHost) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+func (h *contextMenuHost) Render(runtime.RenderContext) {}
+
+func (h *contextMenuHost) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if mouse, ok := msg.(runtime.MouseMsg); ok && mouse.Button == runtime.MouseRight && mouse.Action == runtime.MousePress {
+		return runtime.WithCommand(h.menu.ShowAt(mouse.X, mouse.Y))
+	}
+	return runtime.Unhandled()
+}
+
+func rightClick(x, y int) runtime.MouseMsg {
+	return runtime.MouseMsg{X: x, Y: y, Button: runtime.MouseRight, Action: runtime.MousePress}
+}
+
+func TestContextMenu_PlacementAndClose(t *testing.T) {
+	selected := ""
+	menu := NewContextMenu(
+		&MenuItem{ID: "copy", Title: "Copy", OnSelect: func() { selected = "copy" }},
+		&MenuItem{ID: "paste", Title: "Paste special"},
+	)
+	screen := runtime.NewScreen(30, 10)
+	screen.SetRoot(&contextMenuHost{menu: menu})
+
+	screen.HandleMessage(rightClick(25, 2))
+	if screen.LayerCount() != 2 || !menu.IsOpen() {
+		t.Fatalf("layers = %d, want the menu pushed", screen.LayerCount())
+	}
+	// Widest label plus prefix and padding; shifted left to stay on screen.
+	if got := menu.Menu().Bounds(); got != (runtime.Rect{X: 14, Y: 2, Width: 16, Height: 2}) {
+		t.Fatalf("menu bounds = %+v", got)
+	}
+	screen.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if screen.LayerCount() != 1 || menu.IsOpen() {
+		t.Fatalf("layers = %d after Escape, want the menu closed", screen.LayerCount())
+	}
+
+	// Not enough room below: the menu flips up to end at the click row.
+	screen.HandleMessage(rightClick(3, 9))
+	if got := menu.Menu().Bounds(); got.Y != 8 {
+		t.Fatalf("menu bounds = %+v, want it flipped above row 9", got)
+	}
+	screen.HandleMessage(runtime.MouseMsg{X: 0, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if screen.LayerCount() != 1 {
+		t.Fatal("clicking outside should close the menu")
+	}
+
+	screen.HandleMessage(rightClick(0, 0))
+	screen.HandleMessage(runtime.MouseMsg{X: 2, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if selected != "copy" || screen.LayerCount() != 1 {
+		t.Fatalf("selected = %q, layers = %d, want Copy chosen and the menu closed", selected, screen.LayerCount())
+	}
+}
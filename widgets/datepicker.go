@@ -0,0 +1,284 @@
+package widgets
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// DateLocale controls how date and time pickers order weekdays and display
+// names.
+type DateLocale struct {
+	// FirstWeekday is the first column of the calendar grid.
+	FirstWeekday time.Weekday
+	// Months are the month names, January first.
+	Months [12]string
+	// Weekdays are two-column weekday abbreviations, Sunday first.
+	Weekdays [7]string
+	// Hour12 shows hours as 1-12 with an AM/PM column.
+	Hour12 bool
+}
+
+// Built-in locales.
+var (
+	LocaleEnUS = DateLocale{
+		FirstWeekday: time.Sunday,
+		Months:       [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		Weekdays:     [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+		Hour12:       true,
+	}
+	LocaleEnGB = DateLocale{
+		FirstWeekday: time.Monday,
+		Months:       LocaleEnUS.Months,
+		Weekdays:     LocaleEnUS.Weekdays,
+	}
+	LocaleDE = DateLocale{
+		FirstWeekday: time.Monday,
+		Months:       [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		Weekdays:     [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	}
+)
+
+// Calendar grid size: a month always fits in six weeks.
+const (
+	datePickerWeeks = 6
+	datePickerWidth = 7*3 - 1
+)
+
+// DatePicker is a month calendar for choosing a date. Arrow keys move the
+// cursor, PgUp/PgDn change month, Enter selects, and Escape cancels.
+type DatePicker struct {
+	FocusableBase
+
+	value    time.Time
+	cursor   time.Time
+	locale   DateLocale
+	onSelect func(value time.Time)
+	onCancel func()
+
+	style         backend.Style
+	cursorStyle   backend.Style
+	selectedStyle backend.Style
+	outsideStyle  backend.Style
+}
+
+// NewDatePicker creates a date picker showing initial's month. A zero
+// initial uses today.
+func NewDatePicker(initial time.Time) *DatePicker {
+	if initial.IsZero() {
+		initial = time.Now()
+	}
+	return &DatePicker{
+		value:         initial,
+		cursor:        initial,
+		locale:        LocaleEnUS,
+		style:         backend.DefaultStyle(),
+		cursorStyle:   backend.DefaultStyle().Reverse(true),
+		selectedStyle: backend.DefaultStyle().Bold(true).Underline(true),
+		outsideStyle:  backend.DefaultStyle().Dim(true),
+	}
+}
+
+// SetLocale sets the weekday order and month names.
+func (d *DatePicker) SetLocale(locale DateLocale) {
+	if d == nil {
+		return
+	}
+	d.locale = locale
+	d.Invalidate()
+}
+
+// Value returns the selected date.
+func (d *DatePicker) Value() time.Time {
+	if d == nil {
+		return time.Time{}
+	}
+	return d.value
+}
+
+// SetValue selects a date and moves the cursor to it.
+func (d *DatePicker) SetValue(value time.Time) {
+	if d == nil {
+		return
+	}
+	d.value = value
+	d.cursor = value
+	d.Invalidate()
+}
+
+// Cursor returns the highlighted date.
+func (d *DatePicker) Cursor() time.Time {
+	if d == nil {
+		return time.Time{}
+	}
+	return d.cursor
+}
+
+// Text returns the selected date in RFC 3339 format, as submitted by Form.
+func (d *DatePicker) Text() string {
+	if d == nil {
+		return ""
+	}
+	return d.value.Format(time.RFC3339)
+}
+
+// OnSelect registers a handler called when a date is selected.
+func (d *DatePicker) OnSelect(fn func(value time.Time)) {
+	if d == nil {
+		return
+	}
+	d.onSelect = fn
+}
+
+// OnCancel registers a handler called when Escape discards the cursor.
+func (d *DatePicker) OnCancel(fn func()) {
+	if d == nil {
+		return
+	}
+	d.onCancel = fn
+}
+
+// Measure returns the calendar size: a header, a weekday row, and six weeks.
+func (d *DatePicker) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.Constrain(runtime.Size{Width: datePickerWidth, Height: datePickerWeeks + 2})
+}
+
+// Render draws the month header, weekday row, and day grid.
+func (d *DatePicker) Render(ctx runtime.RenderContext) {
+	if d == nil {
+		return
+	}
+	bounds := d.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	ctx.Buffer.Fill(bounds, ' ', d.style)
+	header := d.locale.Months[d.cursor.Month()-1] + " " + strconv.Itoa(d.cursor.Year())
+	x := bounds.X + max(0, (min(bounds.Width, datePickerWidth)-runewidth.StringWidth(header))/2)
+	ctx.Buffer.SetString(x, bounds.Y, truncateString(header, bounds.Width), d.style.Bold(true))
+	if bounds.Height < 2 {
+		return
+	}
+	for col := range 7 {
+		day := (int(d.locale.FirstWeekday) + col) % 7
+		d.setCell(ctx, col, 1, d.locale.Weekdays[day], d.style.Dim(true))
+	}
+	start := d.gridStart()
+	for i := range datePickerWeeks * 7 {
+		row := 2 + i/7
+		if row >= bounds.Height {
+			break
+		}
+		day := start.AddDate(0, 0, i)
+		style := d.style
+		switch {
+		case sameDay(day, d.cursor) && d.focused:
+			style = d.cursorStyle
+		case sameDay(day, d.value):
+			style = d.selectedStyle
+		case day.Month() != d.cursor.Month():
+			style = d.outsideStyle
+		}
+		label := strconv.Itoa(day.Day())
+		if day.Day() < 10 {
+			label = " " + label
+		}
+		d.setCell(ctx, i%7, row, label, style)
+	}
+}
+
+// setCell writes a two-column cell of the grid.
+func (d *DatePicker) setCell(ctx runtime.RenderContext, col, row int, text string, style backend.Style) {
+	x := col * 3
+	if x >= d.bounds.Width {
+		return
+	}
+	ctx.Buffer.SetString(d.bounds.X+x, d.bounds.Y+row, truncateString(text, d.bounds.Width-x), style)
+}
+
+// gridStart returns the first date shown, on the locale's first weekday.
+func (d *DatePicker) gridStart() time.Time {
+	first := time.Date(d.cursor.Year(), d.cursor.Month(), 1, d.cursor.Hour(), d.cursor.Minute(), d.cursor.Second(), d.cursor.Nanosecond(), d.cursor.Location())
+	offset := (int(first.Weekday()) - int(d.locale.FirstWeekday) + 7) % 7
+	return first.AddDate(0, 0, -offset)
+}
+
+// HandleMessage navigates and selects dates.
+func (d *DatePicker) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if d == nil || !d.focused {
+		return runtime.Unhandled()
+	}
+	switch msg := msg.(type) {
+	case runtime.KeyMsg:
+		switch msg.Key {
+		case terminal.KeyLeft:
+			d.moveCursor(d.cursor.AddDate(0, 0, -1))
+		case terminal.KeyRight:
+			d.moveCursor(d.cursor.AddDate(0, 0, 1))
+		case terminal.KeyUp:
+			d.moveCursor(d.cursor.AddDate(0, 0, -7))
+		case terminal.KeyDown:
+			d.moveCursor(d.cursor.AddDate(0, 0, 7))
+		case terminal.KeyPageUp:
+			d.moveCursor(addMonths(d.cursor, -1))
+		case terminal.KeyPageDown:
+			d.moveCursor(addMonths(d.cursor, 1))
+		case terminal.KeyEnter:
+			return d.selectCursor()
+		case terminal.KeyEscape:
+			d.cursor = d.value
+			d.Invalidate()
+			if d.onCancel != nil {
+				d.onCancel()
+			}
+			return runtime.WithCommand(runtime.Cancel{})
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	case runtime.MouseMsg:
+		if msg.Action != runtime.MousePress || msg.Button != runtime.MouseLeft {
+			return runtime.Unhandled()
+		}
+		row, col := msg.Y-d.bounds.Y-2, (msg.X-d.bounds.X)/3
+		if row < 0 || row >= datePickerWeeks || col < 0 || col >= 7 || msg.X < d.bounds.X {
+			return runtime.Unhandled()
+		}
+		d.cursor = d.gridStart().AddDate(0, 0, row*7+col)
+		return d.selectCursor()
+	}
+	return runtime.Unhandled()
+}
+
+func (d *DatePicker) moveCursor(next time.Time) {
+	d.cursor = next
+	d.Invalidate()
+}
+
+// selectCursor selects the highlighted date and submits it like Input.
+func (d *DatePicker) selectCursor() runtime.HandleResult {
+	d.value = d.cursor
+	d.Invalidate()
+	if d.onSelect != nil {
+		d.onSelect(d.value)
+	}
+	return runtime.WithCommand(runtime.Submit{Text: d.Text()})
+}
+
+// addMonths moves t by n months, keeping the day within the target month.
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), last)-1)
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,73 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func TestDatePicker_NavigateAndSelect(t *testing.T) {
+	initial := time.Date(2026, time.February, 27, 9, 30, 0, 0, time.UTC)
+	picker := NewDatePicker(initial)
+	picker.SetLocale(LocaleEnGB)
+	picker.Focus()
+
+	out := renderToString(picker, 20, 8)
+	lines := strings.Split(out, "\n")
+	if strings.TrimSpace(lines[0]) != "February 2026" || lines[1] != "Mo Tu We Th Fr Sa Su" {
+		t.Fatalf("header = %q", lines[:2])
+	}
+	// February 2026 starts on a Sunday, the last column of a Monday-first grid.
+	if lines[2] != "26 27 28 29 30 31  1" {
+		t.Fatalf("first week = %q", lines[2])
+	}
+
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if got := picker.Cursor(); got.Month() != time.March || got.Day() != 1 {
+		t.Fatalf("cursor = %v, want it to cross into March", got)
+	}
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if !picker.Cursor().Equal(initial) {
+		t.Fatal("Escape should return the cursor to the selection")
+	}
+
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageDown})
+	result := picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	want := time.Date(2026, time.March, 27, 9, 30, 0, 0, time.UTC)
+	if !picker.Value().Equal(want) || picker.Text() != "2026-03-27T09:30:00Z" || !hasCommand[runtime.Submit](result) {
+		t.Fatalf("value = %v, text = %q, commands = %v", picker.Value(), picker.Text(), result.Commands)
+	}
+
+	form := NewForm().AddField("When", picker, nil)
+	if values, _ := form.Submit(); values["When"] != "2026-03-27T09:30:00Z" {
+		t.Fatalf("form values = %v, want RFC 3339", values)
+	}
+}
+
+func TestTimePicker_Columns(t *testing.T) {
+	picker := NewTimePicker(time.Date(2026, time.May, 1, 23, 59, 5, 0, time.UTC))
+	picker.SetLocale(LocaleEnGB)
+	picker.Focus()
+	if out := renderToString(picker, 8, 1); out != "23:59:05\n" {
+		t.Fatalf("render = %q", out)
+	}
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyTab})
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if got := picker.Value(); got.Hour() != 0 || got.Minute() != 0 || got.Day() != 1 {
+		t.Fatalf("value = %v, want columns to wrap without carrying", got)
+	}
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyTab})
+	if result := picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyTab}); !hasCommand[runtime.FocusNext](result) {
+		t.Fatal("Tab on the last column should move focus on")
+	}
+
+	picker.SetLocale(LocaleEnUS)
+	if out := renderToString(picker, 11, 1); out != "12:00:05 AM\n" {
+		t.Fatalf("12-hour render = %q", out)
+	}
+}
@@ -0,0 +1,97 @@
+package widgets
+
+// defaultUndoLimit is the number of undo steps kept when no limit is set.
+const defaultUndoLimit = 100
+
+// editSnapshot is the text and cursor offset of an editor at one point in
+// its history.
+type editSnapshot struct {
+	text   string
+	cursor int
+}
+
+// editHistory is a bounded undo/redo stack of editor snapshots. Adjacent
+// typed characters are batched into a single step.
+type editHistory struct {
+	undo  []editSnapshot
+	redo  []editSnapshot
+	limit int
+
+	// typing is set while typed characters extend the last step; typingEnd
+	// is the cursor offset after the last typed character, in the editor's
+	// own units, where the next one must start.
+	typing    bool
+	typingEnd int
+}
+
+// record pushes the state before an edit; cursor is the offset after it.
+// Typed characters that continue the previous typed character join its step.
+func (h *editHistory) record(before editSnapshot, cursor int, typing bool) {
+	if typing && h.typing && before.cursor == h.typingEnd {
+		h.typingEnd = cursor
+		return
+	}
+	h.undo = h.push(h.undo, before)
+	h.redo = nil
+	h.typing = typing
+	h.typingEnd = cursor
+}
+
+// seal ends the current typing batch.
+func (h *editHistory) seal() {
+	h.typing = false
+}
+
+// undoTo pops the last step, saving current for redo.
+func (h *editHistory) undoTo(current editSnapshot) (editSnapshot, bool) {
+	h.typing = false
+	if len(h.undo) == 0 {
+		return editSnapshot{}, false
+	}
+	prev := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, current)
+	return prev, true
+}
+
+// redoTo pops the last undone step, saving current for undo.
+func (h *editHistory) redoTo(current editSnapshot) (editSnapshot, bool) {
+	h.typing = false
+	if len(h.redo) == 0 {
+		return editSnapshot{}, false
+	}
+	next := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = h.push(h.undo, current)
+	return next, true
+}
+
+// reset drops all history.
+func (h *editHistory) reset() {
+	h.undo = nil
+	h.redo = nil
+	h.typing = false
+}
+
+// setLimit caps the number of undo steps; n <= 0 restores the default.
+func (h *editHistory) setLimit(n int) {
+	h.limit = n
+	if over := len(h.undo) - h.max(); over > 0 {
+		h.undo = append(h.undo[:0], h.undo[over:]...)
+	}
+}
+
+func (h *editHistory) max() int {
+	if h.limit <= 0 {
+		return defaultUndoLimit
+	}
+	return h.limit
+}
+
+func (h *editHistory) push(stack []editSnapshot, snap editSnapshot) []editSnapshot {
+	stack = append(stack, snap)
+	if over := len(stack) - h.max(); over > 0 {
+		stack = append(stack[:0], stack[over:]...)
+	}
+	return stack
+}
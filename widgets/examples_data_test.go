@@ -8,7 +8,7 @@ import (
 
 func ExampleList() {
 	items := []string{"alpha", "beta", "gamma"}
-	adapter := widgets.NewSliceAdapter(items, func(item string, index int, selected bool, ctx runtime.RenderContext) {
+	adapter := widgets.NewSliceAdapter(items, func(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
 		prefix := "  "
 		if selected {
 			prefix = "> "
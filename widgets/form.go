@@ -0,0 +1,238 @@
+package widgets
+
+import (
+	"strconv"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/forms"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// FormField is a labelled form input with an optional validator.
+type FormField struct {
+	Label     string
+	Widget    runtime.Focusable
+	Validator func(value string) error
+
+	err    error
+	bounds runtime.Rect
+}
+
+// Form lays out labelled fields in two columns above a submit button and
+// shows validation errors beneath the offending fields.
+type Form struct {
+	Base
+	fields     []*FormField
+	submit     *Button
+	onSubmit   func(values map[string]string)
+	labelStyle backend.Style
+	errorStyle backend.Style
+}
+
+// NewForm creates an empty form.
+func NewForm() *Form {
+	f := &Form{
+		labelStyle: backend.DefaultStyle(),
+		errorStyle: backend.DefaultStyle().Foreground(backend.ColorRed),
+	}
+	f.submit = NewButton("Submit", WithVariant(VariantPrimary), WithOnClick(func() {
+		f.trySubmit()
+	}))
+	return f
+}
+
+// AddField appends a field and returns the form for chaining. The validator
+// may be nil.
+func (f *Form) AddField(label string, widget runtime.Focusable, validator func(string) error) *Form {
+	if f == nil || widget == nil {
+		return f
+	}
+	f.fields = append(f.fields, &FormField{Label: label, Widget: widget, Validator: validator})
+	f.Invalidate()
+	return f
+}
+
+// Fields returns the form fields.
+func (f *Form) Fields() []*FormField {
+	if f == nil {
+		return nil
+	}
+	return f.fields
+}
+
+// SubmitButton returns the button that submits the form.
+func (f *Form) SubmitButton() *Button {
+	if f == nil {
+		return nil
+	}
+	return f.submit
+}
+
+// OnSubmit registers a handler called with the field values when the submit
+// button is pressed and every field validates.
+func (f *Form) OnSubmit(fn func(values map[string]string)) {
+	if f == nil {
+		return
+	}
+	f.onSubmit = fn
+}
+
+// Submit validates every field and returns the values keyed by label, or
+// nil values and the errors when any field fails.
+func (f *Form) Submit() (map[string]string, []forms.ValidationError) {
+	if f == nil {
+		return nil, nil
+	}
+	values := make(map[string]string, len(f.fields))
+	var errs []forms.ValidationError
+	for _, field := range f.fields {
+		value := formFieldValue(field.Widget)
+		values[field.Label] = value
+		field.err = nil
+		if field.Validator != nil {
+			field.err = field.Validator(value)
+		}
+		if field.err != nil {
+			errs = append(errs, forms.ValidationError{Field: field.Label, Message: field.err.Error()})
+		}
+	}
+	f.Layout(f.bounds)
+	f.Invalidate()
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return values, nil
+}
+
+func (f *Form) trySubmit() {
+	values, errs := f.Submit()
+	if len(errs) == 0 && f.onSubmit != nil {
+		f.onSubmit(values)
+	}
+}
+
+// formFieldValue reads a field widget's value as a string.
+func formFieldValue(widget runtime.Widget) string {
+	switch w := widget.(type) {
+	case *Checkbox:
+		checked := w.Checked()
+		return strconv.FormatBool(checked != nil && *checked)
+	case *Select:
+		if option, ok := w.SelectedOption(); ok {
+			return option.Label
+		}
+		return ""
+	case interface{ Text() string }:
+		return w.Text()
+	}
+	return ""
+}
+
+// labelWidth returns the width of the label column.
+func (f *Form) labelWidth() int {
+	width := 0
+	for _, field := range f.fields {
+		width = max(width, runewidth.StringWidth(field.Label))
+	}
+	if width > 0 {
+		width += 2
+	}
+	return width
+}
+
+// fieldHeight returns the rows a field's widget uses.
+func fieldHeight(field *FormField, width int) int {
+	size := field.Widget.Measure(runtime.Constraints{MaxWidth: width, MaxHeight: 1 << 16})
+	return max(size.Height, 1)
+}
+
+// Measure returns the height of every field, its error, and the button row.
+func (f *Form) Measure(constraints runtime.Constraints) runtime.Size {
+	if f == nil {
+		return constraints.MinSize()
+	}
+	labelWidth := f.labelWidth()
+	inputWidth := max(constraints.MaxWidth-labelWidth, 0)
+	height := 0
+	width := 0
+	for _, field := range f.fields {
+		height += fieldHeight(field, inputWidth)
+		if field.err != nil {
+			height++
+		}
+		size := field.Widget.Measure(runtime.Constraints{MaxWidth: inputWidth, MaxHeight: 1})
+		width = max(width, labelWidth+size.Width)
+	}
+	button := f.submit.Measure(runtime.Constraints{MaxWidth: constraints.MaxWidth, MaxHeight: 1})
+	height += 1 + button.Height
+	width = max(width, button.Width)
+	return constraints.Constrain(runtime.Size{Width: width, Height: height})
+}
+
+// Layout places each widget beside its label and the button below them.
+func (f *Form) Layout(bounds runtime.Rect) {
+	f.Base.Layout(bounds)
+	labelWidth := f.labelWidth()
+	inputWidth := max(bounds.Width-labelWidth, 0)
+	y := bounds.Y
+	for _, field := range f.fields {
+		height := fieldHeight(field, inputWidth)
+		field.bounds = runtime.Rect{X: bounds.X + labelWidth, Y: y, Width: inputWidth, Height: height}
+		field.Widget.Layout(field.bounds)
+		y += height
+		if field.err != nil {
+			y++
+		}
+	}
+	button := f.submit.Measure(runtime.Constraints{MaxWidth: bounds.Width, MaxHeight: 1})
+	f.submit.Layout(runtime.Rect{X: bounds.X + labelWidth, Y: y + 1, Width: min(button.Width, inputWidth), Height: button.Height})
+}
+
+// Render draws labels, fields, errors, and the submit button.
+func (f *Form) Render(ctx runtime.RenderContext) {
+	if f == nil {
+		return
+	}
+	bounds := f.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	labelWidth := f.labelWidth()
+	for _, field := range f.fields {
+		label := truncateString(field.Label, min(labelWidth, bounds.Width))
+		ctx.Buffer.SetString(bounds.X, field.bounds.Y, label, f.labelStyle)
+		field.Widget.Render(ctx)
+		if field.err != nil {
+			y := field.bounds.Y + field.bounds.Height
+			ctx.Buffer.SetString(field.bounds.X, y, truncateString(field.err.Error(), field.bounds.Width), f.errorStyle)
+		}
+	}
+	f.submit.Render(ctx)
+}
+
+// HandleMessage forwards messages to the fields and the submit button.
+func (f *Form) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if f == nil {
+		return runtime.Unhandled()
+	}
+	for _, child := range f.ChildWidgets() {
+		if result := child.HandleMessage(msg); result.Handled {
+			return result
+		}
+	}
+	return runtime.Unhandled()
+}
+
+// ChildWidgets returns the field widgets in tab order, then the button.
+func (f *Form) ChildWidgets() []runtime.Widget {
+	if f == nil {
+		return nil
+	}
+	children := make([]runtime.Widget, 0, len(f.fields)+1)
+	for _, field := range f.fields {
+		children = append(children, field.Widget)
+	}
+	return append(children, f.submit)
+}
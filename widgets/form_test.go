@@ -0,0 +1,61 @@
+package widgets
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func TestForm_ValidateAndSubmit(t *testing.T) {
+	required := func(value string) error {
+		if strings.TrimSpace(value) == "" {
+			return errors.New("required")
+		}
+		return nil
+	}
+	name := NewInput()
+	email := NewInput()
+	form := NewForm().
+		AddField("Name", name, required).
+		AddField("Email", email, nil)
+	var submitted map[string]string
+	form.OnSubmit(func(values map[string]string) {
+		submitted = values
+	})
+
+	screen := runtime.NewScreen(20, 6)
+	screen.SetRoot(form)
+	scope := screen.FocusScope()
+	scope.RegisterAll(form)
+	if !name.IsFocused() {
+		t.Fatal("the first field should take focus")
+	}
+
+	values, errs := form.Submit()
+	if values != nil || len(errs) != 1 || errs[0].Field != "Name" || errs[0].Message != "required" {
+		t.Fatalf("Submit() = %v, %v", values, errs)
+	}
+	if out := renderToString(form, 20, 5); !strings.HasPrefix(out, "Name                \n       required     \nEmail") {
+		t.Fatalf("render = %q, want the error beneath Name", out)
+	}
+	if got := email.Bounds().Y; got != 2 {
+		t.Fatalf("email row = %d, want it moved below the error", got)
+	}
+
+	name.SetText("Ada")
+	scope.FocusNext()
+	scope.FocusNext()
+	if !form.SubmitButton().IsFocused() {
+		t.Fatal("Tab should move through the fields to the submit button")
+	}
+	form.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if submitted["Name"] != "Ada" || submitted["Email"] != "" {
+		t.Fatalf("submitted = %v", submitted)
+	}
+	if email.Bounds().Y != 1 {
+		t.Fatal("the error row should be removed once the field validates")
+	}
+}
@@ -6,27 +6,10 @@ import (
 )
 
 // GaugeStyle defines the visual appearance of a gauge.
-type GaugeStyle struct {
-	// Fill characters
-	FillChar  rune // Filled portion (default '█')
-	EmptyChar rune // Empty portion (default '░')
-
-	// Gradient thresholds and styles (ascending order)
-	// Each threshold defines the ratio at which a new color begins
-	Thresholds []GaugeThreshold
-
-	// EmptyStyle for unfilled portion
-	EmptyStyle backend.Style
-
-	// EdgeStyle for the leading edge (optional glow effect)
-	EdgeStyle backend.Style
-}
+type GaugeStyle = runtime.GaugeStyle
 
 // GaugeThreshold defines a color breakpoint in the gradient.
-type GaugeThreshold struct {
-	Ratio float64       // Start ratio for this color (0.0-1.0)
-	Style backend.Style // Style for this segment
-}
+type GaugeThreshold = runtime.GaugeThreshold
 
 // DefaultGaugeStyle returns a green→amber→coral gradient gauge.
 func DefaultGaugeStyle(green, amber, coral, edge, empty backend.Style) GaugeStyle {
@@ -43,59 +26,6 @@ func DefaultGaugeStyle(green, amber, coral, edge, empty backend.Style) GaugeStyl
 	}
 }
 
-// DrawGauge renders a horizontal gauge bar with gradient fill.
-// ratio: 0.0-1.0 fill percentage
-// width: total width in characters
-// Returns the rendered gauge string and styles for each character.
-func DrawGauge(buf *runtime.Buffer, x, y, width int, ratio float64, style GaugeStyle) {
-	if buf == nil || width <= 0 {
-		return
-	}
-
-	// Clamp ratio
-	if ratio < 0 {
-		ratio = 0
-	}
-	if ratio > 1 {
-		ratio = 1
-	}
-
-	// Calculate fill width
-	fill := int(float64(width)*ratio + 0.5)
-	if fill > width {
-		fill = width
-	}
-
-	// Default characters
-	fillChar := style.FillChar
-	if fillChar == 0 {
-		fillChar = '█'
-	}
-	emptyChar := style.EmptyChar
-	if emptyChar == 0 {
-		emptyChar = '░'
-	}
-
-	// Draw each cell
-	for i := 0; i < width; i++ {
-		if i < fill {
-			// Filled portion - determine color from gradient
-			cellRatio := float64(i) / float64(width)
-			cellStyle := styleForRatio(cellRatio, style.Thresholds)
-
-			// Apply edge style to leading edge
-			if i == fill-1 && style.EdgeStyle != (backend.Style{}) {
-				cellStyle = style.EdgeStyle
-			}
-
-			buf.Set(x+i, y, fillChar, cellStyle)
-		} else {
-			// Empty portion
-			buf.Set(x+i, y, emptyChar, style.EmptyStyle)
-		}
-	}
-}
-
 // DrawGaugeString renders a gauge and returns it as a string (for inline use).
 func DrawGaugeString(width int, ratio float64, style GaugeStyle) string {
 	if width <= 0 {
@@ -137,22 +67,6 @@ func DrawGaugeString(width int, ratio float64, style GaugeStyle) string {
 	return string(runes)
 }
 
-// styleForRatio returns the style for a given position ratio based on thresholds.
-func styleForRatio(ratio float64, thresholds []GaugeThreshold) backend.Style {
-	if len(thresholds) == 0 {
-		return backend.DefaultStyle()
-	}
-
-	// Find the highest threshold that ratio meets or exceeds
-	result := thresholds[0].Style
-	for _, t := range thresholds {
-		if ratio >= t.Ratio {
-			result = t.Style
-		}
-	}
-	return result
-}
-
 // GaugeSpan represents a styled segment for composite rendering.
 type GaugeSpan struct {
 	Text  string
@@ -198,7 +112,7 @@ func DrawGaugeSpans(width int, ratio float64, style GaugeStyle) []GaugeSpan {
 
 		for i := 0; i < fill; i++ {
 			cellRatio := float64(i) / float64(width)
-			cellStyle := styleForRatio(cellRatio, style.Thresholds)
+			cellStyle := style.ThresholdStyle(cellRatio)
 
 			// Apply edge style to leading edge
 			if i == fill-1 && style.EdgeStyle != (backend.Style{}) {
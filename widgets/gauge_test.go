@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
 )
 
 func TestDrawGaugeString(t *testing.T) {
@@ -134,10 +135,31 @@ func TestStyleForRatio(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
-			got := styleForRatio(tt.ratio, thresholds)
+			got := GaugeStyle{Thresholds: thresholds}.ThresholdStyle(tt.ratio)
 			if got != tt.expect {
-				t.Errorf("styleForRatio(%.2f) style mismatch", tt.ratio)
+				t.Errorf("ThresholdStyle(%.2f) style mismatch", tt.ratio)
 			}
 		})
 	}
 }
+
+func TestProgress_PercentAndZeroMax(t *testing.T) {
+	progress := NewProgress()
+	progress.Value = 50
+	if got := renderToString(progress, 10, 1); got != "#####- 50%\n" {
+		t.Fatalf("progress = %q, want percent over a full-width bar", got)
+	}
+
+	progress.Max = 0
+	progress.Value = 0.5
+	progress.ShowPercent = false
+	if got := renderToString(progress, 10, 1); got != "#####-----\n" {
+		t.Fatalf("zero max = %q, want Max treated as 1", got)
+	}
+
+	progress.Style.LabelPosition = runtime.GaugeLabelRight
+	progress.ShowPercent = true
+	if got := renderToString(progress, 10, 1); got != "###--  50%\n" {
+		t.Fatalf("right label = %q, want the bar shrunk for the label", got)
+	}
+}
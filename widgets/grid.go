@@ -1,6 +1,10 @@
 package widgets
 
-import "github.com/odvcencio/fluffy-ui/runtime"
+import (
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
 
 // GridChild positions a widget in the grid.
 type GridChild struct {
@@ -18,6 +22,29 @@ type Grid struct {
 	Cols     int
 	Gap      int
 	Children []GridChild
+
+	colWidths  []int
+	rowHeights []int
+	customCols bool
+	customRows bool
+	// colSpace and rowSpace are the space the sizes were last laid out in;
+	// custom sizes are rescaled when it changes.
+	colSpace int
+	rowSpace int
+
+	resizable   bool
+	handleCol   int
+	handleRow   int
+	drag        *gridDrag
+	onResize    func(col, row, colW, rowH int)
+	handleStyle backend.Style
+}
+
+// gridDrag tracks a separator being dragged with the mouse. col or row is
+// -1 when the drag does not move that axis.
+type gridDrag struct {
+	col, row int
+	x, y     int
 }
 
 // NewGrid creates a grid with the given dimensions.
@@ -28,7 +55,81 @@ func NewGrid(rows, cols int) *Grid {
 	if cols <= 0 {
 		cols = 1
 	}
-	return &Grid{Rows: rows, Cols: cols}
+	return &Grid{Rows: rows, Cols: cols, handleStyle: backend.DefaultStyle()}
+}
+
+// SetResizable enables separator handles. A resizable grid is focusable;
+// arrow keys move the selected handle's column and row separators,
+// Ctrl+arrows select a neighbouring handle, and separators can be dragged
+// with the mouse. Resizable grids always leave at least one cell between
+// cells for the separators.
+func (g *Grid) SetResizable(resizable bool) {
+	if g == nil {
+		return
+	}
+	g.resizable = resizable
+	g.drag = nil
+	g.relayout()
+}
+
+// Resizable reports whether separator handles are enabled.
+func (g *Grid) Resizable() bool {
+	return g != nil && g.resizable
+}
+
+// CanFocus reports whether the grid accepts focus for resizing.
+func (g *Grid) CanFocus() bool {
+	return g.Resizable()
+}
+
+// OnResize registers a callback fired after a separator moves. col and row
+// identify the handle; colW and rowH are the new sizes of the column and row
+// before it.
+func (g *Grid) OnResize(fn func(col, row, colW, rowH int)) {
+	if g == nil {
+		return
+	}
+	g.onResize = fn
+}
+
+// ColumnWidth returns the width of a column after layout.
+func (g *Grid) ColumnWidth(col int) int {
+	if g == nil || col < 0 || col >= len(g.colWidths) {
+		return 0
+	}
+	return g.colWidths[col]
+}
+
+// SetColumnWidth fixes the width of a column. Other columns keep their
+// current widths.
+func (g *Grid) SetColumnWidth(col, width int) {
+	if g == nil || col < 0 || col >= g.cols() {
+		return
+	}
+	g.ensureSizes()
+	g.colWidths[col] = max(0, width)
+	g.customCols = true
+	g.relayout()
+}
+
+// RowHeight returns the height of a row after layout.
+func (g *Grid) RowHeight(row int) int {
+	if g == nil || row < 0 || row >= len(g.rowHeights) {
+		return 0
+	}
+	return g.rowHeights[row]
+}
+
+// SetRowHeight fixes the height of a row. Other rows keep their current
+// heights.
+func (g *Grid) SetRowHeight(row, height int) {
+	if g == nil || row < 0 || row >= g.rows() {
+		return
+	}
+	g.ensureSizes()
+	g.rowHeights[row] = max(0, height)
+	g.customRows = true
+	g.relayout()
 }
 
 // Add adds a child at the given cell.
@@ -53,14 +154,9 @@ func (g *Grid) Add(child runtime.Widget, row, col, rowSpan, colSpan int) {
 
 // Measure estimates the grid size.
 func (g *Grid) Measure(constraints runtime.Constraints) runtime.Size {
-	rows := g.Rows
-	cols := g.Cols
-	if rows <= 0 {
-		rows = 1
-	}
-	if cols <= 0 {
-		cols = 1
-	}
+	rows := g.rows()
+	cols := g.cols()
+	gap := g.gap()
 	maxW, maxH := 0, 0
 	for _, child := range g.Children {
 		if child.Widget == nil {
@@ -74,32 +170,39 @@ func (g *Grid) Measure(constraints runtime.Constraints) runtime.Size {
 			maxH = size.Height
 		}
 	}
-	width := maxW*cols + g.Gap*max(0, cols-1)
-	height := maxH*rows + g.Gap*max(0, rows-1)
+	width := maxW*cols + gap*max(0, cols-1)
+	height := maxH*rows + gap*max(0, rows-1)
+	if g.customCols && len(g.colWidths) == cols {
+		width = sum(g.colWidths) + gap*max(0, cols-1)
+	}
+	if g.customRows && len(g.rowHeights) == rows {
+		height = sum(g.rowHeights) + gap*max(0, rows-1)
+	}
 	return constraints.Constrain(runtime.Size{Width: width, Height: height})
 }
 
 // Layout positions children within the grid.
 func (g *Grid) Layout(bounds runtime.Rect) {
 	g.Base.Layout(bounds)
-	rows := g.Rows
-	cols := g.Cols
-	if rows <= 0 {
-		rows = 1
+	rows := g.rows()
+	cols := g.cols()
+	gap := g.gap()
+	colSpace := bounds.Width - gap*max(0, cols-1)
+	if !g.customCols || len(g.colWidths) != cols {
+		g.colWidths = uniformSizes(colSpace, cols)
+		g.customCols = false
+	} else if g.colSpace > 0 && colSpace != g.colSpace {
+		scaleSizes(g.colWidths, colSpace)
 	}
-	if cols <= 0 {
-		cols = 1
-	}
-	totalGapW := g.Gap * max(0, cols-1)
-	totalGapH := g.Gap * max(0, rows-1)
-	cellW := 0
-	cellH := 0
-	if cols > 0 {
-		cellW = max(0, (bounds.Width-totalGapW)/cols)
-	}
-	if rows > 0 {
-		cellH = max(0, (bounds.Height-totalGapH)/rows)
+	g.colSpace = colSpace
+	rowSpace := bounds.Height - gap*max(0, rows-1)
+	if !g.customRows || len(g.rowHeights) != rows {
+		g.rowHeights = uniformSizes(rowSpace, rows)
+		g.customRows = false
+	} else if g.rowSpace > 0 && rowSpace != g.rowSpace {
+		scaleSizes(g.rowHeights, rowSpace)
 	}
+	g.rowSpace = rowSpace
 	for _, child := range g.Children {
 		if child.Widget == nil {
 			continue
@@ -112,25 +215,98 @@ func (g *Grid) Layout(bounds runtime.Rect) {
 		if colSpan <= 0 {
 			colSpan = 1
 		}
-		x := bounds.X + child.Col*cellW + g.Gap*child.Col
-		y := bounds.Y + child.Row*cellH + g.Gap*child.Row
-		width := cellW*colSpan + g.Gap*max(0, colSpan-1)
-		height := cellH*rowSpan + g.Gap*max(0, rowSpan-1)
-		child.Widget.Layout(runtime.Rect{X: x, Y: y, Width: width, Height: height})
+		x, width := spanExtent(g.colWidths, gap, child.Col, colSpan)
+		y, height := spanExtent(g.rowHeights, gap, child.Row, rowSpan)
+		child.Widget.Layout(runtime.Rect{X: bounds.X + x, Y: bounds.Y + y, Width: width, Height: height})
 	}
 }
 
-// Render draws all children.
+// Render draws all children and, when resizable, the separators.
 func (g *Grid) Render(ctx runtime.RenderContext) {
 	for _, child := range g.Children {
 		if child.Widget != nil {
 			child.Widget.Render(ctx)
 		}
 	}
+	if g.resizable {
+		g.renderSeparators(ctx.Buffer)
+	}
 }
 
-// HandleMessage forwards messages to children.
+func (g *Grid) renderSeparators(buf *runtime.Buffer) {
+	bounds := g.bounds
+	if buf == nil || bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	style := g.handleStyle
+	for col := 0; col < len(g.colWidths)-1; col++ {
+		x := bounds.X + g.separatorOffset(g.colWidths, col)
+		for y := bounds.Y; y < bounds.Y+bounds.Height; y++ {
+			buf.Set(x, y, '│', style)
+		}
+	}
+	for row := 0; row < len(g.rowHeights)-1; row++ {
+		y := bounds.Y + g.separatorOffset(g.rowHeights, row)
+		for x := bounds.X; x < bounds.X+bounds.Width; x++ {
+			buf.Set(x, y, '─', style)
+		}
+	}
+	for col := 0; col < max(1, len(g.colWidths)-1); col++ {
+		for row := 0; row < max(1, len(g.rowHeights)-1); row++ {
+			x, y, ok := g.handlePosition(col, row)
+			if !ok {
+				continue
+			}
+			handleStyle := style
+			if g.focused && col == g.handleCol && row == g.handleRow {
+				handleStyle = style.Reverse(true)
+			}
+			buf.Set(x, y, '┼', handleStyle)
+		}
+	}
+}
+
+// handlePosition returns the screen cell of a handle. Grids with a single
+// row or column place handles midway along the other axis's separators.
+func (g *Grid) handlePosition(col, row int) (x, y int, ok bool) {
+	bounds := g.bounds
+	hasCols := len(g.colWidths) > 1
+	hasRows := len(g.rowHeights) > 1
+	if !hasCols && !hasRows {
+		return 0, 0, false
+	}
+	x = bounds.X + bounds.Width/2
+	if hasCols {
+		x = bounds.X + g.separatorOffset(g.colWidths, col)
+	}
+	y = bounds.Y + bounds.Height/2
+	if hasRows {
+		y = bounds.Y + g.separatorOffset(g.rowHeights, row)
+	}
+	return x, y, true
+}
+
+// separatorOffset returns the offset of the first separator cell after
+// index i.
+func (g *Grid) separatorOffset(sizes []int, i int) int {
+	offset, width := spanExtent(sizes, g.gap(), 0, i+1)
+	return offset + width + (g.gap()-1)/2
+}
+
+// HandleMessage resizes separators and forwards messages to children.
 func (g *Grid) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if g.resizable {
+		switch m := msg.(type) {
+		case runtime.KeyMsg:
+			if g.focused && g.handleKey(m) {
+				return runtime.Handled()
+			}
+		case runtime.MouseMsg:
+			if g.handleMouse(m) {
+				return runtime.Handled()
+			}
+		}
+	}
 	for _, child := range g.Children {
 		if child.Widget == nil {
 			continue
@@ -155,3 +331,225 @@ func (g *Grid) ChildWidgets() []runtime.Widget {
 	}
 	return out
 }
+
+func (g *Grid) handleKey(key runtime.KeyMsg) bool {
+	horizontal := 0
+	vertical := 0
+	switch key.Key {
+	case terminal.KeyLeft:
+		horizontal = -1
+	case terminal.KeyRight:
+		horizontal = 1
+	case terminal.KeyUp:
+		vertical = -1
+	case terminal.KeyDown:
+		vertical = 1
+	default:
+		return false
+	}
+	if key.Ctrl {
+		g.handleCol = min(max(g.handleCol+horizontal, 0), max(0, g.cols()-2))
+		g.handleRow = min(max(g.handleRow+vertical, 0), max(0, g.rows()-2))
+		g.Invalidate()
+		return true
+	}
+	if horizontal != 0 {
+		g.moveColumnSeparator(g.handleCol, horizontal)
+	}
+	if vertical != 0 {
+		g.moveRowSeparator(g.handleRow, vertical)
+	}
+	return true
+}
+
+func (g *Grid) handleMouse(mouse runtime.MouseMsg) bool {
+	switch mouse.Action {
+	case runtime.MousePress:
+		if mouse.Button != runtime.MouseLeft {
+			return false
+		}
+		col, row := g.separatorAt(mouse.X, mouse.Y)
+		if col < 0 && row < 0 {
+			return false
+		}
+		if col >= 0 {
+			g.handleCol = col
+		}
+		if row >= 0 {
+			g.handleRow = row
+		}
+		g.drag = &gridDrag{col: col, row: row, x: mouse.X, y: mouse.Y}
+		g.Invalidate()
+		return true
+	case runtime.MouseMove:
+		if g.drag == nil {
+			return false
+		}
+		if g.drag.col >= 0 {
+			g.drag.x += g.moveColumnSeparator(g.drag.col, mouse.X-g.drag.x)
+		}
+		if g.drag.row >= 0 {
+			g.drag.y += g.moveRowSeparator(g.drag.row, mouse.Y-g.drag.y)
+		}
+		return true
+	case runtime.MouseRelease:
+		if g.drag == nil {
+			return false
+		}
+		g.drag = nil
+		return true
+	}
+	return false
+}
+
+// separatorAt returns the column and row separators under a point, or -1.
+func (g *Grid) separatorAt(x, y int) (col, row int) {
+	col, row = -1, -1
+	if !g.bounds.Contains(x, y) {
+		return col, row
+	}
+	gap := g.gap()
+	offset := g.bounds.X
+	for i := 0; i < len(g.colWidths)-1; i++ {
+		offset += g.colWidths[i]
+		if x >= offset && x < offset+gap {
+			col = i
+		}
+		offset += gap
+	}
+	offset = g.bounds.Y
+	for i := 0; i < len(g.rowHeights)-1; i++ {
+		offset += g.rowHeights[i]
+		if y >= offset && y < offset+gap {
+			row = i
+		}
+		offset += gap
+	}
+	return col, row
+}
+
+// moveColumnSeparator moves the separator after col by delta, keeping the
+// combined width of the two columns constant. It returns the applied delta.
+func (g *Grid) moveColumnSeparator(col, delta int) int {
+	applied := shiftSizes(g.colWidths, col, delta)
+	if applied == 0 {
+		return 0
+	}
+	g.customCols = true
+	g.resized()
+	return applied
+}
+
+// moveRowSeparator moves the separator after row by delta, keeping the
+// combined height of the two rows constant. It returns the applied delta.
+func (g *Grid) moveRowSeparator(row, delta int) int {
+	applied := shiftSizes(g.rowHeights, row, delta)
+	if applied == 0 {
+		return 0
+	}
+	g.customRows = true
+	g.resized()
+	return applied
+}
+
+func (g *Grid) resized() {
+	g.relayout()
+	if g.onResize != nil {
+		g.onResize(g.handleCol, g.handleRow, g.ColumnWidth(g.handleCol), g.RowHeight(g.handleRow))
+	}
+}
+
+func (g *Grid) relayout() {
+	if g.bounds.Width > 0 || g.bounds.Height > 0 {
+		g.Layout(g.bounds)
+	}
+	g.Invalidate()
+}
+
+// ensureSizes allocates size slices before the first layout.
+func (g *Grid) ensureSizes() {
+	if len(g.colWidths) != g.cols() {
+		g.colWidths = uniformSizes(g.bounds.Width-g.gap()*(g.cols()-1), g.cols())
+	}
+	if len(g.rowHeights) != g.rows() {
+		g.rowHeights = uniformSizes(g.bounds.Height-g.gap()*(g.rows()-1), g.rows())
+	}
+}
+
+func (g *Grid) rows() int {
+	return max(1, g.Rows)
+}
+
+func (g *Grid) cols() int {
+	return max(1, g.Cols)
+}
+
+// gap returns the spacing between cells; resizable grids need room for
+// their separators.
+func (g *Grid) gap() int {
+	if g.resizable {
+		return max(1, g.Gap)
+	}
+	return max(0, g.Gap)
+}
+
+// shiftSizes moves delta units from sizes[i+1] to sizes[i], leaving each at
+// least one unit. It returns the delta actually applied.
+func shiftSizes(sizes []int, i, delta int) int {
+	if i < 0 || i+1 >= len(sizes) {
+		return 0
+	}
+	lo, hi := min(0, 1-sizes[i]), max(0, sizes[i+1]-1)
+	delta = min(max(delta, lo), hi)
+	sizes[i] += delta
+	sizes[i+1] -= delta
+	return delta
+}
+
+// uniformSizes splits total into n equal sizes.
+func uniformSizes(total, n int) []int {
+	sizes := make([]int, n)
+	each := max(0, total) / max(1, n)
+	for i := range sizes {
+		sizes[i] = each
+	}
+	return sizes
+}
+
+// scaleSizes resizes sizes in place, keeping their proportions, so they
+// add up to total.
+func scaleSizes(sizes []int, total int) {
+	from := sum(sizes)
+	total = max(0, total)
+	if from <= 0 {
+		copy(sizes, uniformSizes(total, len(sizes)))
+		return
+	}
+	acc, prev := 0, 0
+	for i, size := range sizes {
+		acc += size
+		next := acc * total / from
+		sizes[i] = next - prev
+		prev = next
+	}
+}
+
+// spanExtent returns the offset of cell start and the extent of span cells.
+func spanExtent(sizes []int, gap, start, span int) (offset, extent int) {
+	for i := 0; i < start && i < len(sizes); i++ {
+		offset += sizes[i] + gap
+	}
+	for i := start; i < start+span && i < len(sizes); i++ {
+		extent += sizes[i]
+	}
+	extent += gap * max(0, span-1)
+	return offset, extent
+}
+
+func sum(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
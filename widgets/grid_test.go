@@ -0,0 +1,97 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func newResizableGrid() (*Grid, *Label, *Label) {
+	grid := NewGrid(2, 2)
+	left := NewLabel("left")
+	right := NewLabel("right")
+	grid.Add(left, 0, 0, 1, 1)
+	grid.Add(right, 0, 1, 1, 1)
+	grid.SetResizable(true)
+	grid.Layout(runtime.Rect{X: 0, Y: 0, Width: 21, Height: 5})
+	return grid, left, right
+}
+
+func TestGrid_DragColumnSeparator(t *testing.T) {
+	grid, left, right := newResizableGrid()
+	if grid.ColumnWidth(0) != 10 || grid.ColumnWidth(1) != 10 {
+		t.Fatalf("widths = %d,%d, want 10,10", grid.ColumnWidth(0), grid.ColumnWidth(1))
+	}
+	total := grid.ColumnWidth(0) + grid.ColumnWidth(1)
+
+	var calls int
+	grid.OnResize(func(col, row, colW, rowH int) {
+		calls++
+	})
+	grid.HandleMessage(runtime.MouseMsg{X: 10, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	grid.HandleMessage(runtime.MouseMsg{X: 13, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseMove})
+	grid.HandleMessage(runtime.MouseMsg{X: 13, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+
+	if grid.ColumnWidth(0) != 13 || grid.ColumnWidth(1) != 7 {
+		t.Fatalf("widths = %d,%d, want 13,7", grid.ColumnWidth(0), grid.ColumnWidth(1))
+	}
+	if got := grid.ColumnWidth(0) + grid.ColumnWidth(1); got != total {
+		t.Fatalf("total width = %d, want %d", got, total)
+	}
+	if calls != 1 {
+		t.Fatalf("OnResize calls = %d, want 1", calls)
+	}
+	if left.Bounds().Width != 13 || right.Bounds().X != 14 || right.Bounds().Width != 7 {
+		t.Fatalf("child bounds = %+v %+v", left.Bounds(), right.Bounds())
+	}
+}
+
+func TestGrid_KeyboardResize(t *testing.T) {
+	grid, _, _ := newResizableGrid()
+	if !grid.CanFocus() {
+		t.Fatal("resizable grid should be focusable")
+	}
+	grid.Focus()
+
+	grid.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if grid.ColumnWidth(0) != 11 || grid.ColumnWidth(1) != 9 {
+		t.Fatalf("widths = %d,%d, want 11,9", grid.ColumnWidth(0), grid.ColumnWidth(1))
+	}
+	grid.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if grid.RowHeight(0) != 1 || grid.RowHeight(1) != 3 {
+		t.Fatalf("heights = %d,%d, want 1,3", grid.RowHeight(0), grid.RowHeight(1))
+	}
+	// Rows never shrink below one cell.
+	grid.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if grid.RowHeight(0) != 1 {
+		t.Fatalf("row 0 height = %d, want 1", grid.RowHeight(0))
+	}
+
+	grid.SetColumnWidth(1, 4)
+	if grid.ColumnWidth(1) != 4 || grid.ColumnWidth(0) != 11 {
+		t.Fatalf("widths = %d,%d, want 11,4", grid.ColumnWidth(0), grid.ColumnWidth(1))
+	}
+}
+
+func TestGrid_ResizeKeepsDraggedProportions(t *testing.T) {
+	grid, left, right := newResizableGrid()
+	grid.HandleMessage(runtime.MouseMsg{X: 10, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	grid.HandleMessage(runtime.MouseMsg{X: 15, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseMove})
+	grid.HandleMessage(runtime.MouseMsg{X: 15, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if grid.ColumnWidth(0) != 15 || grid.ColumnWidth(1) != 5 {
+		t.Fatalf("widths = %d,%d, want 15,5", grid.ColumnWidth(0), grid.ColumnWidth(1))
+	}
+
+	grid.Layout(runtime.Rect{Width: 81, Height: 5})
+	if grid.ColumnWidth(0) != 60 || grid.ColumnWidth(1) != 20 {
+		t.Fatalf("widths at 81 = %d,%d, want 60,20", grid.ColumnWidth(0), grid.ColumnWidth(1))
+	}
+	grid.Layout(runtime.Rect{Width: 9, Height: 5})
+	if grid.ColumnWidth(0) != 6 || grid.ColumnWidth(1) != 2 {
+		t.Fatalf("widths at 9 = %d,%d, want 6,2", grid.ColumnWidth(0), grid.ColumnWidth(1))
+	}
+	if end := right.Bounds().X + right.Bounds().Width; end > 9 || left.Bounds().Width != 6 {
+		t.Fatalf("child bounds = %+v %+v, want them inside the grid", left.Bounds(), right.Bounds())
+	}
+}
@@ -0,0 +1,382 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/scroll"
+	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/theme"
+)
+
+// Group is a titled section of items.
+type Group[T any] struct {
+	Header string
+	Items  []T
+}
+
+// GroupedAdapter presents groups of items as virtual content with a
+// one-line header before each group. Place it in a ScrollView to scroll
+// large lists: only visible rows are drawn, and the current group's header
+// stays pinned at the top. Headers cannot be selected.
+type GroupedAdapter[T any] struct {
+	FocusableBase
+	groups     []Group[T]
+	render     RenderFunc[T]
+	itemHeight func(item T) int
+
+	// rows lists headers and items in display order. offsets[i] is the first
+	// line of row i and lines maps each line back to its row.
+	rows    []groupedAdapterRow
+	offsets []int
+	lines   []int
+
+	selected    int // Row index, always an item row when one exists
+	onSelect    func(groupIndex, itemIndex int, item T)
+	style       backend.Style
+	headerStyle backend.Style
+	headerRole  themeRole
+}
+
+type groupedAdapterRow struct {
+	group int
+	item  int // Index within the group, -1 for the header
+}
+
+// NewGroupedAdapter creates a grouped adapter. Render draws one item; the
+// index it receives is the item's position within its group.
+func NewGroupedAdapter[T any](groups []Group[T], render RenderFunc[T]) *GroupedAdapter[T] {
+	a := &GroupedAdapter[T]{
+		render:      render,
+		style:       backend.DefaultStyle(),
+		headerStyle: backend.DefaultStyle().Bold(true).Underline(true),
+	}
+	a.SetGroups(groups)
+	return a
+}
+
+// SetGroups replaces the groups and rebuilds the row index, keeping the
+// selection on the same group and item position when it still exists.
+func (a *GroupedAdapter[T]) SetGroups(groups []Group[T]) {
+	if a == nil {
+		return
+	}
+	group, item := a.SelectedIndex()
+	a.groups = groups
+	a.rebuild()
+	if !a.selectRow(group, item) {
+		a.selected = a.nextItemRow(0, 1)
+	}
+	a.Invalidate()
+}
+
+// Groups returns the groups.
+func (a *GroupedAdapter[T]) Groups() []Group[T] {
+	if a == nil {
+		return nil
+	}
+	return a.groups
+}
+
+// SetItemHeightFunc sets how many lines each item uses (default 1). Headers
+// always use one line.
+func (a *GroupedAdapter[T]) SetItemHeightFunc(fn func(item T) int) {
+	if a == nil {
+		return
+	}
+	a.itemHeight = fn
+	a.rebuild()
+	a.Invalidate()
+}
+
+// SetHeaderStyle sets the group header style.
+func (a *GroupedAdapter[T]) SetHeaderStyle(style backend.Style) {
+	if a == nil {
+		return
+	}
+	a.headerStyle = style
+	a.Invalidate()
+}
+
+// SetHeaderRole sets the group header style to the active theme's token
+// for role.
+func (a *GroupedAdapter[T]) SetHeaderRole(role theme.Role) {
+	if a == nil {
+		return
+	}
+	a.SetHeaderStyle(a.headerRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (a *GroupedAdapter[T]) ApplyTheme() {
+	if a == nil {
+		return
+	}
+	if style, ok := a.headerRole.refresh(a.headerStyle); ok {
+		a.SetHeaderStyle(style)
+	}
+}
+
+// OnSelect registers a handler called when the selection moves or Enter is
+// pressed.
+func (a *GroupedAdapter[T]) OnSelect(fn func(groupIndex, itemIndex int, item T)) {
+	if a == nil {
+		return
+	}
+	a.onSelect = fn
+}
+
+// SelectedIndex returns the group and item index of the selection, or -1, -1.
+func (a *GroupedAdapter[T]) SelectedIndex() (groupIndex, itemIndex int) {
+	if a == nil || a.selected < 0 || a.selected >= len(a.rows) || a.rows[a.selected].item < 0 {
+		return -1, -1
+	}
+	row := a.rows[a.selected]
+	return row.group, row.item
+}
+
+// SelectedItem returns the selected item.
+func (a *GroupedAdapter[T]) SelectedItem() (T, bool) {
+	var zero T
+	group, item := a.SelectedIndex()
+	if group < 0 {
+		return zero, false
+	}
+	return a.groups[group].Items[item], true
+}
+
+// SetSelected selects an item by group and item index.
+func (a *GroupedAdapter[T]) SetSelected(groupIndex, itemIndex int) {
+	if a == nil || !a.selectRow(groupIndex, itemIndex) {
+		return
+	}
+	a.notifySelect()
+	a.Invalidate()
+}
+
+// ItemCount returns the number of header and item rows.
+func (a *GroupedAdapter[T]) ItemCount() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.rows)
+}
+
+// ItemHeight returns the lines used by a row.
+func (a *GroupedAdapter[T]) ItemHeight(index int) int {
+	if a == nil || index < 0 || index >= len(a.rows) {
+		return 0
+	}
+	return a.offsets[index+1] - a.offsets[index]
+}
+
+// ItemAt returns the header string or item of a row.
+func (a *GroupedAdapter[T]) ItemAt(index int) any {
+	if a == nil || index < 0 || index >= len(a.rows) {
+		return nil
+	}
+	row := a.rows[index]
+	if row.item < 0 {
+		return a.groups[row.group].Header
+	}
+	return a.groups[row.group].Items[row.item]
+}
+
+// RenderItem draws a header or item row.
+func (a *GroupedAdapter[T]) RenderItem(index int, ctx runtime.RenderContext) {
+	if a == nil || index < 0 || index >= len(a.rows) {
+		return
+	}
+	bounds := ctx.Bounds
+	row := a.rows[index]
+	if row.item < 0 {
+		title := truncateString(a.groups[row.group].Header, bounds.Width)
+		writePadded(ctx.Buffer, bounds.X, bounds.Y, bounds.Width, title, a.headerStyle)
+		return
+	}
+	if a.render != nil {
+		a.render(a.groups[row.group].Items[row.item], row.item, index == a.selected, false, ctx)
+	}
+}
+
+// TotalHeight returns the lines used by all rows.
+func (a *GroupedAdapter[T]) TotalHeight() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.lines)
+}
+
+// IndexForOffset returns the row drawn at a line offset.
+func (a *GroupedAdapter[T]) IndexForOffset(offset int) int {
+	if a == nil || len(a.lines) == 0 {
+		return 0
+	}
+	return a.lines[max(0, min(offset, len(a.lines)-1))]
+}
+
+// OffsetForIndex returns the first line of a row.
+func (a *GroupedAdapter[T]) OffsetForIndex(index int) int {
+	if a == nil || len(a.rows) == 0 {
+		return 0
+	}
+	return a.offsets[max(0, min(index, len(a.rows)-1))]
+}
+
+// SelectedItemIndex returns the selected row for ScrollView to keep visible.
+func (a *GroupedAdapter[T]) SelectedItemIndex() int {
+	if group, _ := a.SelectedIndex(); group < 0 {
+		return -1
+	}
+	return a.selected
+}
+
+// StickyHeader returns the header row of the group containing index.
+func (a *GroupedAdapter[T]) StickyHeader(index int) int {
+	if a == nil || index < 0 || index >= len(a.rows) {
+		return -1
+	}
+	for index >= 0 && a.rows[index].item >= 0 {
+		index -= a.rows[index].item + 1
+	}
+	return index
+}
+
+// Measure returns the height of all rows.
+func (a *GroupedAdapter[T]) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.Constrain(runtime.Size{Width: constraints.MaxWidth, Height: a.TotalHeight()})
+}
+
+// Render draws rows from the top when used outside a ScrollView.
+func (a *GroupedAdapter[T]) Render(ctx runtime.RenderContext) {
+	if a == nil {
+		return
+	}
+	bounds := a.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	ctx.Buffer.Fill(bounds, ' ', a.style)
+	for i := range a.rows {
+		y := a.offsets[i]
+		if y >= bounds.Height {
+			break
+		}
+		height := min(a.ItemHeight(i), bounds.Height-y)
+		a.RenderItem(i, ctx.Sub(runtime.Rect{X: bounds.X, Y: bounds.Y + y, Width: bounds.Width, Height: height}))
+	}
+}
+
+// HandleMessage moves the selection with Up/Down/Home/End, skipping headers.
+// Paging is left to the enclosing ScrollView.
+func (a *GroupedAdapter[T]) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if a == nil || !a.focused || len(a.rows) == 0 {
+		return runtime.Unhandled()
+	}
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok {
+		return runtime.Unhandled()
+	}
+	switch key.Key {
+	case terminal.KeyUp:
+		a.setSelectedRow(a.nextItemRow(a.selected-1, -1))
+	case terminal.KeyDown:
+		a.setSelectedRow(a.nextItemRow(a.selected+1, 1))
+	case terminal.KeyHome:
+		a.setSelectedRow(a.nextItemRow(0, 1))
+	case terminal.KeyEnd:
+		a.setSelectedRow(a.nextItemRow(len(a.rows)-1, -1))
+	case terminal.KeyEnter:
+		a.notifySelect()
+	default:
+		return runtime.Unhandled()
+	}
+	return runtime.Handled()
+}
+
+// rebuild recomputes the rows and the offset tables.
+func (a *GroupedAdapter[T]) rebuild() {
+	a.rows = a.rows[:0]
+	a.offsets = a.offsets[:0]
+	a.lines = a.lines[:0]
+	for g, group := range a.groups {
+		a.appendRow(groupedAdapterRow{group: g, item: -1}, 1)
+		for i, item := range group.Items {
+			height := 1
+			if a.itemHeight != nil {
+				height = max(a.itemHeight(item), 1)
+			}
+			a.appendRow(groupedAdapterRow{group: g, item: i}, height)
+		}
+	}
+	a.offsets = append(a.offsets, len(a.lines))
+}
+
+func (a *GroupedAdapter[T]) appendRow(row groupedAdapterRow, height int) {
+	index := len(a.rows)
+	a.rows = append(a.rows, row)
+	a.offsets = append(a.offsets, len(a.lines))
+	for i := 0; i < height; i++ {
+		a.lines = append(a.lines, index)
+	}
+}
+
+// selectRow moves the selection to an item without notifying.
+func (a *GroupedAdapter[T]) selectRow(groupIndex, itemIndex int) bool {
+	if groupIndex < 0 || groupIndex >= len(a.groups) || itemIndex < 0 || itemIndex >= len(a.groups[groupIndex].Items) {
+		return false
+	}
+	for i, row := range a.rows {
+		if row.group == groupIndex && row.item == itemIndex {
+			a.selected = i
+			return true
+		}
+	}
+	return false
+}
+
+// nextItemRow returns the nearest item row from start in direction dir,
+// falling back to the opposite direction at the edges.
+func (a *GroupedAdapter[T]) nextItemRow(start, dir int) int {
+	if len(a.rows) == 0 {
+		return 0
+	}
+	start = max(0, min(start, len(a.rows)-1))
+	for i := start; i >= 0 && i < len(a.rows); i += dir {
+		if a.rows[i].item >= 0 {
+			return i
+		}
+	}
+	for i := start; i >= 0 && i < len(a.rows); i -= dir {
+		if a.rows[i].item >= 0 {
+			return i
+		}
+	}
+	return start
+}
+
+func (a *GroupedAdapter[T]) setSelectedRow(row int) {
+	if row == a.selected {
+		return
+	}
+	a.selected = row
+	a.notifySelect()
+	a.Invalidate()
+}
+
+func (a *GroupedAdapter[T]) notifySelect() {
+	if a.onSelect == nil {
+		return
+	}
+	if group, item := a.SelectedIndex(); group >= 0 {
+		a.onSelect(group, item, a.groups[group].Items[item])
+	}
+}
+
+var (
+	_ scroll.VirtualContent       = (*GroupedAdapter[any])(nil)
+	_ scroll.VirtualSizer         = (*GroupedAdapter[any])(nil)
+	_ scroll.VirtualIndexer       = (*GroupedAdapter[any])(nil)
+	_ scroll.VirtualSelection     = (*GroupedAdapter[any])(nil)
+	_ scroll.VirtualStickyHeaders = (*GroupedAdapter[any])(nil)
+)
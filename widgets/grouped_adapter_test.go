@@ -0,0 +1,94 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func newTestGroupedAdapter() *GroupedAdapter[string] {
+	return NewGroupedAdapter([]Group[string]{
+		{Header: "Fruit", Items: []string{"apple", "banana"}},
+		{Header: "Veg", Items: []string{"carrot", "leek", "onion"}},
+	}, func(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item, backend.DefaultStyle())
+	})
+}
+
+func TestGroupedAdapter_OffsetIndex(t *testing.T) {
+	adapter := newTestGroupedAdapter()
+	adapter.SetItemHeightFunc(func(item string) int {
+		if item == "banana" {
+			return 2
+		}
+		return 1
+	})
+
+	if got := adapter.TotalHeight(); got != 8 {
+		t.Fatalf("TotalHeight = %d, want 8", got)
+	}
+	for offset, want := range map[int]int{0: 0, 1: 1, 2: 2, 3: 2, 4: 3, 7: 6, 99: 6} {
+		if got := adapter.IndexForOffset(offset); got != want {
+			t.Errorf("IndexForOffset(%d) = %d, want %d", offset, got, want)
+		}
+	}
+	if got := adapter.OffsetForIndex(3); got != 4 {
+		t.Fatalf("OffsetForIndex(3) = %d, want 4", got)
+	}
+	if got := adapter.ItemAt(3); got != "Veg" {
+		t.Fatalf("ItemAt(3) = %v, want the Veg header", got)
+	}
+}
+
+func TestGroupedAdapter_NavigationSkipsHeaders(t *testing.T) {
+	adapter := newTestGroupedAdapter()
+	adapter.Focus()
+	var selections []string
+	adapter.OnSelect(func(groupIndex, itemIndex int, item string) {
+		selections = append(selections, item)
+		if adapter.Groups()[groupIndex].Items[itemIndex] != item {
+			t.Errorf("OnSelect(%d, %d) does not match %q", groupIndex, itemIndex, item)
+		}
+	})
+
+	adapter.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	adapter.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if group, item := adapter.SelectedIndex(); group != 1 || item != 0 {
+		t.Fatalf("selection = (%d, %d), want (1, 0) after skipping the header", group, item)
+	}
+	adapter.HandleMessage(runtime.KeyMsg{Key: terminal.KeyHome})
+	if got := strings.Join(selections, " "); got != "banana carrot apple" {
+		t.Fatalf("OnSelect calls = %q", got)
+	}
+}
+
+func TestGroupedAdapter_ScrollViewStickyHeader(t *testing.T) {
+	adapter := newTestGroupedAdapter()
+	view := NewScrollView(adapter)
+	view.Focus()
+	down := runtime.KeyMsg{Key: terminal.KeyDown}
+	lines := func() []string {
+		out := strings.Split(renderToString(view, 8, 3), "\n")
+		for i := range out {
+			out[i] = strings.TrimRight(out[i][:min(len(out[i]), 6)], " ")
+		}
+		return out[:3]
+	}
+	lines()
+
+	view.HandleMessage(down)
+	view.HandleMessage(down)
+	// carrot is kept below its header line; banana's line shows the
+	// pinned Fruit header.
+	if got := strings.Join(lines(), ","); got != "Fruit,Veg,carrot" {
+		t.Fatalf("rows = %q, want Fruit,Veg,carrot", got)
+	}
+	view.HandleMessage(down)
+	view.HandleMessage(down)
+	if got := strings.Join(lines(), ","); got != "Veg,leek,onion" {
+		t.Fatalf("rows = %q, want the Veg header pinned over carrot", got)
+	}
+}
@@ -0,0 +1,394 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/scroll"
+	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/theme"
+)
+
+// GroupedList renders list items under non-selectable group headers.
+// Groups appear in order of first occurrence; items keep their relative order.
+type GroupedList[T any] struct {
+	FocusableBase
+	adapter       ListAdapter[T]
+	groupKey      func(item T) string
+	rows          []groupedRow
+	grouped       bool // rows match the adapter and groupKey
+	groupedCount  int  // adapter count when rows were built
+	selected      int  // Visible row index, always an item row when possible
+	offset        int
+	onSelect      func(index int, item T)
+	style         backend.Style
+	headerStyle   backend.Style
+	headerRole    themeRole
+	selectedStyle backend.Style
+}
+
+type groupedRow struct {
+	header string
+	index  int // Flat adapter index, -1 for header rows
+}
+
+// NewGroupedList creates a grouped list widget.
+func NewGroupedList[T any](adapter ListAdapter[T], groupKey func(item T) string) *GroupedList[T] {
+	l := &GroupedList[T]{
+		adapter:       adapter,
+		groupKey:      groupKey,
+		style:         backend.DefaultStyle(),
+		headerStyle:   backend.DefaultStyle().Bold(true).Underline(true),
+		selectedStyle: backend.DefaultStyle().Reverse(true),
+	}
+	l.rebuild()
+	l.selected = l.nextItemRow(0, 1)
+	return l
+}
+
+// OnSelect registers a selection handler.
+// The index passed is the item's flat adapter index.
+func (l *GroupedList[T]) OnSelect(fn func(index int, item T)) {
+	if l == nil {
+		return
+	}
+	l.onSelect = fn
+}
+
+// SetAdapter replaces the items and regroups them.
+func (l *GroupedList[T]) SetAdapter(adapter ListAdapter[T]) {
+	if l == nil {
+		return
+	}
+	l.adapter = adapter
+	l.Refresh()
+}
+
+// SetGroupKey replaces the grouping function and regroups the items.
+func (l *GroupedList[T]) SetGroupKey(groupKey func(item T) string) {
+	if l == nil {
+		return
+	}
+	l.groupKey = groupKey
+	l.Refresh()
+}
+
+// Refresh regroups the items. Groups are cached and rebuilt on their own
+// only when the adapter's count changes, so call Refresh after changing
+// items in place.
+func (l *GroupedList[T]) Refresh() {
+	if l == nil {
+		return
+	}
+	l.grouped = false
+	l.Invalidate()
+}
+
+// SetHeaderStyle sets the group header style.
+func (l *GroupedList[T]) SetHeaderStyle(style backend.Style) {
+	if l == nil {
+		return
+	}
+	l.headerStyle = style
+}
+
+// SetHeaderRole sets the group header style to the active theme's token
+// for role.
+func (l *GroupedList[T]) SetHeaderRole(role theme.Role) {
+	if l == nil {
+		return
+	}
+	l.SetHeaderStyle(l.headerRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (l *GroupedList[T]) ApplyTheme() {
+	if l == nil {
+		return
+	}
+	if style, ok := l.headerRole.refresh(l.headerStyle); ok {
+		l.SetHeaderStyle(style)
+	}
+}
+
+// Measure returns the desired size.
+func (l *GroupedList[T]) Measure(constraints runtime.Constraints) runtime.Size {
+	count := 0
+	if l != nil {
+		count = len(l.groupedRows())
+	}
+	height := min(count, constraints.MaxHeight)
+	if height <= 0 {
+		height = constraints.MinHeight
+	}
+	return constraints.Constrain(runtime.Size{Width: constraints.MaxWidth, Height: height})
+}
+
+// Render draws group headers and items.
+func (l *GroupedList[T]) Render(ctx runtime.RenderContext) {
+	if l == nil || l.adapter == nil {
+		return
+	}
+	bounds := l.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	ctx.Buffer.Fill(bounds, ' ', l.style)
+	rows := l.groupedRows()
+	if len(rows) == 0 {
+		return
+	}
+	l.selected = l.clampSelection(l.selected)
+	if l.selected < l.offset {
+		l.offset = l.selected
+		// Keep the group header visible when scrolling up to a group's first item.
+		if l.offset > 0 && rows[l.offset-1].index < 0 {
+			l.offset--
+		}
+	}
+	if l.selected >= l.offset+bounds.Height {
+		l.offset = l.selected - bounds.Height + 1
+	}
+	for i := 0; i < bounds.Height; i++ {
+		rowIndex := l.offset + i
+		if rowIndex < 0 || rowIndex >= len(rows) {
+			break
+		}
+		row := rows[rowIndex]
+		rowBounds := runtime.Rect{X: bounds.X, Y: bounds.Y + i, Width: bounds.Width, Height: 1}
+		if row.index < 0 {
+			title := truncateString(row.header, bounds.Width)
+			writePadded(ctx.Buffer, rowBounds.X, rowBounds.Y, rowBounds.Width, title, l.headerStyle)
+			continue
+		}
+		item := l.adapter.Item(row.index)
+		l.adapter.Render(item, row.index, rowIndex == l.selected, false, ctx.Sub(rowBounds))
+	}
+}
+
+// HandleMessage handles navigation, skipping header rows.
+func (l *GroupedList[T]) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if l == nil || !l.focused || l.adapter == nil {
+		return runtime.Unhandled()
+	}
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok {
+		return runtime.Unhandled()
+	}
+	rows := l.groupedRows()
+	if len(rows) == 0 {
+		return runtime.Unhandled()
+	}
+	switch key.Key {
+	case terminal.KeyUp:
+		l.setSelectedRow(l.nextItemRow(l.selected-1, -1))
+		return runtime.Handled()
+	case terminal.KeyDown:
+		l.setSelectedRow(l.nextItemRow(l.selected+1, 1))
+		return runtime.Handled()
+	case terminal.KeyPageUp:
+		l.setSelectedRow(l.nextItemRow(l.selected-l.bounds.Height, -1))
+		return runtime.Handled()
+	case terminal.KeyPageDown:
+		l.setSelectedRow(l.nextItemRow(l.selected+l.bounds.Height, 1))
+		return runtime.Handled()
+	case terminal.KeyHome:
+		l.setSelectedRow(l.nextItemRow(0, 1))
+		return runtime.Handled()
+	case terminal.KeyEnd:
+		l.setSelectedRow(l.nextItemRow(len(rows)-1, -1))
+		return runtime.Handled()
+	case terminal.KeyEnter:
+		if index := l.SelectedIndex(); index >= 0 && l.onSelect != nil {
+			l.onSelect(index, l.adapter.Item(index))
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// SetSelected selects the item at the flat adapter index.
+func (l *GroupedList[T]) SetSelected(index int) {
+	if l == nil {
+		return
+	}
+	for i, row := range l.groupedRows() {
+		if row.index == index {
+			l.setSelectedRow(i)
+			l.Invalidate()
+			return
+		}
+	}
+}
+
+// SelectedIndex returns the flat adapter index of the selected item, or -1.
+func (l *GroupedList[T]) SelectedIndex() int {
+	if l == nil {
+		return -1
+	}
+	if l.selected < 0 || l.selected >= len(l.rows) {
+		return -1
+	}
+	return l.rows[l.selected].index
+}
+
+// SelectedItem returns the selected item.
+func (l *GroupedList[T]) SelectedItem() (T, bool) {
+	var zero T
+	if l == nil || l.adapter == nil {
+		return zero, false
+	}
+	index := l.SelectedIndex()
+	if index < 0 || index >= l.adapter.Count() {
+		return zero, false
+	}
+	return l.adapter.Item(index), true
+}
+
+// groupedRows returns the cached header and item rows, rebuilding them when
+// they are stale.
+func (l *GroupedList[T]) groupedRows() []groupedRow {
+	if l.adapter == nil {
+		l.rows = l.rows[:0]
+		return l.rows
+	}
+	if !l.grouped || l.adapter.Count() != l.groupedCount {
+		return l.rebuild()
+	}
+	return l.rows
+}
+
+// rebuild regroups adapter items into header and item rows.
+func (l *GroupedList[T]) rebuild() []groupedRow {
+	l.rows = l.rows[:0]
+	l.grouped = false
+	if l.adapter == nil {
+		return l.rows
+	}
+	count := l.adapter.Count()
+	l.grouped = true
+	l.groupedCount = count
+	var order []string
+	groups := make(map[string][]int)
+	for i := 0; i < count; i++ {
+		key := ""
+		if l.groupKey != nil {
+			key = l.groupKey(l.adapter.Item(i))
+		}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+	for _, key := range order {
+		l.rows = append(l.rows, groupedRow{header: key, index: -1})
+		for _, index := range groups[key] {
+			l.rows = append(l.rows, groupedRow{index: index})
+		}
+	}
+	return l.rows
+}
+
+// nextItemRow returns the nearest item row from start in direction dir,
+// falling back to the opposite direction at the edges.
+func (l *GroupedList[T]) nextItemRow(start, dir int) int {
+	if len(l.rows) == 0 {
+		return 0
+	}
+	start = max(0, min(start, len(l.rows)-1))
+	for i := start; i >= 0 && i < len(l.rows); i += dir {
+		if l.rows[i].index >= 0 {
+			return i
+		}
+	}
+	for i := start; i >= 0 && i < len(l.rows); i -= dir {
+		if l.rows[i].index >= 0 {
+			return i
+		}
+	}
+	return start
+}
+
+func (l *GroupedList[T]) clampSelection(row int) int {
+	if len(l.rows) == 0 {
+		return 0
+	}
+	return l.nextItemRow(row, 1)
+}
+
+func (l *GroupedList[T]) setSelectedRow(row int) {
+	if len(l.rows) == 0 {
+		l.selected = 0
+		return
+	}
+	l.selected = l.clampSelection(row)
+	if l.onSelect != nil {
+		if index := l.SelectedIndex(); index >= 0 {
+			l.onSelect(index, l.adapter.Item(index))
+		}
+	}
+}
+
+// ScrollBy moves the selection by delta items.
+func (l *GroupedList[T]) ScrollBy(dx, dy int) {
+	if l == nil || l.adapter == nil || dy == 0 {
+		return
+	}
+	l.groupedRows()
+	dir := 1
+	if dy < 0 {
+		dir = -1
+		dy = -dy
+	}
+	row := l.selected
+	for i := 0; i < dy; i++ {
+		row = l.nextItemRow(row+dir, dir)
+	}
+	l.setSelectedRow(row)
+	l.Invalidate()
+}
+
+// ScrollTo selects the item at the given flat index.
+func (l *GroupedList[T]) ScrollTo(x, y int) {
+	l.SetSelected(y)
+}
+
+// PageBy scrolls by a number of pages.
+func (l *GroupedList[T]) PageBy(pages int) {
+	if l == nil || l.adapter == nil {
+		return
+	}
+	rows := l.groupedRows()
+	if len(rows) == 0 {
+		return
+	}
+	pageSize := max(1, l.bounds.Height)
+	dir := 1
+	if pages < 0 {
+		dir = -1
+	}
+	l.setSelectedRow(l.nextItemRow(l.selected+pages*pageSize, dir))
+	l.Invalidate()
+}
+
+// ScrollToStart selects the first item.
+func (l *GroupedList[T]) ScrollToStart() {
+	if l == nil || l.adapter == nil {
+		return
+	}
+	l.groupedRows()
+	l.setSelectedRow(l.nextItemRow(0, 1))
+	l.Invalidate()
+}
+
+// ScrollToEnd selects the last item.
+func (l *GroupedList[T]) ScrollToEnd() {
+	if l == nil || l.adapter == nil {
+		return
+	}
+	rows := l.groupedRows()
+	l.setSelectedRow(l.nextItemRow(len(rows)-1, -1))
+	l.Invalidate()
+}
+
+var _ scroll.Controller = (*GroupedList[any])(nil)
@@ -0,0 +1,118 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+type groupedItem struct {
+	group string
+	name  string
+}
+
+func newTestGroupedList() *GroupedList[groupedItem] {
+	items := []groupedItem{
+		{group: "Fruit", name: "apple"},
+		{group: "Veg", name: "carrot"},
+		{group: "Fruit", name: "banana"},
+		{group: "Veg", name: "leek"},
+	}
+	adapter := NewSliceAdapter(items, func(item groupedItem, index int, selected, checked bool, ctx runtime.RenderContext) {
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item.name, backend.DefaultStyle())
+	})
+	list := NewGroupedList(adapter, func(item groupedItem) string { return item.group })
+	list.Focus()
+	return list
+}
+
+func TestGroupedList_DownSkipsHeader(t *testing.T) {
+	list := newTestGroupedList()
+	if list.SelectedIndex() != 0 {
+		t.Fatalf("initial selection = %d, want 0", list.SelectedIndex())
+	}
+
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if item, _ := list.SelectedItem(); item.name != "banana" {
+		t.Fatalf("selected = %q, want banana", item.name)
+	}
+
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if item, _ := list.SelectedItem(); item.name != "carrot" {
+		t.Fatalf("selected = %q, want carrot (first of next group)", item.name)
+	}
+	if list.SelectedIndex() != 1 {
+		t.Fatalf("selected flat index = %d, want 1", list.SelectedIndex())
+	}
+
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if item, _ := list.SelectedItem(); item.name != "banana" {
+		t.Fatalf("selected = %q, want banana after moving up", item.name)
+	}
+}
+
+func TestGroupedList_OnSelectPassesFlatIndex(t *testing.T) {
+	list := newTestGroupedList()
+	var gotIndex int
+	var gotItem groupedItem
+	list.OnSelect(func(index int, item groupedItem) {
+		gotIndex = index
+		gotItem = item
+	})
+
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+
+	if gotIndex != 3 || gotItem.name != "leek" {
+		t.Fatalf("OnSelect = (%d, %q), want (3, leek)", gotIndex, gotItem.name)
+	}
+}
+
+func TestGroupedList_RenderHeaders(t *testing.T) {
+	list := newTestGroupedList()
+	list.Layout(runtime.Rect{Width: 10, Height: 6})
+	buf := runtime.NewBuffer(10, 6)
+	list.Render(runtime.RenderContext{Buffer: buf})
+
+	lines := strings.Split(buf.SnapshotText(), "\n")
+	want := []string{"Fruit", "apple", "banana", "Veg", "carrot", "leek"}
+	for i, w := range want {
+		if strings.TrimSpace(lines[i]) != w {
+			t.Fatalf("line %d = %q, want %q", i, lines[i], w)
+		}
+	}
+	header := buf.Get(0, 0).Style.Attributes()
+	if header&backend.AttrBold == 0 || header&backend.AttrUnderline == 0 {
+		t.Fatalf("header attrs = %v, want bold and underline", header)
+	}
+}
+
+func TestGroupedList_CachesGroups(t *testing.T) {
+	list := newTestGroupedList()
+	calls := 0
+	list.SetGroupKey(func(item groupedItem) string {
+		calls++
+		return item.group
+	})
+	list.Layout(runtime.Rect{Width: 10, Height: 6})
+	buf := runtime.NewBuffer(10, 6)
+	for i := 0; i < 3; i++ {
+		list.Measure(runtime.Constraints{MaxWidth: 10, MaxHeight: 6})
+		list.Render(runtime.RenderContext{Buffer: buf})
+		list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	}
+	if calls != 4 {
+		t.Fatalf("groupKey calls = %d, want one pass over 4 items", calls)
+	}
+
+	list.SetGroupKey(func(item groupedItem) string { return "All" })
+	list.Render(runtime.RenderContext{Buffer: buf})
+	if got := strings.TrimSpace(strings.Split(buf.SnapshotText(), "\n")[0]); got != "All" {
+		t.Fatalf("first line = %q, want the regrouped header", got)
+	}
+}
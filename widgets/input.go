@@ -3,26 +3,47 @@ package widgets
 import (
 	"strings"
 
+	"github.com/mattn/go-runewidth"
+
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/clipboard"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/theme"
 )
 
 // Input is a text input widget with cursor support.
 type Input struct {
 	FocusableBase
 
-	text        strings.Builder
-	cursorPos   int
+	runes       []rune
+	cursorPos   int // rune index into runes
 	style       backend.Style
 	focusStyle  backend.Style
 	placeholder string
 	services    runtime.Services
+	suggest     func(text string) string
+	suggestion  string
+	tooltip     string
+	mask        rune
+
+	filter        func(r rune) bool
+	validator     func(text string) error
+	validationErr error
+	errorStyle    backend.Style
+	styleRole     themeRole
+	focusRole     themeRole
+	errorRole     themeRole
+
+	// Selection runs from anchor to cursorPos while hasAnchor is set.
+	anchor    int
+	hasAnchor bool
 
 	// Callbacks
 	onSubmit func(text string)
 	onChange func(text string)
+	onFocus  func()
+	onBlur   func(text string)
 }
 
 // NewInput creates a new input widget.
@@ -30,6 +51,7 @@ func NewInput() *Input {
 	return &Input{
 		style:      backend.DefaultStyle(),
 		focusStyle: backend.DefaultStyle().Bold(true),
+		errorStyle: backend.DefaultStyle().Foreground(backend.ColorRed),
 	}
 }
 
@@ -43,21 +65,147 @@ func (i *Input) Unbind() {
 	i.services = runtime.Services{}
 }
 
+// SetTooltip sets the tooltip text and returns for chaining.
+func (i *Input) SetTooltip(text string) *Input {
+	if i == nil {
+		return nil
+	}
+	i.tooltip = text
+	return i
+}
+
+// Tooltip returns the tooltip text.
+func (i *Input) Tooltip() string {
+	if i == nil {
+		return ""
+	}
+	return i.tooltip
+}
+
 // SetPlaceholder sets the placeholder text shown when empty.
 func (i *Input) SetPlaceholder(text string) {
 	i.placeholder = text
 }
 
+// SetMask draws every character as r, for passwords and other secrets. Text
+// still returns the real text, and copying or cutting masked text is refused.
+// A zero rune turns masking off.
+func (i *Input) SetMask(r rune) {
+	if i == nil {
+		return
+	}
+	i.mask = r
+	i.suggestion = ""
+}
+
+// SetFilter rejects typed or pasted runes for which fn returns false.
+// Rejected runes are dropped silently. A nil fn accepts everything.
+func (i *Input) SetFilter(fn func(r rune) bool) {
+	if i == nil {
+		return
+	}
+	i.filter = fn
+}
+
+// SetValidator sets a check that runs now and after every change. While it
+// returns an error the input, including its placeholder, is drawn in the
+// error style.
+func (i *Input) SetValidator(fn func(text string) error) {
+	if i == nil {
+		return
+	}
+	i.validator = fn
+	i.validate()
+}
+
+// ValidationError returns the error from the last validation, if any.
+func (i *Input) ValidationError() error {
+	if i == nil {
+		return nil
+	}
+	return i.validationErr
+}
+
+// SetErrorStyle sets the style used while validation fails.
+func (i *Input) SetErrorStyle(style backend.Style) {
+	if i == nil {
+		return
+	}
+	i.errorStyle = style
+}
+
+// SetErrorRole sets the validation error style to the active theme's
+// token for role.
+func (i *Input) SetErrorRole(role theme.Role) {
+	if i == nil {
+		return
+	}
+	i.SetErrorStyle(i.errorRole.set(role))
+}
+
+func (i *Input) validate() {
+	i.validationErr = nil
+	if i.validator != nil {
+		i.validationErr = i.validator(string(i.runes))
+	}
+}
+
 // SetStyle sets the normal style.
 func (i *Input) SetStyle(style backend.Style) {
 	i.style = style
 }
 
+// SetRole sets the normal style to the active theme's token for role.
+func (i *Input) SetRole(role theme.Role) {
+	if i == nil {
+		return
+	}
+	i.SetStyle(i.styleRole.set(role))
+}
+
 // SetFocusStyle sets the focused style.
 func (i *Input) SetFocusStyle(style backend.Style) {
 	i.focusStyle = style
 }
 
+// SetFocusRole sets the focused style to the active theme's token for role.
+func (i *Input) SetFocusRole(role theme.Role) {
+	if i == nil {
+		return
+	}
+	i.SetFocusStyle(i.focusRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: styles set by role follow the
+// new active theme.
+func (i *Input) ApplyTheme() {
+	if i == nil {
+		return
+	}
+	if style, ok := i.styleRole.refresh(i.style); ok {
+		i.SetStyle(style)
+	}
+	if style, ok := i.focusRole.refresh(i.focusStyle); ok {
+		i.SetFocusStyle(style)
+	}
+	if style, ok := i.errorRole.refresh(i.errorStyle); ok {
+		i.SetErrorStyle(style)
+	}
+}
+
+// SetSuggestion sets a provider for ghost-text suggestions.
+// The provider is called synchronously after each edit; a non-empty result is
+// shown dimmed after the cursor and accepted with Tab or Right at the end of the text.
+func (i *Input) SetSuggestion(provider func(text string) string) {
+	i.suggest = provider
+	i.suggestion = ""
+}
+
+// Suggestion returns the ghost-text suggestion currently shown.
+func (i *Input) Suggestion() string {
+	return i.suggestion
+}
+
 // OnSubmit sets the callback for when Enter is pressed.
 func (i *Input) OnSubmit(fn func(text string)) {
 	i.onSubmit = fn
@@ -68,25 +216,106 @@ func (i *Input) OnChange(fn func(text string)) {
 	i.onChange = fn
 }
 
+// OnFocus sets the callback for when the input gains focus.
+func (i *Input) OnFocus(fn func()) {
+	i.onFocus = fn
+}
+
+// OnBlur sets the callback for when the input loses focus. Use it for
+// validation that should run once editing is finished.
+func (i *Input) OnBlur(fn func(text string)) {
+	i.onBlur = fn
+}
+
+// Focus marks the input as focused and fires OnFocus if it was not already.
+func (i *Input) Focus() {
+	if i == nil || i.focused {
+		return
+	}
+	i.FocusableBase.Focus()
+	if i.onFocus != nil {
+		i.onFocus()
+	}
+}
+
+// Blur fires OnBlur if the input was focused, then marks it unfocused.
+func (i *Input) Blur() {
+	if i == nil || !i.focused {
+		return
+	}
+	if i.onBlur != nil {
+		i.onBlur(string(i.runes))
+	}
+	i.FocusableBase.Blur()
+}
+
 // Text returns the current input text.
 func (i *Input) Text() string {
-	return i.text.String()
+	return string(i.runes)
 }
 
 // SetText sets the input text and moves cursor to end.
 func (i *Input) SetText(text string) {
-	i.text.Reset()
-	i.text.WriteString(text)
-	i.cursorPos = i.text.Len()
+	i.runes = []rune(text)
+	i.cursorPos = len(i.runes)
+	i.suggestion = ""
+	i.hasAnchor = false
+	i.validate()
 }
 
 // Clear clears the input text.
 func (i *Input) Clear() {
-	i.text.Reset()
+	i.runes = i.runes[:0]
 	i.cursorPos = 0
+	i.suggestion = ""
+	i.hasAnchor = false
+	i.validate()
+}
+
+// SelectAll selects the whole text and moves the cursor to the end.
+func (i *Input) SelectAll() {
+	if i == nil {
+		return
+	}
+	i.anchor = 0
+	i.hasAnchor = len(i.runes) > 0
+	i.cursorPos = len(i.runes)
+	i.suggestion = ""
+}
+
+// SelectedText returns the selected text, or "" when nothing is selected.
+func (i *Input) SelectedText() string {
+	if i == nil {
+		return ""
+	}
+	start, end, ok := i.selection()
+	if !ok {
+		return ""
+	}
+	return string(i.runes[start:end])
+}
+
+// selection returns the selected rune range, if any.
+func (i *Input) selection() (start, end int, ok bool) {
+	if !i.hasAnchor || i.anchor == i.cursorPos {
+		return 0, 0, false
+	}
+	return min(i.anchor, i.cursorPos), max(i.anchor, i.cursorPos), true
 }
 
-// CursorPos returns the current cursor position.
+// deleteSelection removes the selected text and drops the selection.
+func (i *Input) deleteSelection() bool {
+	start, end, ok := i.selection()
+	i.hasAnchor = false
+	if !ok {
+		return false
+	}
+	i.runes = append(i.runes[:start], i.runes[end:]...)
+	i.cursorPos = start
+	return true
+}
+
+// CursorPos returns the cursor position as a rune index.
 func (i *Input) CursorPos() int {
 	return i.cursorPos
 }
@@ -111,14 +340,15 @@ func (i *Input) Render(ctx runtime.RenderContext) {
 	if i.focused {
 		style = i.focusStyle
 	}
+	if i.validationErr != nil {
+		style = i.errorStyle
+	}
 
 	// Clear the input area
 	ctx.Buffer.Fill(bounds, ' ', style)
 
-	text := i.text.String()
-
 	// Show placeholder if empty and not focused
-	if text == "" && !i.focused && i.placeholder != "" {
+	if len(i.runes) == 0 && !i.focused && i.placeholder != "" {
 		placeholderStyle := style.Dim(true)
 		display := i.placeholder
 		if len(display) > bounds.Width {
@@ -128,51 +358,170 @@ func (i *Input) Render(ctx runtime.RenderContext) {
 		return
 	}
 
+	runes := i.displayRunes()
+
 	// Calculate visible portion of text
 	// Scroll so cursor is always visible
 	visibleStart := 0
-	if i.cursorPos >= bounds.Width {
-		visibleStart = i.cursorPos - bounds.Width + 1
-	}
-
-	visibleEnd := visibleStart + bounds.Width
-	if visibleEnd > len(text) {
-		visibleEnd = len(text)
-	}
-
-	visible := ""
-	if visibleStart < len(text) {
-		visible = text[visibleStart:visibleEnd]
+	for visibleStart < i.cursorPos && runesWidth(runes[visibleStart:i.cursorPos]) >= bounds.Width {
+		visibleStart++
 	}
+	visible := runewidth.Truncate(string(runes[visibleStart:]), bounds.Width, "")
 
 	// Draw text
 	ctx.Buffer.SetString(bounds.X, bounds.Y, visible, style)
+	if start, end, ok := i.selection(); ok && i.focused && end > visibleStart {
+		start = max(start, visibleStart)
+		x := bounds.X + runesWidth(runes[visibleStart:start])
+		if width := bounds.X + bounds.Width - x; width > 0 {
+			selected := runewidth.Truncate(string(runes[start:end]), width, "")
+			ctx.Buffer.SetString(x, bounds.Y, selected, style.Reverse(true))
+		}
+	}
 
 	// Draw cursor if focused (by inverting the cell)
 	if i.focused {
-		cursorX := bounds.X + i.cursorPos - visibleStart
+		cursorX := bounds.X + runesWidth(runes[visibleStart:i.cursorPos])
 		if cursorX >= bounds.X && cursorX < bounds.X+bounds.Width {
 			var cursorChar rune = ' '
-			if i.cursorPos < len(text) {
-				cursorChar = rune(text[i.cursorPos])
+			if i.cursorPos < len(runes) {
+				cursorChar = runes[i.cursorPos]
 			}
 			cursorStyle := style.Reverse(true)
+			if i.showSuggestion() {
+				i.renderSuggestion(ctx, cursorX, bounds, style)
+				cursorChar = []rune(i.suggestion)[0]
+				cursorStyle = cursorStyle.Dim(true)
+			}
 			ctx.Buffer.Set(cursorX, bounds.Y, cursorChar, cursorStyle)
 		}
 	}
 }
 
+// displayRunes returns the runes to draw, masked if a mask is set.
+func (i *Input) displayRunes() []rune {
+	if i.mask == 0 {
+		return i.runes
+	}
+	masked := make([]rune, len(i.runes))
+	for idx := range masked {
+		masked[idx] = i.mask
+	}
+	return masked
+}
+
+func (i *Input) showSuggestion() bool {
+	return i.suggestion != "" && i.cursorPos == len(i.runes)
+}
+
+// runesWidth returns the display width of runes.
+func runesWidth(runes []rune) int {
+	width := 0
+	for _, r := range runes {
+		width += runewidth.RuneWidth(r)
+	}
+	return width
+}
+
+// renderSuggestion draws the ghost text from x to the end of the input.
+func (i *Input) renderSuggestion(ctx runtime.RenderContext, x int, bounds runtime.Rect, style backend.Style) {
+	width := bounds.X + bounds.Width - x
+	if width <= 0 {
+		return
+	}
+	ghost := runewidth.Truncate(i.suggestion, width, "")
+	ctx.Buffer.SetString(x, bounds.Y, ghost, style.Dim(true))
+}
+
 // HandleMessage processes keyboard input.
 func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if !i.focused {
 		return runtime.Unhandled()
 	}
+	if _, ok := msg.(runtime.SelectAllMsg); ok {
+		i.SelectAll()
+		return runtime.Handled()
+	}
 
 	key, ok := msg.(runtime.KeyMsg)
 	if !ok {
 		return runtime.Unhandled()
 	}
+	if i.hasAnchor && i.replaceSelection(key) {
+		return runtime.Handled()
+	}
+	if extendsSelection(key) && !i.hasAnchor {
+		i.anchor = i.cursorPos
+		i.hasAnchor = true
+	}
+
+	if i.showSuggestion() && ((key.Key == terminal.KeyTab && !key.Shift) || (key.Key == terminal.KeyRight && !key.Ctrl && !key.Shift)) {
+		suggestion := i.suggestion
+		i.suggestion = ""
+		i.insertText(suggestion)
+		i.updateSuggestion()
+		return runtime.Handled()
+	}
+
+	before := string(i.runes)
+	result := i.handleKey(key)
+	if string(i.runes) != before {
+		i.updateSuggestion()
+	} else {
+		i.suggestion = ""
+	}
+	return result
+}
+
+// updateSuggestion queries the suggestion provider for the current text.
+func (i *Input) updateSuggestion() {
+	i.suggestion = ""
+	if i.suggest != nil && i.mask == 0 {
+		i.suggestion = i.suggest(string(i.runes))
+	}
+}
+
+// replaceSelection applies key to an active selection. Deleting keys remove
+// the selected text and report true; typing removes it before the rune is
+// inserted. Clipboard keys and Shift+movement keep the selection and any
+// other key drops it.
+func (i *Input) replaceSelection(key runtime.KeyMsg) bool {
+	switch key.Key {
+	case terminal.KeyCtrlC, terminal.KeyCtrlX, terminal.KeyCtrlV:
+		return false
+	case terminal.KeyBackspace, terminal.KeyDelete:
+		if i.deleteSelection() {
+			i.notifyChange()
+			return true
+		}
+		return false
+	case terminal.KeyRune:
+		if i.filter != nil && !i.filter(key.Rune) {
+			return true
+		}
+		i.deleteSelection()
+		return false
+	}
+	if !extendsSelection(key) {
+		i.hasAnchor = false
+	}
+	return false
+}
+
+// extendsSelection reports whether key is a Shift+movement key, which moves
+// the cursor while keeping the selection anchor in place.
+func extendsSelection(key runtime.KeyMsg) bool {
+	if !key.Shift {
+		return false
+	}
+	switch key.Key {
+	case terminal.KeyLeft, terminal.KeyRight, terminal.KeyUp, terminal.KeyDown, terminal.KeyHome, terminal.KeyEnd:
+		return true
+	}
+	return false
+}
 
+func (i *Input) handleKey(key runtime.KeyMsg) runtime.HandleResult {
 	switch key.Key {
 	case terminal.KeyCtrlC:
 		if i.copyToClipboard() {
@@ -187,29 +536,23 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 			return runtime.Handled()
 		}
 	case terminal.KeyEnter:
+		text := string(i.runes)
 		if i.onSubmit != nil {
-			text := i.text.String()
 			i.onSubmit(text)
 		}
-		return runtime.WithCommand(runtime.Submit{Text: i.text.String()})
+		return runtime.WithCommand(runtime.Submit{Text: text})
 
 	case terminal.KeyBackspace:
 		if i.cursorPos > 0 {
-			text := i.text.String()
-			i.text.Reset()
-			i.text.WriteString(text[:i.cursorPos-1])
-			i.text.WriteString(text[i.cursorPos:])
+			i.runes = append(i.runes[:i.cursorPos-1], i.runes[i.cursorPos:]...)
 			i.cursorPos--
 			i.notifyChange()
 		}
 		return runtime.Handled()
 
 	case terminal.KeyDelete:
-		text := i.text.String()
-		if i.cursorPos < len(text) {
-			i.text.Reset()
-			i.text.WriteString(text[:i.cursorPos])
-			i.text.WriteString(text[i.cursorPos+1:])
+		if i.cursorPos < len(i.runes) {
+			i.runes = append(i.runes[:i.cursorPos], i.runes[i.cursorPos+1:]...)
 			i.notifyChange()
 		}
 		return runtime.Handled()
@@ -227,7 +570,7 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		if key.Ctrl {
 			// Word right
 			i.cursorPos = i.wordBoundaryRight()
-		} else if i.cursorPos < i.text.Len() {
+		} else if i.cursorPos < len(i.runes) {
 			i.cursorPos++
 		}
 		return runtime.Handled()
@@ -237,18 +580,12 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		return runtime.Handled()
 
 	case terminal.KeyEnd:
-		i.cursorPos = i.text.Len()
+		i.cursorPos = len(i.runes)
 		return runtime.Handled()
 
 	case terminal.KeyRune:
 		// Insert character
-		text := i.text.String()
-		i.text.Reset()
-		i.text.WriteString(text[:i.cursorPos])
-		i.text.WriteRune(key.Rune)
-		i.text.WriteString(text[i.cursorPos:])
-		i.cursorPos++
-		i.notifyChange()
+		i.insertRunes([]rune{key.Rune})
 		return runtime.Handled()
 
 	case terminal.KeyTab:
@@ -266,35 +603,47 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 }
 
 func (i *Input) notifyChange() {
+	i.validate()
 	if i.onChange != nil {
-		i.onChange(i.text.String())
+		i.onChange(string(i.runes))
 	}
 }
 
-// ClipboardCopy returns the current text.
+// ClipboardCopy returns the selected text, or the whole text when nothing is
+// selected. Masked text is never copied.
 func (i *Input) ClipboardCopy() (string, bool) {
-	if i == nil {
+	if i == nil || i.mask != 0 {
 		return "", false
 	}
-	return i.text.String(), true
+	if text := i.SelectedText(); text != "" {
+		return text, true
+	}
+	return string(i.runes), true
 }
 
-// ClipboardCut returns the current text and clears the input.
+// ClipboardCut returns the selected text and removes it, or cuts the whole
+// text when nothing is selected. Masked text is never cut.
 func (i *Input) ClipboardCut() (string, bool) {
-	if i == nil {
+	if i == nil || i.mask != 0 {
 		return "", false
 	}
-	text := i.text.String()
+	if text := i.SelectedText(); text != "" {
+		i.deleteSelection()
+		i.notifyChange()
+		return text, true
+	}
+	text := string(i.runes)
 	i.Clear()
 	i.notifyChange()
 	return text, true
 }
 
-// ClipboardPaste inserts text at the cursor.
+// ClipboardPaste inserts text at the cursor, replacing the selection.
 func (i *Input) ClipboardPaste(text string) bool {
 	if i == nil || text == "" {
 		return false
 	}
+	i.deleteSelection()
 	i.insertText(text)
 	return true
 }
@@ -341,20 +690,37 @@ func (i *Input) insertText(text string) {
 	if text == "" {
 		return
 	}
-	current := i.text.String()
-	i.text.Reset()
-	i.text.WriteString(current[:i.cursorPos])
-	i.text.WriteString(text)
-	i.text.WriteString(current[i.cursorPos:])
-	i.cursorPos += len(text)
+	i.insertRunes([]rune(text))
+}
+
+// insertRunes inserts runes at the cursor and moves the cursor past them,
+// dropping any the filter rejects.
+func (i *Input) insertRunes(runes []rune) {
+	if i.filter != nil {
+		kept := make([]rune, 0, len(runes))
+		for _, r := range runes {
+			if i.filter(r) {
+				kept = append(kept, r)
+			}
+		}
+		runes = kept
+	}
+	if len(runes) == 0 {
+		return
+	}
+	i.runes = append(i.runes[:i.cursorPos], append(runes, i.runes[i.cursorPos:]...)...)
+	i.cursorPos += len(runes)
 	i.notifyChange()
 }
 
 var _ clipboard.Target = (*Input)(nil)
 
 func (i *Input) wordBoundaryLeft() int {
-	text := i.text.String()
+	text := i.runes
 	pos := i.cursorPos - 1
+	if pos <= 0 {
+		return 0
+	}
 
 	// Skip whitespace
 	for pos > 0 && text[pos] == ' ' {
@@ -368,7 +734,7 @@ func (i *Input) wordBoundaryLeft() int {
 }
 
 func (i *Input) wordBoundaryRight() int {
-	text := i.text.String()
+	text := i.runes
 	pos := i.cursorPos
 
 	// Skip word characters
@@ -394,6 +760,12 @@ type MultilineInput struct {
 	focusStyle backend.Style
 	services   runtime.Services
 
+	// Selection runs from the anchor to the cursor while hasAnchor is set.
+	anchorX, anchorY int
+	hasAnchor        bool
+
+	history editHistory
+
 	onSubmit func(text string)
 	onChange func(text string)
 }
@@ -430,6 +802,63 @@ func (m *MultilineInput) SetText(text string) {
 	}
 	m.cursorY = len(m.lines) - 1
 	m.cursorX = len(m.lines[m.cursorY])
+	m.hasAnchor = false
+	m.history.reset()
+}
+
+// SetUndoLimit caps the number of undo steps kept; n <= 0 restores the
+// default of 100.
+func (m *MultilineInput) SetUndoLimit(n int) {
+	if m == nil {
+		return
+	}
+	m.history.setLimit(n)
+}
+
+// Undo reverts the last edit and reports whether there was one.
+func (m *MultilineInput) Undo() bool {
+	if m == nil {
+		return false
+	}
+	snap, ok := m.history.undoTo(m.snapshot())
+	if !ok {
+		return false
+	}
+	m.restore(snap)
+	return true
+}
+
+// Redo reapplies the last undone edit and reports whether there was one.
+func (m *MultilineInput) Redo() bool {
+	if m == nil {
+		return false
+	}
+	snap, ok := m.history.redoTo(m.snapshot())
+	if !ok {
+		return false
+	}
+	m.restore(snap)
+	return true
+}
+
+func (m *MultilineInput) snapshot() editSnapshot {
+	return editSnapshot{text: m.Text(), cursor: m.offset(m.cursorX, m.cursorY)}
+}
+
+func (m *MultilineInput) restore(snap editSnapshot) {
+	m.lines = strings.Split(snap.text, "\n")
+	m.hasAnchor = false
+	m.setCursorOffset(snap.cursor)
+	m.notifyChange()
+}
+
+// recordEdit adds an undo step if the text changed since before.
+func (m *MultilineInput) recordEdit(before editSnapshot, typing bool) {
+	if m.Text() == before.text {
+		m.history.seal()
+		return
+	}
+	m.history.record(before, m.offset(m.cursorX, m.cursorY), typing)
 }
 
 // Clear clears all content.
@@ -438,6 +867,77 @@ func (m *MultilineInput) Clear() {
 	m.cursorX = 0
 	m.cursorY = 0
 	m.scrollY = 0
+	m.hasAnchor = false
+}
+
+// SelectAll selects the whole text and moves the cursor to the end.
+func (m *MultilineInput) SelectAll() {
+	if m == nil {
+		return
+	}
+	m.anchorX, m.anchorY = 0, 0
+	m.hasAnchor = m.Text() != ""
+	m.cursorY = len(m.lines) - 1
+	m.cursorX = len(m.lines[m.cursorY])
+	m.ensureCursorVisible()
+}
+
+// SelectedText returns the selected text, or "" when nothing is selected.
+func (m *MultilineInput) SelectedText() string {
+	if m == nil {
+		return ""
+	}
+	start, end, ok := m.selection()
+	if !ok {
+		return ""
+	}
+	return m.Text()[start:end]
+}
+
+// selection returns the selected range as offsets into Text, if any.
+func (m *MultilineInput) selection() (start, end int, ok bool) {
+	if !m.hasAnchor {
+		return 0, 0, false
+	}
+	anchor := m.offset(m.anchorX, m.anchorY)
+	cursor := m.offset(m.cursorX, m.cursorY)
+	if anchor == cursor {
+		return 0, 0, false
+	}
+	return min(anchor, cursor), max(anchor, cursor), true
+}
+
+// offset converts a line and column to an offset into Text.
+func (m *MultilineInput) offset(x, y int) int {
+	offset := x
+	for i := 0; i < y && i < len(m.lines); i++ {
+		offset += len(m.lines[i]) + 1
+	}
+	return offset
+}
+
+// deleteSelection removes the selected text and drops the selection.
+func (m *MultilineInput) deleteSelection() bool {
+	start, end, ok := m.selection()
+	m.hasAnchor = false
+	if !ok {
+		return false
+	}
+	text := m.Text()
+	m.lines = strings.Split(text[:start]+text[end:], "\n")
+	m.setCursorOffset(start)
+	return true
+}
+
+// setCursorOffset moves the cursor to an offset into Text.
+func (m *MultilineInput) setCursorOffset(offset int) {
+	m.cursorY, m.cursorX = 0, offset
+	for m.cursorY < len(m.lines)-1 && m.cursorX > len(m.lines[m.cursorY]) {
+		m.cursorX -= len(m.lines[m.cursorY]) + 1
+		m.cursorY++
+	}
+	m.cursorX = min(m.cursorX, len(m.lines[m.cursorY]))
+	m.ensureCursorVisible()
 }
 
 // OnSubmit sets the callback (Ctrl+Enter to submit).
@@ -479,6 +979,8 @@ func (m *MultilineInput) Render(ctx runtime.RenderContext) {
 	ctx.Buffer.Fill(bounds, ' ', style)
 
 	// Draw visible lines
+	selStart, selEnd, selected := m.selection()
+	selected = selected && m.focused
 	for i := 0; i < bounds.Height; i++ {
 		lineIdx := m.scrollY + i
 		if lineIdx >= len(m.lines) {
@@ -490,6 +992,14 @@ func (m *MultilineInput) Render(ctx runtime.RenderContext) {
 			line = line[:bounds.Width]
 		}
 		ctx.Buffer.SetString(bounds.X, bounds.Y+i, line, style)
+		if selected {
+			lineStart := m.offset(0, lineIdx)
+			from := min(max(selStart-lineStart, 0), len(line))
+			to := min(max(selEnd-lineStart, 0), len(line))
+			if from < to {
+				ctx.Buffer.SetString(bounds.X+from, bounds.Y+i, line[from:to], style.Reverse(true))
+			}
+		}
 	}
 
 	// Draw cursor
@@ -513,11 +1023,43 @@ func (m *MultilineInput) HandleMessage(msg runtime.Message) runtime.HandleResult
 	if !m.focused {
 		return runtime.Unhandled()
 	}
+	if _, ok := msg.(runtime.SelectAllMsg); ok {
+		m.SelectAll()
+		return runtime.Handled()
+	}
 
 	key, ok := msg.(runtime.KeyMsg)
 	if !ok {
 		return runtime.Unhandled()
 	}
+	switch {
+	case key.Key == terminal.KeyCtrlY, key.Key == terminal.KeyCtrlZ && key.Shift:
+		if m.Redo() {
+			return runtime.Handled()
+		}
+		return runtime.Unhandled()
+	case key.Key == terminal.KeyCtrlZ:
+		if m.Undo() {
+			return runtime.Handled()
+		}
+		return runtime.Unhandled()
+	}
+
+	before := m.snapshot()
+	_, _, selected := m.selection()
+	result := m.handleKey(key)
+	m.recordEdit(before, key.Key == terminal.KeyRune && !selected)
+	return result
+}
+
+func (m *MultilineInput) handleKey(key runtime.KeyMsg) runtime.HandleResult {
+	if m.hasAnchor && m.replaceSelection(key) {
+		return runtime.Handled()
+	}
+	if extendsSelection(key) && !m.hasAnchor {
+		m.anchorX, m.anchorY = m.cursorX, m.cursorY
+		m.hasAnchor = true
+	}
 
 	switch key.Key {
 	case terminal.KeyCtrlC:
@@ -603,10 +1145,19 @@ func (m *MultilineInput) HandleMessage(msg runtime.Message) runtime.HandleResult
 		}
 		return runtime.Handled()
 
+	case terminal.KeyHome:
+		m.cursorX = 0
+		return runtime.Handled()
+
+	case terminal.KeyEnd:
+		m.cursorX = len(m.lines[m.cursorY])
+		return runtime.Handled()
+
 	case terminal.KeyRune:
 		line := m.lines[m.cursorY]
-		m.lines[m.cursorY] = line[:m.cursorX] + string(key.Rune) + line[m.cursorX:]
-		m.cursorX++
+		text := string(key.Rune)
+		m.lines[m.cursorY] = line[:m.cursorX] + text + line[m.cursorX:]
+		m.cursorX += len(text)
 		m.notifyChange()
 		return runtime.Handled()
 
@@ -617,6 +1168,32 @@ func (m *MultilineInput) HandleMessage(msg runtime.Message) runtime.HandleResult
 	return runtime.Unhandled()
 }
 
+// replaceSelection applies key to an active selection; see Input.replaceSelection.
+func (m *MultilineInput) replaceSelection(key runtime.KeyMsg) bool {
+	switch key.Key {
+	case terminal.KeyCtrlC, terminal.KeyCtrlX, terminal.KeyCtrlV:
+		return false
+	case terminal.KeyBackspace, terminal.KeyDelete:
+		if m.deleteSelection() {
+			m.notifyChange()
+			return true
+		}
+		return false
+	case terminal.KeyRune:
+		m.deleteSelection()
+		return false
+	case terminal.KeyEnter:
+		if !key.Ctrl {
+			m.deleteSelection()
+		}
+		return false
+	}
+	if !extendsSelection(key) {
+		m.hasAnchor = false
+	}
+	return false
+}
+
 func (m *MultilineInput) ensureCursorVisible() {
 	if m.cursorY < m.scrollY {
 		m.scrollY = m.cursorY
@@ -631,34 +1208,58 @@ func (m *MultilineInput) notifyChange() {
 	}
 }
 
-// ClipboardCopy returns the current text.
+// ClipboardCopy returns the selected text, or the whole text when nothing is
+// selected.
 func (m *MultilineInput) ClipboardCopy() (string, bool) {
 	if m == nil {
 		return "", false
 	}
+	if text := m.SelectedText(); text != "" {
+		return text, true
+	}
 	return m.Text(), true
 }
 
-// ClipboardCut returns the current text and clears the input.
+// ClipboardCut returns the selected text and removes it, or cuts the whole
+// text when nothing is selected.
 func (m *MultilineInput) ClipboardCut() (string, bool) {
 	if m == nil {
 		return "", false
 	}
-	text := m.Text()
-	m.Clear()
-	m.notifyChange()
+	before := m.snapshot()
+	text := m.cut()
+	m.recordEdit(before, false)
 	return text, true
 }
 
-// ClipboardPaste inserts text at the cursor.
+// ClipboardPaste inserts text at the cursor, replacing the selection.
 func (m *MultilineInput) ClipboardPaste(text string) bool {
 	if m == nil || text == "" {
 		return false
 	}
-	m.insertText(text)
+	before := m.snapshot()
+	m.paste(text)
+	m.recordEdit(before, false)
 	return true
 }
 
+func (m *MultilineInput) cut() string {
+	if text := m.SelectedText(); text != "" {
+		m.deleteSelection()
+		m.notifyChange()
+		return text
+	}
+	text := m.Text()
+	m.Clear()
+	m.notifyChange()
+	return text
+}
+
+func (m *MultilineInput) paste(text string) {
+	m.deleteSelection()
+	m.insertText(text)
+}
+
 func (m *MultilineInput) copyToClipboard() bool {
 	cb := m.services.Clipboard()
 	if cb == nil || !cb.Available() {
@@ -677,11 +1278,7 @@ func (m *MultilineInput) cutToClipboard() bool {
 	if cb == nil || !cb.Available() {
 		return false
 	}
-	text, ok := m.ClipboardCut()
-	if !ok {
-		return false
-	}
-	_ = cb.Write(text)
+	_ = cb.Write(m.cut())
 	return true
 }
 
@@ -694,7 +1291,8 @@ func (m *MultilineInput) pasteFromClipboard() bool {
 	if err != nil || text == "" {
 		return false
 	}
-	return m.ClipboardPaste(text)
+	m.paste(text)
+	return true
 }
 
 func (m *MultilineInput) insertText(text string) {
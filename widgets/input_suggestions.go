@@ -0,0 +1,291 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// defaultSuggestionRows is the most suggestions shown at once by default.
+const defaultSuggestionRows = 8
+
+// InputWithSuggestions wraps an Input and shows matching suggestions in a
+// dropdown overlay as the user types. Down moves into the list, Enter inserts
+// the highlighted suggestion, and Escape closes the list.
+type InputWithSuggestions struct {
+	Base
+
+	input   *Input
+	source  func(prefix string) []string
+	items   []string
+	list    *List[string]
+	popup   *suggestionPopup
+	open    bool
+	inList  bool
+	maxRows int
+
+	style         backend.Style
+	selectedStyle backend.Style
+	onAccept      func(text string)
+}
+
+// NewInputWithSuggestions creates an input whose suggestions come from
+// source. Source is called with the input text after every edit, so it can
+// filter a static slice, read a cache, or run a query.
+func NewInputWithSuggestions(source func(prefix string) []string) *InputWithSuggestions {
+	w := &InputWithSuggestions{
+		input:         NewInput(),
+		source:        source,
+		maxRows:       defaultSuggestionRows,
+		style:         backend.DefaultStyle(),
+		selectedStyle: backend.DefaultStyle().Reverse(true),
+	}
+	w.list = NewList(NewSliceAdapter[string](nil, w.renderItem))
+	w.popup = &suggestionPopup{owner: w}
+	return w
+}
+
+// Input returns the wrapped input.
+func (w *InputWithSuggestions) Input() *Input {
+	if w == nil {
+		return nil
+	}
+	return w.input
+}
+
+// Text returns the input text.
+func (w *InputWithSuggestions) Text() string {
+	if w == nil {
+		return ""
+	}
+	return w.input.Text()
+}
+
+// Suggestions returns the suggestions currently listed.
+func (w *InputWithSuggestions) Suggestions() []string {
+	if w == nil {
+		return nil
+	}
+	return w.items
+}
+
+// IsOpen reports whether the suggestion list is showing.
+func (w *InputWithSuggestions) IsOpen() bool {
+	return w != nil && w.open
+}
+
+// SetMaxRows sets how many suggestions are shown at once (default 8).
+func (w *InputWithSuggestions) SetMaxRows(rows int) {
+	if w == nil || rows <= 0 {
+		return
+	}
+	w.maxRows = rows
+}
+
+// SetStyles sets the list style and the style of the highlighted suggestion.
+func (w *InputWithSuggestions) SetStyles(style, selected backend.Style) {
+	if w == nil {
+		return
+	}
+	w.style = style
+	w.selectedStyle = selected
+}
+
+// OnAccept sets a callback for when a suggestion is inserted.
+func (w *InputWithSuggestions) OnAccept(fn func(text string)) {
+	if w == nil {
+		return
+	}
+	w.onAccept = fn
+}
+
+// Measure delegates to the input.
+func (w *InputWithSuggestions) Measure(constraints runtime.Constraints) runtime.Size {
+	return w.input.Measure(constraints)
+}
+
+// Layout positions the input.
+func (w *InputWithSuggestions) Layout(bounds runtime.Rect) {
+	w.Base.Layout(bounds)
+	w.input.Layout(bounds)
+}
+
+// Render draws the input; the suggestion list draws in its own layer.
+func (w *InputWithSuggestions) Render(ctx runtime.RenderContext) {
+	if w == nil {
+		return
+	}
+	w.input.Render(ctx)
+}
+
+// ChildWidgets returns the wrapped input.
+func (w *InputWithSuggestions) ChildWidgets() []runtime.Widget {
+	return []runtime.Widget{w.input}
+}
+
+// HandleMessage routes list navigation while the suggestions are open and
+// passes everything else to the input.
+func (w *InputWithSuggestions) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if w == nil {
+		return runtime.Unhandled()
+	}
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok || !w.input.IsFocused() {
+		return w.input.HandleMessage(msg)
+	}
+	if w.open {
+		switch key.Key {
+		case terminal.KeyEscape:
+			return runtime.WithCommand(w.close())
+		case terminal.KeyDown:
+			if !w.inList {
+				w.inList = true
+				w.list.Focus()
+				return runtime.Handled()
+			}
+			return w.list.HandleMessage(msg)
+		case terminal.KeyUp:
+			if w.inList && w.list.SelectedIndex() == 0 {
+				w.inList = false
+				w.list.Blur()
+				return runtime.Handled()
+			}
+			if w.inList {
+				return w.list.HandleMessage(msg)
+			}
+		case terminal.KeyPageUp, terminal.KeyPageDown:
+			if w.inList {
+				return w.list.HandleMessage(msg)
+			}
+		case terminal.KeyEnter:
+			if w.inList {
+				if item, ok := w.list.SelectedItem(); ok {
+					w.accept(item)
+				}
+				return runtime.WithCommand(w.close())
+			}
+			return w.withClose(w.input.HandleMessage(msg))
+		case terminal.KeyTab:
+			return w.withClose(w.input.HandleMessage(msg))
+		}
+		if w.inList {
+			w.inList = false
+			w.list.Blur()
+		}
+	}
+
+	before := w.input.Text()
+	result := w.input.HandleMessage(msg)
+	if w.input.Text() != before {
+		if cmd := w.refresh(); cmd != nil {
+			result.Commands = append(result.Commands, cmd)
+		}
+	}
+	return result
+}
+
+// withClose prepends closing the list to result's commands.
+func (w *InputWithSuggestions) withClose(result runtime.HandleResult) runtime.HandleResult {
+	result.Handled = true
+	result.Commands = append([]runtime.Command{w.close()}, result.Commands...)
+	return result
+}
+
+// refresh queries the source and returns a command that opens or closes
+// the list, or nil when its visibility is unchanged.
+func (w *InputWithSuggestions) refresh() runtime.Command {
+	text := w.input.Text()
+	w.items = nil
+	if w.source != nil && text != "" {
+		w.items = w.source(text)
+	}
+	w.list.adapter = NewSliceAdapter(w.items, w.renderItem)
+	w.list.selected = 0
+	w.list.offset = 0
+	if len(w.items) == 0 {
+		if w.open {
+			return w.close()
+		}
+		return nil
+	}
+	w.popup.place()
+	if w.open {
+		return nil
+	}
+	w.open = true
+	return runtime.PushOverlay{Widget: w.popup}
+}
+
+// close hides the list and returns the command that pops its layer.
+func (w *InputWithSuggestions) close() runtime.Command {
+	w.open = false
+	w.inList = false
+	w.list.Blur()
+	return runtime.PopOverlay{}
+}
+
+func (w *InputWithSuggestions) accept(item string) {
+	w.input.SetText(item)
+	w.input.notifyChange()
+	w.items = nil
+	if w.onAccept != nil {
+		w.onAccept(item)
+	}
+}
+
+func (w *InputWithSuggestions) renderItem(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
+	style := w.style
+	if selected && w.inList {
+		style = w.selectedStyle
+	}
+	bounds := ctx.Bounds
+	ctx.Buffer.Fill(bounds, ' ', style)
+	ctx.Buffer.SetString(bounds.X, bounds.Y, truncateString(item, bounds.Width), style)
+}
+
+// suggestionPopup is the overlay layer root that positions the list below
+// the input, or above it when there is more room there.
+type suggestionPopup struct {
+	Base
+	owner *InputWithSuggestions
+}
+
+// Measure fills the screen; only the list area is drawn.
+func (p *suggestionPopup) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Layout records the screen bounds and places the list.
+func (p *suggestionPopup) Layout(bounds runtime.Rect) {
+	p.Base.Layout(bounds)
+	p.place()
+}
+
+// place positions the list relative to the input, clipped to the screen.
+func (p *suggestionPopup) place() {
+	w := p.owner
+	screen := p.bounds
+	anchor := w.input.Bounds()
+	rows := min(len(w.items), w.maxRows)
+	below := screen.Y + screen.Height - (anchor.Y + anchor.Height)
+	above := anchor.Y - screen.Y
+	rect := runtime.Rect{X: anchor.X, Y: anchor.Y + anchor.Height, Width: anchor.Width, Height: rows}
+	if rows > below && above > below {
+		rect.Height = min(rows, above)
+		rect.Y = anchor.Y - rect.Height
+	}
+	w.list.Layout(rect.Intersection(screen))
+}
+
+// Render draws the list.
+func (p *suggestionPopup) Render(ctx runtime.RenderContext) {
+	if p.owner.list.bounds.Width <= 0 || p.owner.list.bounds.Height <= 0 {
+		return
+	}
+	p.owner.list.Render(ctx)
+}
+
+// HandleMessage leaves input to the owner, which sits in the layer below.
+func (p *suggestionPopup) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	return runtime.Unhandled()
+}
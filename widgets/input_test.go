@@ -0,0 +1,162 @@
+package widgets
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/theme"
+)
+
+func TestInput_FilterDropsRejectedRunes(t *testing.T) {
+	input := NewInput()
+	input.SetFilter(unicode.IsDigit)
+	input.Focus()
+	changes := 0
+	input.OnChange(func(string) { changes++ })
+
+	for _, r := range "1a2b" {
+		input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if input.Text() != "12" {
+		t.Fatalf("Text() = %q, want %q", input.Text(), "12")
+	}
+	if changes != 2 {
+		t.Fatalf("OnChange fired %d times, want 2", changes)
+	}
+
+	input.ClipboardPaste("3x4")
+	if input.Text() != "1234" {
+		t.Fatalf("after paste Text() = %q, want %q", input.Text(), "1234")
+	}
+}
+
+func TestInput_ValidatorSetsErrorStyle(t *testing.T) {
+	errRequired := errors.New("required")
+	errStyle := backend.DefaultStyle().Foreground(backend.ColorRed)
+	input := NewInput()
+	input.SetPlaceholder("Name")
+	input.SetValidator(func(text string) error {
+		if text == "" {
+			return errRequired
+		}
+		return nil
+	})
+	input.Layout(runtime.Rect{Width: 10, Height: 1})
+
+	if !errors.Is(input.ValidationError(), errRequired) {
+		t.Fatalf("ValidationError() = %v, want %v", input.ValidationError(), errRequired)
+	}
+	buf := runtime.NewBuffer(10, 1)
+	input.Render(runtime.RenderContext{Buffer: buf})
+	if cell := buf.Get(0, 0); cell.Rune != 'N' || cell.Style.FG() != errStyle.FG() {
+		t.Fatalf("placeholder cell = %q with fg %v, want error style", cell.Rune, cell.Style.FG())
+	}
+
+	input.Focus()
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'a'})
+	if input.ValidationError() != nil {
+		t.Fatalf("ValidationError() = %v, want nil", input.ValidationError())
+	}
+	input.Render(runtime.RenderContext{Buffer: buf})
+	if cell := buf.Get(0, 0); cell.Style.FG() == errStyle.FG() {
+		t.Fatal("valid input should not use the error style")
+	}
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	input.Render(runtime.RenderContext{Buffer: buf})
+	if cell := buf.Get(1, 0); cell.Style.FG() != errStyle.FG() {
+		t.Fatalf("cell fg = %v, want error style after clearing", cell.Style.FG())
+	}
+}
+
+func TestInput_RolesUseActiveTheme(t *testing.T) {
+	theme.Apply(theme.Solarized())
+	defer theme.Apply(nil)
+
+	input := NewInput()
+	input.SetText("hi")
+	input.SetRole(theme.RoleText)
+	input.SetFocusRole(theme.RolePrimary)
+	input.Layout(runtime.Rect{Width: 6, Height: 1})
+	buf := runtime.NewBuffer(6, 1)
+
+	input.Render(runtime.RenderContext{Buffer: buf})
+	if got, want := buf.Get(0, 0).Style, theme.Style(theme.RoleText); got != want {
+		t.Fatalf("style = %v, want the text role", got)
+	}
+	input.Focus()
+	input.Render(runtime.RenderContext{Buffer: buf})
+	if got, want := buf.Get(0, 0).Style.FG(), theme.Style(theme.RolePrimary).FG(); got != want {
+		t.Fatalf("focused fg = %v, want the primary role", got)
+	}
+}
+
+func TestInputWithSuggestions_SelectFromList(t *testing.T) {
+	fruits := []string{"apple", "apricot", "avocado", "banana"}
+	w := NewInputWithSuggestions(func(prefix string) []string {
+		var matches []string
+		for _, fruit := range fruits {
+			if strings.HasPrefix(fruit, prefix) {
+				matches = append(matches, fruit)
+			}
+		}
+		return matches
+	})
+	w.Layout(runtime.Rect{X: 2, Y: 4, Width: 10, Height: 1})
+	w.Input().Focus()
+
+	result := w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'a'})
+	if !hasCommand[runtime.PushOverlay](result) || !w.IsOpen() {
+		t.Fatalf("typing should open the list, commands = %v", result.Commands)
+	}
+	if len(w.Suggestions()) != 3 {
+		t.Fatalf("Suggestions() = %v, want 3 matches", w.Suggestions())
+	}
+
+	// With one row below the input, the list opens above it.
+	w.popup.Layout(runtime.Rect{Width: 20, Height: 6})
+	if got, want := w.list.Bounds(), (runtime.Rect{X: 2, Y: 1, Width: 10, Height: 3}); got != want {
+		t.Fatalf("list bounds = %+v, want %+v", got, want)
+	}
+
+	w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	result = w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if w.Text() != "apricot" {
+		t.Fatalf("Text() = %q, want %q", w.Text(), "apricot")
+	}
+	if !hasCommand[runtime.PopOverlay](result) || w.IsOpen() {
+		t.Fatal("accepting a suggestion should close the list")
+	}
+}
+
+func TestInputWithSuggestions_EscapeCloses(t *testing.T) {
+	w := NewInputWithSuggestions(func(prefix string) []string {
+		return []string{prefix + "1", prefix + "2"}
+	})
+	w.Layout(runtime.Rect{Width: 10, Height: 1})
+	w.Input().Focus()
+
+	w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'x'})
+	result := w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if !hasCommand[runtime.PopOverlay](result) || w.IsOpen() {
+		t.Fatal("Escape should close the list")
+	}
+	if w.Text() != "x" {
+		t.Fatalf("Text() = %q, want input unchanged", w.Text())
+	}
+}
+
+func hasCommand[C runtime.Command](result runtime.HandleResult) bool {
+	for _, cmd := range result.Commands {
+		if _, ok := cmd.(C); ok {
+			return true
+		}
+	}
+	return false
+}
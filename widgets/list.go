@@ -1,21 +1,26 @@
 package widgets
 
 import (
+	"fmt"
+	"sort"
+
+	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/scroll"
 	"github.com/odvcencio/fluffy-ui/state"
 	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/theme"
 )
 
 // RenderFunc renders an item.
-type RenderFunc[T any] func(item T, index int, selected bool, ctx runtime.RenderContext)
+type RenderFunc[T any] func(item T, index int, selected, checked bool, ctx runtime.RenderContext)
 
 // ListAdapter provides data for list widgets.
 type ListAdapter[T any] interface {
 	Count() int
 	Item(index int) T
-	Render(item T, index int, selected bool, ctx runtime.RenderContext)
+	Render(item T, index int, selected, checked bool, ctx runtime.RenderContext)
 }
 
 // SliceAdapter adapts a slice to a ListAdapter.
@@ -47,11 +52,11 @@ func (s *SliceAdapter[T]) Item(index int) T {
 }
 
 // Render renders the item.
-func (s *SliceAdapter[T]) Render(item T, index int, selected bool, ctx runtime.RenderContext) {
+func (s *SliceAdapter[T]) Render(item T, index int, selected, checked bool, ctx runtime.RenderContext) {
 	if s == nil || s.render == nil {
 		return
 	}
-	s.render(item, index, selected, ctx)
+	s.render(item, index, selected, checked, ctx)
 }
 
 // SignalAdapter adapts a signal slice to a ListAdapter.
@@ -87,32 +92,108 @@ func (s *SignalAdapter[T]) Item(index int) T {
 }
 
 // Render draws an item.
-func (s *SignalAdapter[T]) Render(item T, index int, selected bool, ctx runtime.RenderContext) {
+func (s *SignalAdapter[T]) Render(item T, index int, selected, checked bool, ctx runtime.RenderContext) {
 	if s == nil || s.render == nil {
 		return
 	}
-	s.render(item, index, selected, ctx)
+	s.render(item, index, selected, checked, ctx)
 }
 
 // List renders a list of items.
 type List[T any] struct {
 	FocusableBase
+	accessibility.Base
 	adapter       ListAdapter[T]
 	selected      int
 	offset        int
 	onSelect      func(index int, item T)
 	style         backend.Style
 	selectedStyle backend.Style
+	header        string
+	headerStyle   backend.Style
+	headerRole    themeRole
+	hasHeader     bool
+	itemHeight    int
+
+	// multiSelect shows a checkbox before each item; checked holds the
+	// checked item indices.
+	multiSelect bool
+	checked     map[int]bool
+	onCheck     func(index int, item T, checked bool)
 }
 
+// listCheckboxWidth is the width of the "[x] " marker in multi-select lists.
+const listCheckboxWidth = 4
+
 // NewList creates a list widget.
 func NewList[T any](adapter ListAdapter[T]) *List[T] {
-	return &List[T]{
+	l := &List[T]{
 		adapter:       adapter,
 		selected:      0,
 		style:         backend.DefaultStyle(),
 		selectedStyle: backend.DefaultStyle().Reverse(true),
 	}
+	l.Base.Role = accessibility.RoleList
+	if observable, ok := adapter.(*ObservableSliceAdapter[T]); ok {
+		observable.onEvent = l.applySliceEvent
+	}
+	return l
+}
+
+// applySliceEvent keeps the selection and checked items on the same items
+// after an ObservableSliceAdapter mutation.
+func (l *List[T]) applySliceEvent(event state.SliceEvent[T]) {
+	switch event.Op {
+	case state.SliceRemove:
+		if event.Index < l.selected {
+			l.selected--
+		}
+		if len(l.checked) > 0 {
+			checked := make(map[int]bool, len(l.checked))
+			for index := range l.checked {
+				switch {
+				case index < event.Index:
+					checked[index] = true
+				case index > event.Index:
+					checked[index-1] = true
+				}
+			}
+			l.checked = checked
+		}
+	case state.SliceReplace:
+		l.checked = nil
+	}
+	l.Invalidate()
+}
+
+// AccessibleValue reports the label of the selected item. Strings and
+// fmt.Stringer values are used as-is; other items are formatted with %v.
+func (l *List[T]) AccessibleValue() *accessibility.ValueInfo {
+	if l == nil || l.adapter == nil {
+		return nil
+	}
+	count := l.adapter.Count()
+	if l.selected < 0 || l.selected >= count {
+		return nil
+	}
+	return &accessibility.ValueInfo{
+		Min:     0,
+		Max:     float64(count - 1),
+		Current: float64(l.selected),
+		Text:    itemLabel(l.adapter.Item(l.selected)),
+	}
+}
+
+// itemLabel formats a list item for accessibility output.
+func itemLabel(item any) string {
+	switch v := item.(type) {
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(item)
+	}
 }
 
 // OnSelect registers a selection handler.
@@ -123,13 +204,183 @@ func (l *List[T]) OnSelect(fn func(index int, item T)) {
 	l.onSelect = fn
 }
 
+// SetMultiSelect turns checkboxes on or off. In multi-select mode Space
+// toggles the selected item's checkbox without moving the selection.
+func (l *List[T]) SetMultiSelect(enabled bool) {
+	if l == nil {
+		return
+	}
+	l.multiSelect = enabled
+	l.Invalidate()
+}
+
+// MultiSelect reports whether checkboxes are shown.
+func (l *List[T]) MultiSelect() bool {
+	return l != nil && l.multiSelect
+}
+
+// OnCheck registers a handler called when Space toggles an item.
+func (l *List[T]) OnCheck(fn func(index int, item T, checked bool)) {
+	if l == nil {
+		return
+	}
+	l.onCheck = fn
+}
+
+// CheckedIndices returns the checked item indices in ascending order.
+func (l *List[T]) CheckedIndices() []int {
+	if l == nil || len(l.checked) == 0 {
+		return nil
+	}
+	indices := make([]int, 0, len(l.checked))
+	for index := range l.checked {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// CheckedItems returns the checked items in list order.
+func (l *List[T]) CheckedItems() []T {
+	if l == nil || l.adapter == nil {
+		return nil
+	}
+	indices := l.CheckedIndices()
+	items := make([]T, 0, len(indices))
+	count := l.adapter.Count()
+	for _, index := range indices {
+		if index < count {
+			items = append(items, l.adapter.Item(index))
+		}
+	}
+	return items
+}
+
+// SetAllChecked checks or unchecks every item.
+func (l *List[T]) SetAllChecked(checked bool) {
+	if l == nil {
+		return
+	}
+	l.checked = nil
+	if checked && l.adapter != nil {
+		count := l.adapter.Count()
+		l.checked = make(map[int]bool, count)
+		for i := 0; i < count; i++ {
+			l.checked[i] = true
+		}
+	}
+	l.Invalidate()
+}
+
+// toggleChecked flips the selected item's checkbox and notifies OnCheck.
+func (l *List[T]) toggleChecked() {
+	index := l.selected
+	checked := !l.checked[index]
+	if checked {
+		if l.checked == nil {
+			l.checked = make(map[int]bool)
+		}
+		l.checked[index] = true
+	} else {
+		delete(l.checked, index)
+	}
+	l.Invalidate()
+	if l.onCheck != nil {
+		l.onCheck(index, l.adapter.Item(index), checked)
+	}
+}
+
+// SetHeader shows a title row above the items that does not scroll.
+func (l *List[T]) SetHeader(text string, style backend.Style) {
+	if l == nil {
+		return
+	}
+	l.header = text
+	l.headerStyle = style
+	l.hasHeader = true
+	l.Invalidate()
+}
+
+// SetHeaderRole shows a title row styled with the active theme's token
+// for role.
+func (l *List[T]) SetHeaderRole(text string, role theme.Role) {
+	if l == nil {
+		return
+	}
+	l.SetHeader(text, l.headerRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (l *List[T]) ApplyTheme() {
+	if l == nil {
+		return
+	}
+	if style, ok := l.headerRole.refresh(l.headerStyle); ok {
+		l.headerStyle = style
+		l.Invalidate()
+	}
+}
+
+// ClearHeader removes the title row.
+func (l *List[T]) ClearHeader() {
+	if l == nil {
+		return
+	}
+	l.header = ""
+	l.hasHeader = false
+	l.Invalidate()
+}
+
+// SetItemHeight sets the number of rows each item occupies.
+func (l *List[T]) SetItemHeight(rows int) {
+	if l == nil {
+		return
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	l.itemHeight = rows
+	l.Invalidate()
+}
+
+// rowsPerItem returns the rows allocated to each item.
+func (l *List[T]) rowsPerItem() int {
+	if l.itemHeight < 1 {
+		return 1
+	}
+	return l.itemHeight
+}
+
+// visibleItems returns how many items fit fully in the item area.
+func (l *List[T]) visibleItems() int {
+	return max(l.itemBounds().Height/l.rowsPerItem(), 1)
+}
+
+// itemBounds returns the area available for items, below the header.
+func (l *List[T]) itemBounds() runtime.Rect {
+	bounds := l.bounds
+	if l.hasHeader && bounds.Height > 0 {
+		bounds.Y++
+		bounds.Height--
+	}
+	return bounds
+}
+
 // Measure returns the desired size.
 func (l *List[T]) Measure(constraints runtime.Constraints) runtime.Size {
 	count := 0
 	if l != nil && l.adapter != nil {
 		count = l.adapter.Count()
 	}
-	height := min(count, constraints.MaxHeight)
+	rows := count
+	if l != nil {
+		rows = count * l.rowsPerItem()
+		if l.hasHeader {
+			rows++
+		}
+	}
+	height := min(rows, constraints.MaxHeight)
 	if height <= 0 {
 		height = constraints.MinHeight
 	}
@@ -146,6 +397,13 @@ func (l *List[T]) Render(ctx runtime.RenderContext) {
 		return
 	}
 	ctx.Buffer.Fill(bounds, ' ', l.style)
+	if l.hasHeader {
+		ctx.Buffer.SetString(bounds.X, bounds.Y, truncateString(l.header, bounds.Width), l.headerStyle)
+		bounds = l.itemBounds()
+		if bounds.Height <= 0 {
+			return
+		}
+	}
 	count := l.adapter.Count()
 	if l.selected < 0 {
 		l.selected = 0
@@ -156,21 +414,45 @@ func (l *List[T]) Render(ctx runtime.RenderContext) {
 	if l.selected < l.offset {
 		l.offset = l.selected
 	}
-	if l.selected >= l.offset+bounds.Height {
-		l.offset = l.selected - bounds.Height + 1
+	visible := l.visibleItems()
+	if l.selected >= l.offset+visible {
+		l.offset = l.selected - visible + 1
 	}
-	for i := 0; i < bounds.Height; i++ {
+	rows := l.rowsPerItem()
+	for i := 0; i*rows < bounds.Height; i++ {
 		index := l.offset + i
 		if index < 0 || index >= count {
 			break
 		}
 		item := l.adapter.Item(index)
-		rowBounds := runtime.Rect{X: bounds.X, Y: bounds.Y + i, Width: bounds.Width, Height: 1}
-		rowCtx := ctx.Sub(rowBounds)
-		l.adapter.Render(item, index, index == l.selected, rowCtx)
+		rowBounds := runtime.Rect{X: bounds.X, Y: bounds.Y + i*rows, Width: bounds.Width, Height: min(rows, bounds.Height-i*rows)}
+		selected := index == l.selected
+		checked := l.checked[index]
+		if l.multiSelect {
+			rowBounds = l.renderCheckbox(ctx, rowBounds, selected, checked)
+		}
+		l.adapter.Render(item, index, selected, checked, ctx.Sub(rowBounds))
 	}
 }
 
+// renderCheckbox draws an item's checkbox and returns the bounds left for
+// the item.
+func (l *List[T]) renderCheckbox(ctx runtime.RenderContext, bounds runtime.Rect, selected, checked bool) runtime.Rect {
+	marker := "[ ] "
+	if checked {
+		marker = "[x] "
+	}
+	style := l.style
+	if selected {
+		style = l.selectedStyle
+	}
+	width := min(listCheckboxWidth, bounds.Width)
+	ctx.Buffer.SetString(bounds.X, bounds.Y, marker[:width], style)
+	bounds.X += width
+	bounds.Width -= width
+	return bounds
+}
+
 // HandleMessage handles navigation.
 func (l *List[T]) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if l == nil || !l.focused || l.adapter == nil {
@@ -192,10 +474,10 @@ func (l *List[T]) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		l.setSelected(l.selected + 1)
 		return runtime.Handled()
 	case terminal.KeyPageUp:
-		l.setSelected(l.selected - l.bounds.Height)
+		l.setSelected(l.selected - l.visibleItems())
 		return runtime.Handled()
 	case terminal.KeyPageDown:
-		l.setSelected(l.selected + l.bounds.Height)
+		l.setSelected(l.selected + l.visibleItems())
 		return runtime.Handled()
 	case terminal.KeyHome:
 		l.setSelected(0)
@@ -203,6 +485,11 @@ func (l *List[T]) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	case terminal.KeyEnd:
 		l.setSelected(count - 1)
 		return runtime.Handled()
+	case terminal.KeyRune:
+		if key.Rune == ' ' && l.multiSelect && l.selected >= 0 && l.selected < count {
+			l.toggleChecked()
+			return runtime.Handled()
+		}
 	case terminal.KeyEnter:
 		item := l.adapter.Item(l.selected)
 		if l.onSelect != nil {
@@ -289,10 +576,7 @@ func (l *List[T]) PageBy(pages int) {
 	if l == nil || l.adapter == nil {
 		return
 	}
-	pageSize := l.bounds.Height
-	if pageSize < 1 {
-		pageSize = 1
-	}
+	pageSize := l.visibleItems()
 	l.setSelected(l.selected + pages*pageSize)
 	l.Invalidate()
 }
@@ -0,0 +1,48 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/accessibility"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// LiveRegion is an invisible widget that announces messages to screen
+// readers without taking focus. It occupies no space and registers its
+// accessibility.LiveRegion with the app when bound.
+type LiveRegion struct {
+	Base
+	*accessibility.LiveRegion
+	remove func()
+}
+
+// NewLiveRegion creates a live region widget with the given politeness.
+func NewLiveRegion(politeness accessibility.Politeness) *LiveRegion {
+	return &LiveRegion{LiveRegion: accessibility.NewLiveRegion(politeness)}
+}
+
+// Bind registers the region with the app.
+func (l *LiveRegion) Bind(services runtime.Services) {
+	if l == nil {
+		return
+	}
+	if l.remove != nil {
+		l.remove()
+	}
+	l.remove = services.RegisterLiveRegion(l.LiveRegion)
+}
+
+// Unbind detaches the region from the app.
+func (l *LiveRegion) Unbind() {
+	if l == nil || l.remove == nil {
+		return
+	}
+	l.remove()
+	l.remove = nil
+}
+
+// Measure returns a zero size.
+func (l *LiveRegion) Measure(constraints runtime.Constraints) runtime.Size {
+	return runtime.Size{}
+}
+
+// Render draws nothing.
+func (l *LiveRegion) Render(ctx runtime.RenderContext) {}
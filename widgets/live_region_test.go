@@ -0,0 +1,57 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/accessibility"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/scroll"
+)
+
+func TestLiveRegion_AnnouncesWithoutSpace(t *testing.T) {
+	announcer := &accessibility.SimpleAnnouncer{}
+	app := runtime.NewApp(runtime.AppConfig{Announcer: announcer})
+	region := NewLiveRegion(accessibility.Assertive)
+
+	if size := region.Measure(runtime.Constraints{MaxWidth: 20, MaxHeight: 5}); size != (runtime.Size{}) {
+		t.Fatalf("Measure = %+v, want zero", size)
+	}
+	if region.CanFocus() {
+		t.Fatal("live region should not take focus")
+	}
+
+	region.Announce("before bind")
+	region.Bind(app.Services())
+	region.Announce("upload done")
+	region.Unbind()
+	region.Announce("after unbind")
+
+	history := announcer.History()
+	if len(history) != 1 || history[0] != (accessibility.Announcement{Message: "upload done", Priority: accessibility.PriorityAssertive}) {
+		t.Fatalf("announcements = %+v, want only the bound one", history)
+	}
+}
+
+func TestScrollView_AnnouncesThroughLiveRegion(t *testing.T) {
+	announcer := &accessibility.SimpleAnnouncer{}
+	app := runtime.NewApp(runtime.AppConfig{Announcer: announcer})
+	view := NewScrollView(NewText(strings.Repeat("line\n", 29) + "line"))
+	view.SetBehavior(scroll.ScrollBehavior{PageSize: 1})
+	view.Bind(app.Services())
+	view.Measure(runtime.Constraints{MaxWidth: 10, MaxHeight: 5})
+	view.Layout(runtime.Rect{Width: 10, Height: 5})
+
+	view.ScrollBy(0, 3)
+	history := announcer.History()
+	if len(history) == 0 || history[len(history)-1] != (accessibility.Announcement{Message: "Line 4 of 30", Priority: accessibility.PriorityPolite}) {
+		t.Fatalf("announcements = %+v, want Line 4 of 30", history)
+	}
+
+	view.Unbind()
+	count := len(announcer.History())
+	view.ScrollBy(0, 1)
+	if len(announcer.History()) != count {
+		t.Fatal("unbound scroll view should not announce")
+	}
+}
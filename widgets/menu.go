@@ -1,7 +1,12 @@
 package widgets
 
 import (
+	"strconv"
+	"sync/atomic"
+
+	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/keybind"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/scroll"
 	"github.com/odvcencio/fluffy-ui/terminal"
@@ -21,6 +26,7 @@ type MenuItem struct {
 // Menu renders a vertical menu.
 type Menu struct {
 	FocusableBase
+	accessibility.Base
 	Items         []*MenuItem
 	selectedIndex int
 	offset        int
@@ -31,11 +37,21 @@ type Menu struct {
 	flatDirty     bool
 	itemsLen      int
 	itemsFirst    *MenuItem
+
+	platformShortcuts bool
+
+	shortcutRegistry *keybind.CommandRegistry
+	shortcutKeymaps  *keybind.KeymapStack
+	shortcutKeymap   *keybind.Keymap
+	shortcutPrefix   string
 }
 
+// menuInstances numbers menus so their shortcut command IDs stay distinct.
+var menuInstances atomic.Uint64
+
 // NewMenu creates a new menu.
 func NewMenu(items ...*MenuItem) *Menu {
-	return &Menu{
+	m := &Menu{
 		Items:         items,
 		selectedIndex: 0,
 		style:         backend.DefaultStyle(),
@@ -44,6 +60,26 @@ func NewMenu(items ...*MenuItem) *Menu {
 		itemsLen:      len(items),
 		itemsFirst:    firstItem(items),
 	}
+	m.Base.Role = accessibility.RoleMenu
+	return m
+}
+
+// AccessibleValue reports the title of the selected item.
+func (m *Menu) AccessibleValue() *accessibility.ValueInfo {
+	if m == nil {
+		return nil
+	}
+	rows := m.flatten()
+	row := m.selectedRow(rows)
+	if row == nil || row.item == nil {
+		return nil
+	}
+	return &accessibility.ValueInfo{
+		Min:     0,
+		Max:     float64(len(rows) - 1),
+		Current: float64(m.selectedIndex),
+		Text:    row.item.Title,
+	}
 }
 
 // SetItems replaces the menu items and clears cached rows.
@@ -57,6 +93,16 @@ func (m *Menu) SetItems(items ...*MenuItem) {
 	m.flatDirty = true
 }
 
+// SetPlatformShortcuts toggles platform-specific shortcut display,
+// e.g. "⌘S" on macOS instead of "Ctrl+S".
+func (m *Menu) SetPlatformShortcuts(enabled bool) {
+	if m == nil {
+		return
+	}
+	m.platformShortcuts = enabled
+	m.Invalidate()
+}
+
 // Measure returns desired size.
 func (m *Menu) Measure(constraints runtime.Constraints) runtime.Size {
 	count := len(m.flatten())
@@ -113,8 +159,8 @@ func (m *Menu) Render(ctx runtime.RenderContext) {
 		}
 		indent := m.indent(row.depth)
 		line := indent + prefix + row.item.Title
-		if row.item.Shortcut != "" {
-			line += " (" + row.item.Shortcut + ")"
+		if shortcut := m.shortcutLabel(row.item); shortcut != "" {
+			line += " (" + shortcut + ")"
 		}
 		line = truncateString(line, bounds.Width)
 		writePadded(ctx.Buffer, bounds.X, bounds.Y+i, bounds.Width, line, style)
@@ -130,6 +176,12 @@ func (m *Menu) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if !ok {
 		return runtime.Unhandled()
 	}
+	if item := m.shortcutItem(keybind.KeyPressFromKeyMsg(key)); item != nil {
+		if item.OnSelect != nil {
+			item.OnSelect()
+		}
+		return runtime.Handled()
+	}
 	rows := m.flatten()
 	switch key.Key {
 	case terminal.KeyUp:
@@ -165,6 +217,127 @@ func (m *Menu) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	return runtime.Unhandled()
 }
 
+// shortcutItem returns the enabled item whose shortcut matches the key press.
+func (m *Menu) shortcutItem(press keybind.KeyPress) *MenuItem {
+	var find func(items []*MenuItem) *MenuItem
+	find = func(items []*MenuItem) *MenuItem {
+		for _, item := range items {
+			if item == nil || item.Disabled {
+				continue
+			}
+			if item.Shortcut != "" {
+				if shortcut, err := keybind.ParseShortcut(item.Shortcut); err == nil && shortcut.Matches(press) {
+					return item
+				}
+			}
+			if found := find(item.Children); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	return find(m.Items)
+}
+
+// RegisterShortcuts makes item shortcuts work while the menu is unfocused.
+// Each enabled item with a Shortcut, including items under expanded
+// parents, gets a command in registry and a binding in a keymap pushed onto
+// keymaps. Command IDs are scoped to this menu and the item's ID, so menus
+// sharing a shortcut don't replace each other's commands. Activating a
+// shortcut selects the item and calls OnSelect. Calling it again replaces
+// the previous registration.
+func (m *Menu) RegisterShortcuts(registry *keybind.CommandRegistry, keymaps *keybind.KeymapStack) {
+	if m == nil || registry == nil || keymaps == nil {
+		return
+	}
+	m.UnregisterShortcuts()
+	if m.shortcutPrefix == "" {
+		m.shortcutPrefix = "menu." + strconv.FormatUint(menuInstances.Add(1), 10) + ".shortcut."
+	}
+	keymap := &keybind.Keymap{Name: "menu-shortcuts"}
+	var walk func(items []*MenuItem)
+	walk = func(items []*MenuItem) {
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+			if item.Expanded {
+				walk(item.Children)
+			}
+			if item.Disabled || item.Shortcut == "" {
+				continue
+			}
+			shortcut, err := keybind.ParseShortcut(item.Shortcut)
+			if err != nil {
+				continue
+			}
+			press, err := shortcut.KeyPress()
+			if err != nil {
+				continue
+			}
+			item := item
+			id := m.shortcutPrefix + item.ID
+			if item.ID == "" {
+				id = m.shortcutPrefix + shortcut.Format("linux")
+			}
+			registry.Register(keybind.Command{
+				ID:      id,
+				Title:   item.Title,
+				Handler: func(keybind.Context) { m.activateShortcut(item) },
+				Enabled: func(keybind.Context) bool { return !item.Disabled },
+			})
+			keymap.Bindings = append(keymap.Bindings, keybind.Binding{
+				Key:     keybind.Key{Sequence: []keybind.KeyPress{press}},
+				Command: id,
+			})
+		}
+	}
+	walk(m.Items)
+	keymaps.Push(keymap)
+	m.shortcutRegistry = registry
+	m.shortcutKeymaps = keymaps
+	m.shortcutKeymap = keymap
+}
+
+// UnregisterShortcuts removes the shortcuts added by RegisterShortcuts.
+func (m *Menu) UnregisterShortcuts() {
+	if m == nil || m.shortcutKeymap == nil {
+		return
+	}
+	for _, binding := range m.shortcutKeymap.Bindings {
+		m.shortcutRegistry.Unregister(binding.Command)
+	}
+	m.shortcutKeymaps.Remove(m.shortcutKeymap)
+	m.shortcutRegistry = nil
+	m.shortcutKeymaps = nil
+	m.shortcutKeymap = nil
+}
+
+// activateShortcut selects item and calls its OnSelect.
+func (m *Menu) activateShortcut(item *MenuItem) {
+	for i, row := range m.flatten() {
+		if row.item == item {
+			m.selectedIndex = i
+			break
+		}
+	}
+	m.Invalidate()
+	if item.OnSelect != nil {
+		item.OnSelect()
+	}
+}
+
+func (m *Menu) shortcutLabel(item *MenuItem) string {
+	if item.Shortcut == "" || !m.platformShortcuts {
+		return item.Shortcut
+	}
+	shortcut, err := keybind.ParseShortcut(item.Shortcut)
+	if err != nil {
+		return item.Shortcut
+	}
+	return shortcut.String()
+}
+
 type menuRow struct {
 	item  *MenuItem
 	depth int
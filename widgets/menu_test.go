@@ -0,0 +1,111 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/keybind"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func TestMenu_ShortcutFiresOnSelect(t *testing.T) {
+	saved := 0
+	menu := NewMenu(
+		&MenuItem{ID: "open", Title: "Open", Shortcut: "Ctrl+O"},
+		&MenuItem{ID: "file", Title: "File", Children: []*MenuItem{
+			{ID: "save", Title: "Save", Shortcut: "Ctrl+S", OnSelect: func() { saved++ }},
+		}},
+	)
+	menu.Focus()
+
+	result := menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's', Ctrl: true})
+	if !result.Handled || saved != 1 {
+		t.Fatalf("expected shortcut to fire OnSelect, handled=%v saved=%d", result.Handled, saved)
+	}
+	if result := menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's'}); result.Handled {
+		t.Fatalf("expected plain key not to match shortcut")
+	}
+}
+
+func TestMenu_DisabledShortcutIgnored(t *testing.T) {
+	fired := false
+	menu := NewMenu(&MenuItem{Title: "Save", Shortcut: "Ctrl+S", Disabled: true, OnSelect: func() { fired = true }})
+	menu.Focus()
+
+	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's', Ctrl: true})
+	if fired {
+		t.Fatalf("expected disabled item shortcut to be ignored")
+	}
+}
+
+func TestMenu_RegisterShortcutsWithoutFocus(t *testing.T) {
+	opened, saved, quit := 0, 0, 0
+	menu := NewMenu(
+		&MenuItem{ID: "open", Title: "Open", Shortcut: "Ctrl+O", OnSelect: func() { opened++ }},
+		&MenuItem{ID: "file", Title: "File", Expanded: true, Children: []*MenuItem{
+			{ID: "save", Title: "Save", Shortcut: "Ctrl+S", OnSelect: func() { saved++ }},
+		}},
+		&MenuItem{ID: "quit", Title: "Quit", Shortcut: "Ctrl+Q", Disabled: true, OnSelect: func() { quit++ }},
+	)
+	registry := keybind.NewRegistry()
+	keymaps := &keybind.KeymapStack{}
+	router := keybind.NewKeyRouter(registry, nil, keymaps)
+	other := NewInput()
+	other.Focus()
+	ctx := keybind.Context{Focused: other}
+
+	menu.RegisterShortcuts(registry, keymaps)
+	if got := len(registry.List()); got != 2 {
+		t.Fatalf("registered commands = %d, want 2 without the disabled item", got)
+	}
+	if !router.HandleKey(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's', Ctrl: true}, ctx) {
+		t.Fatal("expected Ctrl+S to be handled")
+	}
+	if saved != 1 || opened != 0 {
+		t.Fatalf("saved=%d opened=%d, want 1 and 0", saved, opened)
+	}
+	if menu.selectedIndex != 2 {
+		t.Fatalf("selectedIndex = %d, want 2", menu.selectedIndex)
+	}
+	router.HandleKey(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'q', Ctrl: true}, ctx)
+	if quit != 0 {
+		t.Fatal("disabled item shortcut fired")
+	}
+
+	menu.UnregisterShortcuts()
+	if router.HandleKey(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's', Ctrl: true}, ctx) || saved != 1 {
+		t.Fatalf("shortcut fired after UnregisterShortcuts: saved=%d", saved)
+	}
+	if len(keymaps.All()) != 0 {
+		t.Fatalf("keymaps = %d, want 0", len(keymaps.All()))
+	}
+}
+
+func TestMenu_ShortcutsFromTwoMenusStaySeparate(t *testing.T) {
+	first, second := 0, 0
+	a := NewMenu(&MenuItem{ID: "save", Title: "Save", Shortcut: "Ctrl+S", OnSelect: func() { first++ }})
+	b := NewMenu(&MenuItem{ID: "save", Title: "Save", Shortcut: "Ctrl+S", OnSelect: func() { second++ }})
+	registry := keybind.NewRegistry()
+	keymaps := &keybind.KeymapStack{}
+	router := keybind.NewKeyRouter(registry, nil, keymaps)
+	ctx := keybind.Context{}
+	ctrlS := runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's', Ctrl: true}
+
+	a.RegisterShortcuts(registry, keymaps)
+	b.RegisterShortcuts(registry, keymaps)
+	if got := len(registry.List()); got != 2 {
+		t.Fatalf("registered commands = %d, want one per menu", got)
+	}
+	router.HandleKey(ctrlS, ctx)
+	if first != 0 || second != 1 {
+		t.Fatalf("first=%d second=%d, want the top keymap's menu", first, second)
+	}
+
+	b.UnregisterShortcuts()
+	if !router.HandleKey(ctrlS, ctx) || first != 1 {
+		t.Fatalf("first=%d, want the other menu's shortcut kept", first)
+	}
+	if got := len(registry.List()); got != 1 {
+		t.Fatalf("registered commands = %d, want 1", got)
+	}
+}
@@ -0,0 +1,271 @@
+package widgets
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// MenubarEntry is a top-level menubar label and its dropdown items.
+type MenubarEntry struct {
+	// Mnemonic opens the entry with Alt; it defaults to the first letter of
+	// Title.
+	Mnemonic rune
+	Title    string
+	Items    []*MenuItem
+}
+
+// Menubar renders a row of menu titles that open dropdown menus.
+type Menubar struct {
+	FocusableBase
+	entries       []MenubarEntry
+	positions     []int
+	active        int
+	dropdown      *ContextMenu
+	onCommand     func(id string)
+	style         backend.Style
+	selectedStyle backend.Style
+}
+
+// NewMenubar creates a menubar.
+func NewMenubar(entries ...MenubarEntry) *Menubar {
+	return &Menubar{
+		entries:       entries,
+		style:         backend.DefaultStyle(),
+		selectedStyle: backend.DefaultStyle().Reverse(true),
+	}
+}
+
+// SetItems replaces the menubar entries.
+func (m *Menubar) SetItems(entries ...MenubarEntry) {
+	if m == nil {
+		return
+	}
+	m.entries = entries
+	m.active = max(0, min(m.active, len(entries)-1))
+	m.Layout(m.bounds)
+	m.Invalidate()
+}
+
+// Entries returns the menubar entries.
+func (m *Menubar) Entries() []MenubarEntry {
+	if m == nil {
+		return nil
+	}
+	return m.entries
+}
+
+// OnCommand registers a handler called with the ID of each chosen item.
+func (m *Menubar) OnCommand(fn func(id string)) {
+	if m == nil {
+		return
+	}
+	m.onCommand = fn
+}
+
+// IsOpen reports whether a dropdown is showing.
+func (m *Menubar) IsOpen() bool {
+	return m != nil && m.dropdown.IsOpen()
+}
+
+// Measure returns one row.
+func (m *Menubar) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.Constrain(runtime.Size{Width: constraints.MaxWidth, Height: 1})
+}
+
+// Layout stores bounds and the position of each title.
+func (m *Menubar) Layout(bounds runtime.Rect) {
+	m.Base.Layout(bounds)
+	m.positions = m.positions[:0]
+	x := bounds.X
+	for _, entry := range m.entries {
+		m.positions = append(m.positions, x)
+		x += runewidth.StringWidth(entry.Title) + 2
+	}
+}
+
+// Render draws the titles, underlining each mnemonic.
+func (m *Menubar) Render(ctx runtime.RenderContext) {
+	if m == nil {
+		return
+	}
+	bounds := m.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	ctx.Buffer.Fill(runtime.Rect{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: 1}, ' ', m.style)
+	for i, entry := range m.entries {
+		if i >= len(m.positions) {
+			break
+		}
+		style := m.style
+		if i == m.active && (m.focused || m.IsOpen()) {
+			style = m.selectedStyle
+		}
+		x := m.positions[i]
+		label := " " + entry.Title + " "
+		available := bounds.X + bounds.Width - x
+		if available <= 0 {
+			break
+		}
+		ctx.Buffer.SetString(x, bounds.Y, truncateString(label, available), style)
+		if idx := mnemonicIndex(entry); idx >= 0 {
+			col := x + 1 + runewidth.StringWidth(entry.Title[:idx])
+			if col < bounds.X+bounds.Width {
+				r := []rune(entry.Title[idx:])[0]
+				ctx.Buffer.Set(col, bounds.Y, r, style.Underline(true))
+			}
+		}
+	}
+}
+
+// HandleMessage opens dropdowns on Alt+mnemonic at any time, and on
+// Enter/Down or a click while the bar is focused.
+func (m *Menubar) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if m == nil || len(m.entries) == 0 {
+		return runtime.Unhandled()
+	}
+	switch msg := msg.(type) {
+	case runtime.KeyMsg:
+		if i := m.mnemonicEntry(msg); i >= 0 {
+			m.active = i
+			return m.open()
+		}
+		if !m.focused {
+			return runtime.Unhandled()
+		}
+		switch msg.Key {
+		case terminal.KeyLeft:
+			m.active = (m.active - 1 + len(m.entries)) % len(m.entries)
+			m.Invalidate()
+			return runtime.Handled()
+		case terminal.KeyRight:
+			m.active = (m.active + 1) % len(m.entries)
+			m.Invalidate()
+			return runtime.Handled()
+		case terminal.KeyEnter, terminal.KeyDown:
+			return m.open()
+		}
+		if msg.Key == terminal.KeyRune && msg.Rune == ' ' {
+			return m.open()
+		}
+	case runtime.MouseMsg:
+		if msg.Action != runtime.MousePress || msg.Button != runtime.MouseLeft || !m.bounds.Contains(msg.X, msg.Y) {
+			return runtime.Unhandled()
+		}
+		for i := len(m.positions) - 1; i >= 0; i-- {
+			if msg.X >= m.positions[i] && i < len(m.entries) {
+				m.active = i
+				return m.open()
+			}
+		}
+	}
+	return runtime.Unhandled()
+}
+
+// open focuses the bar and opens the active entry's dropdown below its title.
+func (m *Menubar) open() runtime.HandleResult {
+	var cmds []runtime.Command
+	if !m.focused {
+		cmds = append(cmds, runtime.FocusWidget{Widget: m})
+	}
+	cmds = append(cmds, m.openDropdown())
+	m.Invalidate()
+	return runtime.WithCommands(cmds...)
+}
+
+func (m *Menubar) openDropdown() runtime.Command {
+	entry := m.entries[m.active]
+	x := m.bounds.X
+	if m.active < len(m.positions) {
+		x = m.positions[m.active]
+	}
+	dropdown := NewContextMenu(entry.Items...)
+	dropdown.onKey = m.dropdownKey
+	dropdown.OnCommand(func(id string) {
+		if m.onCommand != nil {
+			m.onCommand(id)
+		}
+	})
+	dropdown.OnClose(func() {
+		if m.dropdown == dropdown {
+			m.dropdown = nil
+		}
+		m.Invalidate()
+	})
+	m.dropdown = dropdown
+	return dropdown.ShowAt(x, m.bounds.Y+1)
+}
+
+// mnemonicEntry returns the entry opened by an Alt key press, or -1.
+func (m *Menubar) mnemonicEntry(msg runtime.KeyMsg) int {
+	if !msg.Alt || msg.Key != terminal.KeyRune {
+		return -1
+	}
+	for i, entry := range m.entries {
+		if unicode.ToLower(entryMnemonic(entry)) == unicode.ToLower(msg.Rune) {
+			return i
+		}
+	}
+	return -1
+}
+
+// dropdownKey switches dropdowns on an Alt mnemonic, or on Left/Right unless
+// the selected item is a submenu to collapse or expand.
+func (m *Menubar) dropdownKey(msg runtime.KeyMsg) (runtime.HandleResult, bool) {
+	if m.dropdown == nil {
+		return runtime.HandleResult{}, false
+	}
+	menu := m.dropdown.menu
+	row := menu.selectedRow(menu.flatten())
+	next := m.mnemonicEntry(msg)
+	switch msg.Key {
+	case terminal.KeyLeft:
+		if row == nil || !row.item.Expanded {
+			next = (m.active - 1 + len(m.entries)) % len(m.entries)
+		}
+	case terminal.KeyRight:
+		if row == nil || len(row.item.Children) == 0 {
+			next = (m.active + 1) % len(m.entries)
+		}
+	}
+	if next < 0 {
+		return runtime.HandleResult{}, false
+	}
+	if next == m.active {
+		return runtime.Handled(), true
+	}
+	result := m.dropdown.close()
+	m.active = next
+	result.Commands = append(result.Commands, m.openDropdown())
+	return result, true
+}
+
+// entryMnemonic returns the entry's mnemonic or the first letter of its title.
+func entryMnemonic(entry MenubarEntry) rune {
+	if entry.Mnemonic != 0 {
+		return entry.Mnemonic
+	}
+	for _, r := range entry.Title {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+	}
+	return 0
+}
+
+// mnemonicIndex returns the byte index of the mnemonic in the title, or -1.
+func mnemonicIndex(entry MenubarEntry) int {
+	mnemonic := unicode.ToLower(entryMnemonic(entry))
+	if mnemonic == 0 {
+		return -1
+	}
+	return strings.IndexFunc(entry.Title, func(r rune) bool {
+		return unicode.ToLower(r) == mnemonic
+	})
+}
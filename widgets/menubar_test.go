@@ -0,0 +1,53 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func TestMenubar_OpenSwitchAndSelect(t *testing.T) {
+	var commands []string
+	bar := NewMenubar(
+		MenubarEntry{Title: "File", Items: []*MenuItem{{ID: "file.open", Title: "Open"}, {ID: "file.quit", Title: "Quit"}}},
+		MenubarEntry{Title: "Edit", Items: []*MenuItem{{ID: "edit.copy", Title: "Copy"}}},
+	)
+	bar.OnCommand(func(id string) { commands = append(commands, id) })
+	content := NewInput()
+	if out := renderToString(bar, 12, 1); out != " File  Edit \n" {
+		t.Fatalf("render = %q", out)
+	}
+	screen := runtime.NewScreen(30, 8)
+	screen.SetRoot(runtime.VBox(runtime.Fixed(bar), runtime.Flexible(content, 1)))
+	screen.FocusScope().RegisterAll(screen.Root())
+	screen.FocusScope().SetFocus(content)
+
+	screen.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'f', Alt: true})
+	if !bar.IsOpen() || !bar.IsFocused() || screen.LayerCount() != 2 {
+		t.Fatalf("open = %v, focused = %v, layers = %d after Alt+F", bar.IsOpen(), bar.IsFocused(), screen.LayerCount())
+	}
+	if got := bar.dropdown.Menu().Bounds(); got.X != 0 || got.Y != 1 {
+		t.Fatalf("dropdown bounds = %+v, want below File", got)
+	}
+
+	screen.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if screen.LayerCount() != 2 || bar.active != 1 {
+		t.Fatalf("layers = %d, active = %d after Right", screen.LayerCount(), bar.active)
+	}
+	if got := bar.dropdown.Menu().Bounds(); got.X != 6 {
+		t.Fatalf("dropdown bounds = %+v, want below Edit", got)
+	}
+
+	screen.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if len(commands) != 1 || commands[0] != "edit.copy" || screen.LayerCount() != 1 {
+		t.Fatalf("commands = %v, layers = %d", commands, screen.LayerCount())
+	}
+	if !bar.IsFocused() {
+		t.Fatal("the menubar should keep focus after the dropdown closes")
+	}
+	screen.FocusScope().FocusNext()
+	if !content.IsFocused() {
+		t.Fatal("Tab should move focus from the menubar back to the content")
+	}
+}
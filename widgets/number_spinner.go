@@ -0,0 +1,236 @@
+package widgets
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// NumberSpinner wraps an Input that accepts numbers. Up and Down step the
+// value within its bounds, and Enter or leaving the field applies typed text.
+type NumberSpinner struct {
+	Base
+
+	input    *Input
+	value    float64
+	min      float64
+	max      float64
+	step     float64
+	wrap     bool
+	format   string
+	onChange func(value float64)
+}
+
+// NewNumberSpinner creates a spinner over [min, max] that steps by step. The
+// value starts at 0, clamped to the bounds.
+func NewNumberSpinner(min, max, step float64) *NumberSpinner {
+	if min > max {
+		min, max = max, min
+	}
+	s := &NumberSpinner{
+		input:  NewInput(),
+		min:    min,
+		max:    max,
+		step:   math.Abs(step),
+		format: "%g",
+	}
+	s.value, _ = clampValue(0, min, max)
+	s.input.SetFilter(func(r rune) bool {
+		return (r >= '0' && r <= '9') || r == '.' || r == '-' || r == '+'
+	})
+	s.input.OnBlur(func(string) {
+		s.commit()
+	})
+	s.syncText()
+	return s
+}
+
+// Input returns the wrapped input.
+func (s *NumberSpinner) Input() *Input {
+	if s == nil {
+		return nil
+	}
+	return s.input
+}
+
+// Value returns the current value.
+func (s *NumberSpinner) Value() float64 {
+	if s == nil {
+		return 0
+	}
+	return s.value
+}
+
+// Text returns the formatted value shown in the input.
+func (s *NumberSpinner) Text() string {
+	if s == nil {
+		return ""
+	}
+	return s.input.Text()
+}
+
+// SetValue sets the value, clamped to the bounds. NaN and infinite values
+// are ignored.
+func (s *NumberSpinner) SetValue(v float64) {
+	if s == nil {
+		return
+	}
+	if v, ok := clampValue(v, s.min, s.max); ok {
+		s.value = v
+	}
+	s.syncText()
+}
+
+// SetBound changes the bounds and clamps the current value to them.
+func (s *NumberSpinner) SetBound(min, max float64) {
+	if s == nil {
+		return
+	}
+	if min > max {
+		min, max = max, min
+	}
+	s.min, s.max = min, max
+	s.SetValue(s.value)
+}
+
+// Bound returns the minimum and maximum values.
+func (s *NumberSpinner) Bound() (min, max float64) {
+	if s == nil {
+		return 0, 0
+	}
+	return s.min, s.max
+}
+
+// SetStep sets the amount Up and Down change the value.
+func (s *NumberSpinner) SetStep(step float64) {
+	if s == nil {
+		return
+	}
+	s.step = math.Abs(step)
+}
+
+// SetWrap makes stepping past one bound continue from the other instead of
+// stopping at it.
+func (s *NumberSpinner) SetWrap(wrap bool) {
+	if s == nil {
+		return
+	}
+	s.wrap = wrap
+}
+
+// SetFormat sets the fmt verb used to display the value (default "%g").
+func (s *NumberSpinner) SetFormat(format string) {
+	if s == nil || format == "" {
+		return
+	}
+	s.format = format
+	s.syncText()
+}
+
+// OnChange registers a handler called when the user changes the value.
+func (s *NumberSpinner) OnChange(fn func(value float64)) {
+	if s == nil {
+		return
+	}
+	s.onChange = fn
+}
+
+// Measure returns the input's size.
+func (s *NumberSpinner) Measure(constraints runtime.Constraints) runtime.Size {
+	return s.input.Measure(constraints)
+}
+
+// Layout positions the input.
+func (s *NumberSpinner) Layout(bounds runtime.Rect) {
+	s.Base.Layout(bounds)
+	s.input.Layout(bounds)
+}
+
+// Render draws the input.
+func (s *NumberSpinner) Render(ctx runtime.RenderContext) {
+	if s == nil {
+		return
+	}
+	s.input.Render(ctx)
+}
+
+// ChildWidgets returns the wrapped input.
+func (s *NumberSpinner) ChildWidgets() []runtime.Widget {
+	if s == nil {
+		return nil
+	}
+	return []runtime.Widget{s.input}
+}
+
+// HandleMessage steps on Up/Down and applies typed text before Enter
+// submits it.
+func (s *NumberSpinner) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if s == nil || !s.input.IsFocused() {
+		return runtime.Unhandled()
+	}
+	if key, ok := msg.(runtime.KeyMsg); ok {
+		switch key.Key {
+		case terminal.KeyUp:
+			s.stepBy(1)
+			return runtime.Handled()
+		case terminal.KeyDown:
+			s.stepBy(-1)
+			return runtime.Handled()
+		case terminal.KeyEnter:
+			s.commit()
+		}
+	}
+	return s.input.HandleMessage(msg)
+}
+
+// stepBy applies typed text, then moves the value by dir steps.
+func (s *NumberSpinner) stepBy(dir float64) {
+	s.commit()
+	next := roundValue(s.value + dir*s.step)
+	switch {
+	case next > s.max && s.wrap:
+		next = s.min
+	case next < s.min && s.wrap:
+		next = s.max
+	}
+	s.apply(next)
+}
+
+// commit parses the input text, restoring the last value if it is not a
+// number.
+func (s *NumberSpinner) commit() {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s.input.Text()), 64)
+	if err != nil {
+		s.syncText()
+		return
+	}
+	s.apply(v)
+}
+
+// apply clamps and stores v, notifying OnChange when the value changes. NaN
+// and infinite values restore the current value's text.
+func (s *NumberSpinner) apply(v float64) {
+	v, ok := clampValue(v, s.min, s.max)
+	if !ok {
+		s.syncText()
+		return
+	}
+	changed := v != s.value
+	s.value = v
+	s.syncText()
+	if changed && s.onChange != nil {
+		s.onChange(v)
+	}
+}
+
+func (s *NumberSpinner) syncText() {
+	text := fmt.Sprintf(s.format, s.value)
+	if text != s.input.Text() {
+		s.input.SetText(text)
+	}
+	s.Invalidate()
+}
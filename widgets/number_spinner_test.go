@@ -0,0 +1,71 @@
+package widgets
+
+import (
+	"math"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func TestNumberSpinner_StepClampAndWrap(t *testing.T) {
+	spinner := NewNumberSpinner(0, 0.3, 0.1)
+	spinner.SetFormat("%.2f")
+	spinner.Input().Focus()
+	var changes []float64
+	spinner.OnChange(func(v float64) { changes = append(changes, v) })
+	up := runtime.KeyMsg{Key: terminal.KeyUp}
+
+	for range 5 {
+		spinner.HandleMessage(up)
+	}
+	if spinner.Value() != 0.3 || spinner.Text() != "0.30" || len(changes) != 3 {
+		t.Fatalf("value = %v (%q), changes = %v, want clamped at 0.3", spinner.Value(), spinner.Text(), changes)
+	}
+	spinner.SetWrap(true)
+	spinner.HandleMessage(up)
+	if spinner.Value() != 0 {
+		t.Fatalf("value = %v, want wrap to the minimum", spinner.Value())
+	}
+
+	spinner.Input().Clear()
+	for _, r := range "9x" {
+		spinner.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if spinner.Text() != "9" {
+		t.Fatalf("text = %q, want non-numeric runes filtered", spinner.Text())
+	}
+	result := spinner.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if spinner.Value() != 0.3 || !hasCommand[runtime.Submit](result) {
+		t.Fatalf("value = %v, commands = %v, want typed value clamped and submitted", spinner.Value(), result.Commands)
+	}
+
+	spinner.SetBound(1, 2)
+	if spinner.Value() != 1 || spinner.Text() != "1.00" {
+		t.Fatalf("value = %v (%q) after SetBound", spinner.Value(), spinner.Text())
+	}
+}
+
+func TestNumberSpinner_IgnoresNaN(t *testing.T) {
+	spinner := NewNumberSpinner(0, 10, 1)
+	spinner.SetValue(4)
+	spinner.SetValue(math.NaN())
+	spinner.SetValue(math.Inf(1))
+	if spinner.Value() != 4 {
+		t.Fatalf("value = %v, want NaN and Inf ignored", spinner.Value())
+	}
+
+	spinner.Input().Focus()
+	spinner.Input().SetText("NaN")
+	spinner.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if spinner.Value() != 4 || spinner.Text() != "4" {
+		t.Fatalf("value = %v (%q), want typed NaN rejected", spinner.Value(), spinner.Text())
+	}
+
+	slider := NewSlider(0, 10)
+	slider.SetValue(3)
+	slider.SetValue(math.NaN())
+	if slider.Value() != 3 {
+		t.Fatalf("slider value = %v, want NaN ignored", slider.Value())
+	}
+}
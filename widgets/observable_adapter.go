@@ -0,0 +1,94 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/state"
+)
+
+// ObservableSliceAdapter adapts a state.ObservableSlice to a ListAdapter. It
+// keeps its own copy of the items and applies each SliceEvent to it, so an
+// append, remove, or set touches only the affected rows; only Replace copies
+// the whole slice.
+type ObservableSliceAdapter[T any] struct {
+	source *state.ObservableSlice[T]
+	items  []T
+	render RenderFunc[T]
+	unsub  func()
+
+	// onEvent lets the List bound to the adapter keep its selection on the
+	// same item and repaint.
+	onEvent func(event state.SliceEvent[T])
+}
+
+// NewObservableSliceAdapter creates an adapter subscribed to source. Call
+// Stop to unsubscribe when the list is discarded.
+func NewObservableSliceAdapter[T any](source *state.ObservableSlice[T], render RenderFunc[T]) *ObservableSliceAdapter[T] {
+	a := &ObservableSliceAdapter[T]{source: source, render: render}
+	if source != nil {
+		a.items = source.Get()
+		a.unsub = source.Subscribe(a.apply)
+	}
+	return a
+}
+
+// Count returns the item count.
+func (a *ObservableSliceAdapter[T]) Count() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.items)
+}
+
+// Item returns the item at index.
+func (a *ObservableSliceAdapter[T]) Item(index int) T {
+	var zero T
+	if a == nil || index < 0 || index >= len(a.items) {
+		return zero
+	}
+	return a.items[index]
+}
+
+// Render draws an item.
+func (a *ObservableSliceAdapter[T]) Render(item T, index int, selected, checked bool, ctx runtime.RenderContext) {
+	if a == nil || a.render == nil {
+		return
+	}
+	a.render(item, index, selected, checked, ctx)
+}
+
+// Stop unsubscribes from the source; the adapter keeps its last items.
+func (a *ObservableSliceAdapter[T]) Stop() {
+	if a == nil || a.unsub == nil {
+		return
+	}
+	a.unsub()
+	a.unsub = nil
+}
+
+// apply mirrors one mutation of the source.
+func (a *ObservableSliceAdapter[T]) apply(event state.SliceEvent[T]) {
+	switch event.Op {
+	case state.SliceAppend:
+		a.items = append(a.items, event.Items...)
+	case state.SliceRemove:
+		if event.Index < 0 || event.Index >= len(a.items) {
+			return
+		}
+		var zero T
+		copy(a.items[event.Index:], a.items[event.Index+1:])
+		a.items[len(a.items)-1] = zero
+		a.items = a.items[:len(a.items)-1]
+	case state.SliceSet:
+		if event.Index < 0 || event.Index >= len(a.items) || len(event.Items) == 0 {
+			return
+		}
+		a.items[event.Index] = event.Items[0]
+	case state.SliceReplace:
+		a.items = append([]T(nil), event.Items...)
+	}
+	if a.onEvent != nil {
+		a.onEvent(event)
+	}
+}
+
+var _ ListAdapter[int] = (*ObservableSliceAdapter[int])(nil)
@@ -0,0 +1,61 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/state"
+)
+
+func newObservableTestList(items *state.ObservableSlice[string]) (*List[string], *ObservableSliceAdapter[string]) {
+	adapter := NewObservableSliceAdapter(items, func(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item, backend.DefaultStyle())
+	})
+	return NewList[string](adapter), adapter
+}
+
+func TestObservableSliceAdapter_MirrorsMutations(t *testing.T) {
+	items := state.NewObservableSlice([]string{"a", "b", "c"})
+	list, adapter := newObservableTestList(items)
+	defer adapter.Stop()
+
+	items.Append("d")
+	_ = items.Set(1, "B")
+	_ = items.Remove(0)
+	if got := renderToString(list, 2, 4); got != "B \nc \nd \n  \n" {
+		t.Fatalf("render = %q", got)
+	}
+
+	items.Replace([]string{"z"})
+	if adapter.Count() != 1 || adapter.Item(0) != "z" {
+		t.Fatalf("after Replace count=%d item=%q", adapter.Count(), adapter.Item(0))
+	}
+
+	adapter.Stop()
+	items.Append("late")
+	if adapter.Count() != 1 {
+		t.Fatalf("stopped adapter still mirrors: count=%d", adapter.Count())
+	}
+}
+
+func TestObservableSliceAdapter_KeepsSelectionOnItem(t *testing.T) {
+	items := state.NewObservableSlice([]string{"a", "b", "c"})
+	list, adapter := newObservableTestList(items)
+	defer adapter.Stop()
+	list.SetMultiSelect(true)
+	list.SetSelected(2)
+	list.SetAllChecked(true)
+	list.ClearInvalidation()
+
+	_ = items.Remove(0)
+	if item, _ := list.SelectedItem(); item != "c" {
+		t.Fatalf("selected = %q, want c", item)
+	}
+	if checked := list.CheckedIndices(); len(checked) != 2 || checked[0] != 0 || checked[1] != 1 {
+		t.Fatalf("checked = %v, want [0 1]", checked)
+	}
+	if !list.NeedsRender() {
+		t.Fatal("mutation did not invalidate the list")
+	}
+}
@@ -276,16 +276,7 @@ func (p *PaletteWidget) Layout(bounds runtime.Rect) {
 		MaxHeight: bounds.Height,
 	})
 
-	x := bounds.X + (bounds.Width-size.Width)/2
-	y := bounds.Y + (bounds.Height-size.Height)/2
-
-	newBounds := runtime.Rect{
-		X:      x,
-		Y:      y,
-		Width:  size.Width,
-		Height: size.Height,
-	}
-	p.Base.Layout(newBounds)
+	p.Base.Layout(runtime.AlignRect(bounds, size, runtime.AlignCenter, runtime.AlignMiddle))
 }
 
 // Render draws the palette.
@@ -3,6 +3,7 @@ package widgets
 import (
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/theme"
 )
 
 // Panel is a container widget with optional border and background.
@@ -10,8 +11,10 @@ type Panel struct {
 	Base
 	child       runtime.Widget
 	style       backend.Style
+	styleRole   themeRole
 	borderStyle backend.Style
 	hasBorder   bool
+	boxStyle    runtime.BoxStyle
 	title       string
 }
 
@@ -22,6 +25,7 @@ func NewPanel(child runtime.Widget) *Panel {
 		style:       backend.DefaultStyle(),
 		borderStyle: backend.DefaultStyle(),
 		hasBorder:   false,
+		boxStyle:    runtime.BoxStyleRounded,
 	}
 }
 
@@ -36,6 +40,23 @@ func (p *Panel) WithStyle(style backend.Style) *Panel {
 	return p
 }
 
+// WithRole sets the style to the active theme's token for role and returns
+// the panel for chaining.
+func (p *Panel) WithRole(role theme.Role) *Panel {
+	return p.WithStyle(p.styleRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (p *Panel) ApplyTheme() {
+	if p == nil {
+		return
+	}
+	if style, ok := p.styleRole.refresh(p.style); ok {
+		p.style = style
+	}
+}
+
 // SetBorder enables or disables the border.
 func (p *Panel) SetBorder(enabled bool) {
 	p.hasBorder = enabled
@@ -48,6 +69,18 @@ func (p *Panel) WithBorder(style backend.Style) *Panel {
 	return p
 }
 
+// SetBoxStyle sets the border characters. Panels use runtime.BoxStyleRounded
+// by default.
+func (p *Panel) SetBoxStyle(bs runtime.BoxStyle) {
+	p.boxStyle = bs
+}
+
+// WithBoxStyle sets the border characters and returns for chaining.
+func (p *Panel) WithBoxStyle(bs runtime.BoxStyle) *Panel {
+	p.boxStyle = bs
+	return p
+}
+
 // SetTitle sets the panel title (shown in border).
 func (p *Panel) SetTitle(title string) {
 	p.title = title
@@ -117,7 +150,11 @@ func (p *Panel) Render(ctx runtime.RenderContext) {
 
 	// Draw border if enabled
 	if p.hasBorder {
-		ctx.Buffer.DrawRoundedBox(bounds, p.borderStyle)
+		boxStyle := p.boxStyle
+		if boxStyle == (runtime.BoxStyle{}) {
+			boxStyle = runtime.BoxStyleRounded
+		}
+		ctx.Buffer.DrawBoxWithStyle(bounds, p.borderStyle, boxStyle)
 
 		// Draw title in top border
 		if p.title != "" {
@@ -155,8 +192,9 @@ func (p *Panel) ChildWidgets() []runtime.Widget {
 // Box is a simple container that fills its background.
 type Box struct {
 	Base
-	child runtime.Widget
-	style backend.Style
+	child     runtime.Widget
+	style     backend.Style
+	styleRole themeRole
 }
 
 // NewBox creates a new box widget.
@@ -178,6 +216,23 @@ func (b *Box) WithStyle(style backend.Style) *Box {
 	return b
 }
 
+// WithRole sets the style to the active theme's token for role and returns
+// the box for chaining.
+func (b *Box) WithRole(role theme.Role) *Box {
+	return b.WithStyle(b.styleRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (b *Box) ApplyTheme() {
+	if b == nil {
+		return
+	}
+	if style, ok := b.styleRole.refresh(b.style); ok {
+		b.style = style
+	}
+}
+
 // Measure returns the child's size.
 func (b *Box) Measure(constraints runtime.Constraints) runtime.Size {
 	if b.child == nil {
@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
@@ -44,15 +45,10 @@ func (p *Progress) Render(ctx runtime.RenderContext) {
 	if max <= 0 {
 		max = 1
 	}
-	ratio := p.Value / max
-	if ratio < 0 {
-		ratio = 0
-	}
-	if ratio > 1 {
-		ratio = 1
-	}
-	DrawGauge(ctx.Buffer, bounds.X, bounds.Y, bounds.Width, ratio, p.Style)
-	if p.ShowPercent && bounds.Width >= 4 {
+	ctx.Buffer.DrawProgress(bounds.X, bounds.Y, bounds.Width, p.Value, max, p.Style)
+	// A style without its own label gets the percentage over the bar's end.
+	if p.ShowPercent && p.Style.LabelPosition == runtime.GaugeLabelNone && bounds.Width >= 4 {
+		ratio := math.Max(0, math.Min(1, p.Value/max))
 		text := fmt.Sprintf("%3.0f%%", ratio*100)
 		ctx.Buffer.SetString(bounds.X+bounds.Width-len(text), bounds.Y, text, backend.DefaultStyle())
 	}
@@ -3,7 +3,10 @@ package widgets
 import (
 	"fmt"
 	"image"
+	"math"
+	"time"
 
+	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/scroll"
@@ -22,8 +25,23 @@ type ScrollView struct {
 	vScrollbar scroll.Scrollbar
 	hScrollbar scroll.Scrollbar
 	childBuf   *runtime.Buffer
+	focusScope *runtime.FocusScope
+
+	// live announces the scroll position; removeLive unregisters it.
+	live       *accessibility.LiveRegion
+	removeLive func()
+
+	easing       scroll.EasingFunc
+	animating    bool
+	animProgress float64
+	animStart    image.Point
+	animTarget   image.Point
+	animStarted  time.Time
 }
 
+// smoothScrollDuration is the length of a smooth scroll animation.
+const smoothScrollDuration = 150 * time.Millisecond
+
 // NewScrollView creates a scroll view for content.
 func NewScrollView(content runtime.Widget) *ScrollView {
 	vp := scroll.NewViewport(content)
@@ -33,6 +51,8 @@ func NewScrollView(content runtime.Widget) *ScrollView {
 		viewport: vp,
 		behavior: scroll.ScrollBehavior{Vertical: scroll.ScrollAuto, Horizontal: scroll.ScrollAuto, MouseWheel: 3, PageSize: 1},
 		style:    backend.DefaultStyle(),
+		easing:   scroll.EaseLinear,
+		live:     accessibility.NewLiveRegion(accessibility.Polite),
 		vScrollbar: scroll.Scrollbar{
 			Orientation:  scroll.Vertical,
 			Track:        backend.DefaultStyle(),
@@ -48,7 +68,10 @@ func NewScrollView(content runtime.Widget) *ScrollView {
 			Chars:        scroll.DefaultScrollbarChars(),
 		},
 	}
+	view.focusScope = runtime.NewFocusScope()
+	view.focusScope.SetWrap(false)
 	view.setViewportCallbacks()
+	view.refreshFocusScope()
 	return view
 }
 
@@ -63,6 +86,7 @@ func (s *ScrollView) SetContent(content runtime.Widget) {
 		s.viewport.SetContent(content)
 	}
 	s.setViewportCallbacks()
+	s.refreshFocusScope()
 }
 
 // SetBehavior updates scroll behavior.
@@ -73,15 +97,80 @@ func (s *ScrollView) SetBehavior(behavior scroll.ScrollBehavior) {
 	s.behavior = behavior
 }
 
-// Bind attaches app services.
+// SetEasing sets the easing curve used when ScrollBehavior.SmoothScroll is enabled.
+// A nil function restores linear easing.
+func (s *ScrollView) SetEasing(fn scroll.EasingFunc) {
+	if s == nil {
+		return
+	}
+	if fn == nil {
+		fn = scroll.EaseLinear
+	}
+	s.easing = fn
+}
+
+// Bind attaches app services and registers focusable content.
 func (s *ScrollView) Bind(services runtime.Services) {
 	s.services = services
+	if s.removeLive != nil {
+		s.removeLive()
+	}
+	s.removeLive = services.RegisterLiveRegion(s.live)
 	s.setViewportCallbacks()
+	s.refreshFocusScope()
+}
+
+// ChildFocusScope returns the scope that orders focus within the content.
+// Tab moves through it before leaving the scroll view.
+func (s *ScrollView) ChildFocusScope() *runtime.FocusScope {
+	if s == nil {
+		return nil
+	}
+	return s.focusScope
+}
+
+// Focus focuses the scroll view and its first focusable child.
+func (s *ScrollView) Focus() {
+	if s == nil {
+		return
+	}
+	s.FocusableBase.Focus()
+	if s.focusScope != nil && s.focusScope.Current() == nil {
+		s.focusScope.FocusFirst()
+	}
+}
+
+// Blur removes focus from the scroll view and its children.
+func (s *ScrollView) Blur() {
+	if s == nil {
+		return
+	}
+	s.FocusableBase.Blur()
+	if s.focusScope != nil {
+		s.focusScope.ClearFocus()
+	}
+}
+
+// refreshFocusScope re-registers focusable content without moving focus,
+// focusing the first child only when the focused one left while the scroll
+// view itself is focused.
+func (s *ScrollView) refreshFocusScope() {
+	if s.focusScope == nil {
+		return
+	}
+	runtime.RefreshFocusables(s.focusScope, s.content)
+	if s.focused && s.focusScope.Current() == nil {
+		s.focusScope.FocusFirst()
+	}
 }
 
 // Unbind releases app services.
 func (s *ScrollView) Unbind() {
 	s.services = runtime.Services{}
+	if s.removeLive != nil {
+		s.removeLive()
+		s.removeLive = nil
+	}
 }
 
 // Measure returns the desired size.
@@ -207,10 +296,30 @@ func (s *ScrollView) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	}
 	if s.content != nil {
 		if result := s.content.HandleMessage(msg); result.Handled {
+			s.revealVirtualSelection()
 			return result
 		}
 	}
 	switch ev := msg.(type) {
+	case runtime.KeyMsg:
+		if ev.Key == terminal.KeyTab && s.focused && s.focusScope.Count() > 0 {
+			if ev.Shift {
+				if s.focusScope.FocusPrev() {
+					return runtime.Handled()
+				}
+				return runtime.WithCommand(runtime.FocusPrev{})
+			}
+			if s.focusScope.FocusNext() {
+				return runtime.Handled()
+			}
+			return runtime.WithCommand(runtime.FocusNext{})
+		}
+	}
+	switch ev := msg.(type) {
+	case runtime.TickMsg:
+		if s.animating {
+			s.stepAnimation(ev.Time)
+		}
 	case runtime.KeyMsg:
 		if !s.focused {
 			return runtime.Unhandled()
@@ -265,6 +374,14 @@ func (s *ScrollView) ScrollBy(dx, dy int) {
 		s.virtualScrollBy(dy)
 		return
 	}
+	if s.behavior.SmoothScroll {
+		target := s.viewport.Offset()
+		if s.animating {
+			target = s.animTarget
+		}
+		s.animateTo(target.X+dx, target.Y+dy)
+		return
+	}
 	s.viewport.ScrollBy(dx, dy)
 }
 
@@ -278,6 +395,10 @@ func (s *ScrollView) ScrollTo(x, y int) {
 		s.viewport.ScrollTo(0, s.virtualOffsetForIndex(index))
 		return
 	}
+	if s.behavior.SmoothScroll {
+		s.animateTo(x, y)
+		return
+	}
 	s.viewport.ScrollTo(x, y)
 }
 
@@ -304,6 +425,53 @@ func (s *ScrollView) ScrollToEnd() {
 	s.ScrollTo(max.X, max.Y)
 }
 
+// animateTo starts a smooth scroll from the current offset to the target.
+func (s *ScrollView) animateTo(x, y int) {
+	maxOffset := s.viewport.MaxOffset()
+	target := image.Point{X: max(0, min(x, maxOffset.X)), Y: max(0, min(y, maxOffset.Y))}
+	current := s.viewport.Offset()
+	if target == current {
+		s.animating = false
+		return
+	}
+	s.animStart = current
+	s.animTarget = target
+	s.animStarted = time.Now()
+	s.animProgress = 0
+	s.animating = true
+	s.invalidate()
+}
+
+// stepAnimation advances a smooth scroll to the given time.
+func (s *ScrollView) stepAnimation(now time.Time) {
+	if s == nil || s.viewport == nil || !s.animating {
+		return
+	}
+	s.animProgress = float64(now.Sub(s.animStarted)) / float64(smoothScrollDuration)
+	if s.animProgress >= 1 {
+		s.animProgress = 1
+		s.animating = false
+		s.viewport.SetOffset(s.animTarget.X, s.animTarget.Y)
+		return
+	}
+	if s.animProgress < 0 {
+		s.animProgress = 0
+	}
+	easing := s.easing
+	if easing == nil {
+		easing = scroll.EaseLinear
+	}
+	fraction := easing(s.animProgress)
+	s.viewport.SetOffset(
+		lerpInt(s.animStart.X, s.animTarget.X, fraction),
+		lerpInt(s.animStart.Y, s.animTarget.Y, fraction),
+	)
+}
+
+func lerpInt(start, target int, fraction float64) int {
+	return start + int(math.Round(float64(target-start)*fraction))
+}
+
 func (s *ScrollView) pageSize() int {
 	if s == nil {
 		return 1
@@ -336,10 +504,6 @@ func (s *ScrollView) invalidate() {
 }
 
 func (s *ScrollView) announceScroll(offset image.Point, content runtime.Size, view runtime.Size) {
-	announcer := s.services.Announcer()
-	if announcer == nil {
-		return
-	}
 	if content.Height <= 0 {
 		return
 	}
@@ -350,8 +514,7 @@ func (s *ScrollView) announceScroll(offset image.Point, content runtime.Size, vi
 	if line > content.Height {
 		line = content.Height
 	}
-	message := fmt.Sprintf("Line %d of %d", line, content.Height)
-	announcer.Announce(message, 0)
+	s.live.Announce(fmt.Sprintf("Line %d of %d", line, content.Height))
 }
 
 func (s *ScrollView) drawScrollbars(ctx runtime.RenderContext) {
@@ -509,6 +672,37 @@ func (s *ScrollView) renderVirtual(ctx runtime.RenderContext) {
 		s.virtual.RenderItem(i, ctx.Sub(itemBounds))
 		y += itemHeight
 	}
+	if sticky, ok := s.virtual.(scroll.VirtualStickyHeaders); ok && start < count {
+		if header := sticky.StickyHeader(start); header >= 0 && header != start {
+			height := min(s.virtual.ItemHeight(header), bounds.Height)
+			headerBounds := runtime.Rect{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: height}
+			ctx.Buffer.Fill(headerBounds, ' ', s.style)
+			s.virtual.RenderItem(header, ctx.Sub(headerBounds))
+		}
+	}
+}
+
+// revealVirtualSelection scrolls the selected virtual item into view. Items
+// under a sticky header keep one extra line above them so the pinned header
+// does not cover them.
+func (s *ScrollView) revealVirtualSelection() {
+	selection, ok := s.virtual.(scroll.VirtualSelection)
+	if !ok || s.viewport == nil {
+		return
+	}
+	index := selection.SelectedItemIndex()
+	if index < 0 || index >= s.virtual.ItemCount() {
+		return
+	}
+	rect := runtime.Rect{Y: s.virtualOffsetForIndex(index), Width: 1, Height: max(s.virtual.ItemHeight(index), 1)}
+	if sticky, ok := s.virtual.(scroll.VirtualStickyHeaders); ok {
+		if header := sticky.StickyHeader(index); header >= 0 && header != index {
+			rect.Y--
+			rect.Height++
+		}
+	}
+	rect.X = s.viewport.Offset().X
+	s.viewport.EnsureVisible(rect)
 }
 
 func (s *ScrollView) virtualContentSize(constraints runtime.Constraints) runtime.Size {
@@ -0,0 +1,127 @@
+package widgets
+
+import (
+	"image"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/scroll"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func newSmoothScrollView() *ScrollView {
+	view := NewScrollView(NewText(strings.Repeat("line\n", 29) + "line"))
+	view.SetBehavior(scroll.ScrollBehavior{SmoothScroll: true, PageSize: 1})
+	view.Measure(runtime.Constraints{MaxWidth: 10, MaxHeight: 5})
+	view.Layout(runtime.Rect{Width: 10, Height: 5})
+	return view
+}
+
+func TestScrollView_SmoothScrollEasing(t *testing.T) {
+	view := newSmoothScrollView()
+	view.SetEasing(scroll.EaseOutCubic)
+
+	view.ScrollTo(0, 20)
+	if got := view.viewport.Offset(); got != (image.Point{}) {
+		t.Fatalf("offset = %+v, want animation to start at origin", got)
+	}
+
+	start := view.animStarted
+	view.HandleMessage(runtime.TickMsg{Time: start.Add(smoothScrollDuration / 2)})
+	if got := view.viewport.Offset().Y; got <= 10 {
+		t.Fatalf("mid-animation offset = %d, want > 10 for ease-out", got)
+	}
+
+	view.HandleMessage(runtime.TickMsg{Time: start.Add(smoothScrollDuration)})
+	if got := view.viewport.Offset().Y; got != 20 {
+		t.Fatalf("final offset = %d, want 20", got)
+	}
+	if view.animating {
+		t.Fatal("expected animation to finish")
+	}
+}
+
+func TestScrollView_SmoothScrollRetargets(t *testing.T) {
+	view := newSmoothScrollView()
+
+	view.ScrollBy(0, 3)
+	view.ScrollBy(0, 3)
+	view.HandleMessage(runtime.TickMsg{Time: time.Now().Add(time.Second)})
+	if got := view.viewport.Offset().Y; got != 6 {
+		t.Fatalf("offset = %d, want 6 after chained scrolls", got)
+	}
+}
+
+func TestScrollView_RefreshKeepsChildFocus(t *testing.T) {
+	first, second := NewInput(), NewInput()
+	var focuses, blurs int
+	for _, input := range []*Input{first, second} {
+		input.OnFocus(func() { focuses++ })
+		input.OnBlur(func(string) { blurs++ })
+	}
+	content := runtime.VBox(runtime.Fixed(first), runtime.Fixed(second))
+	view := NewScrollView(content)
+	view.Bind(runtime.Services{})
+	view.SetContent(content)
+	if focuses != 0 || blurs != 0 {
+		t.Fatalf("focus=%d blur=%d before the view was focused, want 0 and 0", focuses, blurs)
+	}
+
+	view.Focus()
+	view.Bind(runtime.Services{})
+	view.SetContent(content)
+	if focuses != 1 || blurs != 0 || !first.IsFocused() {
+		t.Fatalf("focus=%d blur=%d, want the first input focused once", focuses, blurs)
+	}
+
+	view.SetContent(runtime.VBox(runtime.Fixed(second)))
+	if !second.IsFocused() || first.IsFocused() || focuses != 2 || blurs != 1 {
+		t.Fatalf("focus=%d blur=%d, want focus moved to the remaining input", focuses, blurs)
+	}
+}
+
+func TestScrollView_TabCyclesNestedFocus(t *testing.T) {
+	inputs := []*Input{NewInput(), NewInput(), NewInput()}
+	content := runtime.VBox(runtime.Fixed(inputs[0]), runtime.Fixed(inputs[1]), runtime.Fixed(inputs[2]))
+	view := NewScrollView(content)
+	button := NewButton("OK")
+
+	screen := runtime.NewScreen(20, 10)
+	screen.SetRoot(runtime.VBox(runtime.Sized(view, 2), runtime.Fixed(button)))
+	scope := screen.FocusScope()
+	scope.RegisterAll(screen.Root())
+
+	if scope.Count() != 2 {
+		t.Fatalf("outer scope = %d widgets, want scroll view and button", scope.Count())
+	}
+	if !view.IsFocused() || !inputs[0].IsFocused() {
+		t.Fatal("expected scroll view and its first input to be focused")
+	}
+
+	tab := runtime.KeyMsg{Key: terminal.KeyTab}
+	for i := 1; i < len(inputs); i++ {
+		screen.HandleMessage(tab)
+		if !inputs[i].IsFocused() || inputs[i-1].IsFocused() {
+			t.Fatalf("after tab %d: expected input %d focused", i, i)
+		}
+	}
+
+	screen.HandleMessage(tab)
+	if !button.IsFocused() {
+		t.Fatal("fourth tab should move focus out of the scroll view")
+	}
+	if view.IsFocused() || inputs[2].IsFocused() {
+		t.Fatal("scroll view and its inputs should be blurred")
+	}
+
+	scope.FocusPrev()
+	if !inputs[2].IsFocused() {
+		t.Fatal("FocusPrev should re-enter the scroll view at its last input")
+	}
+
+	if !scope.SetFocus(inputs[1]) || !inputs[1].IsFocused() || inputs[2].IsFocused() {
+		t.Fatal("SetFocus should reach inputs inside the scroll view")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/theme"
 )
 
 // SelectOption represents a selectable option.
@@ -14,16 +15,27 @@ type SelectOption struct {
 	Disabled bool
 }
 
+// SelectGroup is a labelled section of options. Group labels are shown
+// but cannot be selected.
+type SelectGroup struct {
+	Label   string
+	Options []SelectOption
+}
+
 // Select is a dropdown-like selector (inline).
 type Select struct {
 	FocusableBase
 	accessibility.Base
 
-	options    []SelectOption
-	selected   int
-	onChange   func(option SelectOption)
-	style      backend.Style
-	focusStyle backend.Style
+	options     []SelectOption
+	groups      []SelectGroup
+	optionGroup []int // Group index per option; nil when ungrouped
+	selected    int
+	onChange    func(option SelectOption)
+	style       backend.Style
+	focusStyle  backend.Style
+	groupStyle  backend.Style
+	groupRole   themeRole
 }
 
 // NewSelect creates a select widget.
@@ -33,12 +45,59 @@ func NewSelect(options ...SelectOption) *Select {
 		selected:   0,
 		style:      backend.DefaultStyle(),
 		focusStyle: backend.DefaultStyle().Reverse(true),
+		groupStyle: backend.DefaultStyle().Bold(true).Underline(true),
 	}
 	s.Base.Role = accessibility.RoleTextbox
 	s.syncState()
 	return s
 }
 
+// NewGroupedSelect creates a select whose options are organised into groups.
+// Navigation moves across group boundaries without stopping on labels.
+func NewGroupedSelect(groups ...SelectGroup) *Select {
+	var options []SelectOption
+	var optionGroup []int
+	for i, group := range groups {
+		for _, option := range group.Options {
+			options = append(options, option)
+			optionGroup = append(optionGroup, i)
+		}
+	}
+	s := NewSelect(options...)
+	s.groups = groups
+	s.optionGroup = optionGroup
+	s.syncState()
+	return s
+}
+
+// SetGroupStyle sets the style used for group labels.
+func (s *Select) SetGroupStyle(style backend.Style) {
+	if s == nil {
+		return
+	}
+	s.groupStyle = style
+}
+
+// SetGroupRole sets the group label style to the active theme's token
+// for role.
+func (s *Select) SetGroupRole(role theme.Role) {
+	if s == nil {
+		return
+	}
+	s.SetGroupStyle(s.groupRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (s *Select) ApplyTheme() {
+	if s == nil {
+		return
+	}
+	if style, ok := s.groupRole.refresh(s.groupStyle); ok {
+		s.SetGroupStyle(style)
+	}
+}
+
 // SetOnChange sets the change handler.
 func (s *Select) SetOnChange(fn func(option SelectOption)) {
 	if s == nil {
@@ -55,6 +114,23 @@ func (s *Select) Selected() int {
 	return s.selected
 }
 
+// SelectedGroupIndex returns the selected option's group index and its
+// index within that group. The group index is -1 for ungrouped selects.
+func (s *Select) SelectedGroupIndex() (group, option int) {
+	if s == nil {
+		return -1, 0
+	}
+	if s.optionGroup == nil || s.selected < 0 || s.selected >= len(s.optionGroup) {
+		return -1, s.selected
+	}
+	group = s.optionGroup[s.selected]
+	start := s.selected
+	for start > 0 && s.optionGroup[start-1] == group {
+		start--
+	}
+	return group, s.selected - start
+}
+
 // SelectedOption returns the current option.
 func (s *Select) SelectedOption() (SelectOption, bool) {
 	if s == nil || s.selected < 0 || s.selected >= len(s.options) {
@@ -82,6 +158,9 @@ func (s *Select) SetSelected(index int) {
 func (s *Select) Measure(constraints runtime.Constraints) runtime.Size {
 	label := s.currentLabel()
 	width := len(label) + 4
+	if group := s.currentGroupLabel(); group != "" {
+		width += len(group) + len(selectGroupSeparator)
+	}
 	if width < 6 {
 		width = 6
 	}
@@ -98,14 +177,36 @@ func (s *Select) Render(ctx runtime.RenderContext) {
 		return
 	}
 	label := s.currentLabel()
-	text := "[" + truncateString(label, bounds.Width-4) + " v]"
 	style := s.style
 	if s.focused {
 		style = s.focusStyle
 	}
-	writePadded(ctx.Buffer, bounds.X, bounds.Y, bounds.Width, text, style)
+	group := s.currentGroupLabel()
+	if group == "" {
+		text := "[" + truncateString(label, bounds.Width-4) + " v]"
+		writePadded(ctx.Buffer, bounds.X, bounds.Y, bounds.Width, text, style)
+		return
+	}
+	// Grouped: "[Group › label v]" with the group label in groupStyle.
+	ctx.Buffer.Fill(runtime.Rect{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: 1}, ' ', style)
+	avail := bounds.Width - 4
+	group = truncateString(group, avail)
+	label = truncateString(label, avail-len(group)-len(selectGroupSeparator))
+	x := bounds.X
+	ctx.Buffer.SetString(x, bounds.Y, "[", style)
+	x++
+	ctx.Buffer.SetString(x, bounds.Y, group, s.groupStyle.Reverse(s.focused))
+	x += len(group)
+	if label != "" {
+		ctx.Buffer.SetString(x, bounds.Y, selectGroupSeparator+label, style)
+		x += len(selectGroupSeparator) + len(label)
+	}
+	ctx.Buffer.SetString(x, bounds.Y, " v]", style)
 }
 
+// selectGroupSeparator separates the group label from the option label.
+const selectGroupSeparator = ": "
+
 // HandleMessage changes selection.
 func (s *Select) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if s == nil || !s.focused {
@@ -161,6 +262,14 @@ func (s *Select) currentLabel() string {
 	return ""
 }
 
+func (s *Select) currentGroupLabel() string {
+	group, _ := s.SelectedGroupIndex()
+	if group < 0 || group >= len(s.groups) {
+		return ""
+	}
+	return s.groups[group].Label
+}
+
 func (s *Select) syncState() {
 	if s == nil {
 		return
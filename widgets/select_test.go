@@ -0,0 +1,61 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func newTestGroupedSelect() *Select {
+	sel := NewGroupedSelect(
+		SelectGroup{Label: "Fruit", Options: []SelectOption{{Label: "apple"}, {Label: "pear"}}},
+		SelectGroup{Label: "Veg", Options: []SelectOption{{Label: "leek"}, {Label: "kale"}}},
+	)
+	sel.Focus()
+	return sel
+}
+
+func TestSelect_GroupedNavigationSkipsLabels(t *testing.T) {
+	sel := newTestGroupedSelect()
+	sel.SetSelected(1)
+	if group, option := sel.SelectedGroupIndex(); group != 0 || option != 1 {
+		t.Fatalf("selected = (%d, %d), want (0, 1)", group, option)
+	}
+
+	sel.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if group, option := sel.SelectedGroupIndex(); group != 1 || option != 0 {
+		t.Fatalf("selected = (%d, %d), want (1, 0)", group, option)
+	}
+	if opt, _ := sel.SelectedOption(); opt.Label != "leek" {
+		t.Fatalf("option = %q, want leek", opt.Label)
+	}
+
+	sel.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if opt, _ := sel.SelectedOption(); opt.Label != "pear" {
+		t.Fatalf("option = %q, want pear", opt.Label)
+	}
+}
+
+func TestSelect_GroupedRender(t *testing.T) {
+	sel := newTestGroupedSelect()
+	sel.Blur()
+	sel.SetSelected(2)
+
+	buf := runtime.NewBuffer(20, 1)
+	sel.Layout(runtime.Rect{Width: 20, Height: 1})
+	sel.Render(runtime.RenderContext{Buffer: buf})
+	if got := strings.TrimSpace(buf.SnapshotText()); got != "[Veg: leek v]" {
+		t.Fatalf("render = %q", got)
+	}
+	attrs := buf.Get(1, 0).Style.Attributes()
+	if attrs&backend.AttrBold == 0 || attrs&backend.AttrUnderline == 0 {
+		t.Fatalf("group label attrs = %v, want bold and underline", attrs)
+	}
+
+	if group, _ := NewSelect(SelectOption{Label: "a"}).SelectedGroupIndex(); group != -1 {
+		t.Fatalf("ungrouped group index = %d, want -1", group)
+	}
+}
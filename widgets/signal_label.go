@@ -4,19 +4,23 @@ import (
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/state"
+	"github.com/odvcencio/fluffy-ui/theme"
 )
 
 // SignalLabel is a tiny label bound to a signal.
-// It demonstrates managing subscriptions in Mount/Unmount with a state.Scheduler.
+// It subscribes on construction so it stays current even if never mounted;
+// Unmount releases the subscription and Mount restores it.
 type SignalLabel struct {
 	Base
-	source    state.Readable[string]
-	scheduler state.Scheduler
-	subs      state.Subscriptions
-	text      string
-	style     backend.Style
-	alignment Alignment
-	mounted   bool
+	source     state.Readable[string]
+	scheduler  state.Scheduler
+	subs       state.Subscriptions
+	text       string
+	style      backend.Style
+	styleRole  themeRole
+	alignment  Alignment
+	subscribed bool
+	revision   uint64
 }
 
 // NewSignalLabel creates a new signal-backed label.
@@ -28,9 +32,7 @@ func NewSignalLabel(source state.Readable[string], scheduler state.Scheduler) *S
 		alignment: AlignLeft,
 	}
 	label.subs.SetScheduler(scheduler)
-	if source != nil {
-		label.text = source.Get()
-	}
+	label.subscribe()
 	return label
 }
 
@@ -42,11 +44,38 @@ func (s *SignalLabel) Text() string {
 // SetStyle sets the label style.
 func (s *SignalLabel) SetStyle(style backend.Style) {
 	s.style = style
+	s.revision++
+}
+
+// SetRole sets the label style to the active theme's token for role.
+func (s *SignalLabel) SetRole(role theme.Role) {
+	s.SetStyle(s.styleRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (s *SignalLabel) ApplyTheme() {
+	if s == nil {
+		return
+	}
+	if style, ok := s.styleRole.refresh(s.style); ok {
+		s.SetStyle(style)
+	}
 }
 
 // SetAlignment sets text alignment.
 func (s *SignalLabel) SetAlignment(align Alignment) {
 	s.alignment = align
+	s.revision++
+}
+
+// CacheKey implements runtime.Cacheable; it changes with each signal update
+// and each style or alignment change.
+func (s *SignalLabel) CacheKey() uint64 {
+	if s == nil {
+		return 0
+	}
+	return s.revision
 }
 
 // Measure returns the size needed for the label.
@@ -80,31 +109,36 @@ func (s *SignalLabel) Render(ctx runtime.RenderContext) {
 	ctx.Buffer.SetString(x, bounds.Y, text, s.style)
 }
 
-// Mount subscribes to signal changes.
+// Mount subscribes to signal changes. It is a no-op while already subscribed.
 func (s *SignalLabel) Mount() {
-	s.mounted = true
 	s.subscribe()
 }
 
 // Unmount unsubscribes from signal changes.
 func (s *SignalLabel) Unmount() {
-	s.mounted = false
+	s.subscribed = false
 	s.subs.Clear()
 }
 
 func (s *SignalLabel) subscribe() {
-	s.subs.Clear()
+	if s.subscribed {
+		return
+	}
 	if s.source == nil {
 		s.text = ""
 		return
 	}
+	s.subscribed = true
 	s.text = s.source.Get()
+	s.revision++
 	s.subs.Observe(s.source, s.onSignal)
 }
 
 func (s *SignalLabel) onSignal() {
-	if !s.mounted || s.source == nil {
+	if !s.subscribed || s.source == nil {
 		return
 	}
 	s.text = s.source.Get()
+	s.revision++
+	s.Invalidate()
 }
@@ -36,3 +36,51 @@ func TestSignalLabel_LifecycleQueue(t *testing.T) {
 		t.Fatalf("expected text to remain next after unmount, got %q", label.text)
 	}
 }
+
+func TestSignalLabel_UpdatesBeforeMount(t *testing.T) {
+	sig := state.NewSignal("start")
+	label := NewSignalLabel(sig, state.DirectScheduler)
+	label.ClearInvalidation()
+
+	sig.Set("next")
+	if label.Text() != "next" {
+		t.Fatalf("text = %q, want next before Mount", label.Text())
+	}
+	if !label.NeedsRender() {
+		t.Fatal("expected signal change to invalidate the label")
+	}
+}
+
+func TestSignalLabel_MountIsIdempotent(t *testing.T) {
+	sig := state.NewSignal("start")
+	queue := state.NewQueue()
+	label := NewSignalLabel(sig, queue)
+
+	label.Mount()
+	label.Mount()
+	sig.Set("next")
+	if flushed := queue.Flush(); flushed != 1 {
+		t.Fatalf("expected 1 queued callback, got %d", flushed)
+	}
+
+	label.Unmount()
+	label.Mount()
+	sig.Set("again")
+	if flushed := queue.Flush(); flushed != 1 {
+		t.Fatalf("expected 1 queued callback after remount, got %d", flushed)
+	}
+	if label.Text() != "again" {
+		t.Fatalf("text = %q, want again", label.Text())
+	}
+}
+
+func TestSignalLabel_CacheKeyChangesWithSignal(t *testing.T) {
+	sig := state.NewSignal("start")
+	label := NewSignalLabel(sig, nil)
+	before := label.CacheKey()
+
+	sig.Set("next")
+	if label.CacheKey() == before {
+		t.Fatal("expected the cache key to change after a signal update")
+	}
+}
@@ -0,0 +1,257 @@
+package widgets
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// Slider selects a value in a range by moving a thumb along a track.
+type Slider struct {
+	FocusableBase
+
+	value     float64
+	min       float64
+	max       float64
+	step      float64
+	pageStep  float64
+	showLabel bool
+	labelFunc func(value float64) string
+	dragging  bool
+	onChange  func(value float64)
+
+	style      backend.Style
+	fillStyle  backend.Style
+	focusStyle backend.Style
+}
+
+// NewSlider creates a slider over [min, max] starting at min. Arrow keys
+// step by a hundredth of the range and PgUp/PgDn by a tenth.
+func NewSlider(min, max float64) *Slider {
+	if min > max {
+		min, max = max, min
+	}
+	return &Slider{
+		value:      min,
+		min:        min,
+		max:        max,
+		step:       (max - min) / 100,
+		pageStep:   (max - min) / 10,
+		style:      backend.DefaultStyle(),
+		fillStyle:  backend.DefaultStyle(),
+		focusStyle: backend.DefaultStyle().Bold(true),
+	}
+}
+
+// Value returns the current value.
+func (s *Slider) Value() float64 {
+	if s == nil {
+		return 0
+	}
+	return s.value
+}
+
+// SetValue sets the value, clamped to the range.
+func (s *Slider) SetValue(v float64) {
+	if s == nil {
+		return
+	}
+	s.apply(v)
+}
+
+// SetStep sets the amount Left and Right move the value.
+func (s *Slider) SetStep(step float64) {
+	if s == nil {
+		return
+	}
+	s.step = math.Abs(step)
+}
+
+// SetPageStep sets the amount PgUp and PgDn move the value.
+func (s *Slider) SetPageStep(step float64) {
+	if s == nil {
+		return
+	}
+	s.pageStep = math.Abs(step)
+}
+
+// SetShowLabel shows the formatted value to the right of the track.
+func (s *Slider) SetShowLabel(show bool) {
+	if s == nil {
+		return
+	}
+	s.showLabel = show
+	s.Invalidate()
+}
+
+// SetLabelFunc sets how the value label is formatted and shows it.
+func (s *Slider) SetLabelFunc(fn func(value float64) string) {
+	if s == nil {
+		return
+	}
+	s.labelFunc = fn
+	s.showLabel = fn != nil || s.showLabel
+	s.Invalidate()
+}
+
+// SetStyles sets the track, filled track, and focused thumb styles.
+func (s *Slider) SetStyles(track, fill, focus backend.Style) {
+	if s == nil {
+		return
+	}
+	s.style = track
+	s.fillStyle = fill
+	s.focusStyle = focus
+}
+
+// OnChange registers a handler called whenever the value changes.
+func (s *Slider) OnChange(fn func(value float64)) {
+	if s == nil {
+		return
+	}
+	s.onChange = fn
+}
+
+// Measure fills the available width with one row.
+func (s *Slider) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.Constrain(runtime.Size{Width: constraints.MaxWidth, Height: 1})
+}
+
+// Render draws the track, filled up to the thumb with █, and the label.
+func (s *Slider) Render(ctx runtime.RenderContext) {
+	if s == nil {
+		return
+	}
+	bounds := s.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	track := s.trackWidth()
+	thumb := s.thumbColumn(track)
+	for i := 0; i < track; i++ {
+		switch {
+		case i == thumb && s.focused:
+			ctx.Buffer.Set(bounds.X+i, bounds.Y, '█', s.focusStyle)
+		case i <= thumb:
+			ctx.Buffer.Set(bounds.X+i, bounds.Y, '█', s.fillStyle)
+		default:
+			ctx.Buffer.Set(bounds.X+i, bounds.Y, '─', s.style)
+		}
+	}
+	if s.showLabel && track < bounds.Width {
+		label := truncateString(s.label(s.value), bounds.Width-track-1)
+		writePadded(ctx.Buffer, bounds.X+track, bounds.Y, bounds.Width-track, " "+label, s.style)
+	}
+}
+
+// HandleMessage moves the thumb with the keyboard and mouse.
+func (s *Slider) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if s == nil {
+		return runtime.Unhandled()
+	}
+	switch msg := msg.(type) {
+	case runtime.KeyMsg:
+		if !s.focused {
+			return runtime.Unhandled()
+		}
+		switch msg.Key {
+		case terminal.KeyLeft, terminal.KeyDown:
+			s.apply(s.value - s.step)
+		case terminal.KeyRight, terminal.KeyUp:
+			s.apply(s.value + s.step)
+		case terminal.KeyPageDown:
+			s.apply(s.value - s.pageStep)
+		case terminal.KeyPageUp:
+			s.apply(s.value + s.pageStep)
+		case terminal.KeyHome:
+			s.apply(s.min)
+		case terminal.KeyEnd:
+			s.apply(s.max)
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	case runtime.MouseMsg:
+		switch {
+		case msg.Action == runtime.MouseRelease:
+			s.dragging = false
+			return runtime.Unhandled()
+		case msg.Button != runtime.MouseLeft:
+			return runtime.Unhandled()
+		case msg.Action == runtime.MousePress && s.bounds.Contains(msg.X, msg.Y):
+			s.dragging = true
+		case msg.Action != runtime.MouseMove || !s.dragging:
+			return runtime.Unhandled()
+		}
+		track := s.trackWidth()
+		if track > 1 {
+			col := max(0, min(msg.X-s.bounds.X, track-1))
+			s.apply(s.min + float64(col)/float64(track-1)*(s.max-s.min))
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// apply clamps and stores v, notifying OnChange when the value changes.
+func (s *Slider) apply(v float64) {
+	v, ok := clampValue(v, s.min, s.max)
+	if !ok || v == s.value {
+		return
+	}
+	s.value = v
+	s.Invalidate()
+	if s.onChange != nil {
+		s.onChange(v)
+	}
+}
+
+// roundValue drops float noise so repeated fractional steps stay on round
+// values.
+func roundValue(v float64) float64 {
+	return math.Round(v*1e9) / 1e9
+}
+
+// clampValue rounds v and clamps it to [min, max]. It reports false for NaN
+// and infinite values, which have no place in a bounded range.
+func clampValue(v, min, max float64) (float64, bool) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+	return math.Max(min, math.Min(max, roundValue(v))), true
+}
+
+// label formats a value for the label.
+func (s *Slider) label(v float64) string {
+	if s.labelFunc != nil {
+		return s.labelFunc(v)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// trackWidth returns the track width, leaving room for the label. The label
+// is sized for the range ends as well so the track rarely changes width.
+func (s *Slider) trackWidth() int {
+	width := s.bounds.Width
+	if !s.showLabel {
+		return width
+	}
+	label := 0
+	for _, v := range []float64{s.min, s.max, s.value} {
+		label = max(label, runewidth.StringWidth(s.label(v)))
+	}
+	return max(width-label-1, 1)
+}
+
+// thumbColumn returns the track column of the thumb.
+func (s *Slider) thumbColumn(track int) int {
+	if s.max <= s.min || track <= 1 {
+		return 0
+	}
+	return int(math.Round((s.value - s.min) / (s.max - s.min) * float64(track-1)))
+}
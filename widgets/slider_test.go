@@ -0,0 +1,41 @@
+package widgets
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func TestSlider_KeysAndRender(t *testing.T) {
+	slider := NewSlider(0, 10)
+	slider.SetStep(1)
+	slider.Focus()
+	var changes []float64
+	slider.OnChange(func(v float64) { changes = append(changes, v) })
+
+	slider.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	slider.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageUp})
+	if slider.Value() != 2 || len(changes) != 2 {
+		t.Fatalf("value = %v, changes = %v", slider.Value(), changes)
+	}
+	slider.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnd})
+	slider.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if slider.Value() != 10 || len(changes) != 3 {
+		t.Fatalf("value = %v, changes = %v, want clamped at max", slider.Value(), changes)
+	}
+
+	slider.SetValue(5)
+	slider.Blur()
+	slider.SetLabelFunc(func(v float64) string { return fmt.Sprintf("%.0f%%", v*10) })
+	if out := renderToString(slider, 16, 1); out != "██████───── 50% \n" {
+		t.Fatalf("render = %q", out)
+	}
+
+	slider.HandleMessage(runtime.MouseMsg{X: 0, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	slider.HandleMessage(runtime.MouseMsg{X: 99, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseMove})
+	if slider.Value() != 10 {
+		t.Fatalf("value = %v, want dragging past the end to reach max", slider.Value())
+	}
+}
@@ -0,0 +1,251 @@
+package widgets
+
+import (
+	"math"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// Orientation is the split direction of a SplitView: SplitHorizontal places
+// the panes side by side and SplitVertical stacks them.
+type Orientation = SplitterOrientation
+
+// SplitView divides its bounds between two panes with a divider the user can
+// move with Alt+arrow keys or by dragging it.
+type SplitView struct {
+	Base
+	first       runtime.Widget
+	second      runtime.Widget
+	orientation Orientation
+	ratio       float64
+	minPane     int
+	split       int
+	dragging    bool
+	onResize    func(ratio float64)
+
+	style     backend.Style
+	dragStyle backend.Style
+}
+
+// NewSplitView creates a split view with the divider in the middle.
+func NewSplitView(orientation Orientation, a, b runtime.Widget) *SplitView {
+	return &SplitView{
+		first:       a,
+		second:      b,
+		orientation: orientation,
+		ratio:       0.5,
+		style:       backend.DefaultStyle(),
+		dragStyle:   backend.DefaultStyle().Bold(true),
+	}
+}
+
+// Ratio returns the share of the space given to the first pane.
+func (s *SplitView) Ratio() float64 {
+	if s == nil {
+		return 0
+	}
+	return s.ratio
+}
+
+// SetRatio sets the share of the space given to the first pane, from 0 to 1.
+func (s *SplitView) SetRatio(ratio float64) {
+	if s == nil {
+		return
+	}
+	s.ratio = math.Max(0, math.Min(1, ratio))
+	s.Layout(s.bounds)
+	s.Invalidate()
+}
+
+// SetMinPaneSize keeps both panes at least n cells wide (or tall).
+func (s *SplitView) SetMinPaneSize(n int) {
+	if s == nil {
+		return
+	}
+	s.minPane = max(n, 0)
+	s.Layout(s.bounds)
+}
+
+// SetOnResize registers a handler called with the new ratio when the user
+// moves the divider.
+func (s *SplitView) SetOnResize(fn func(ratio float64)) {
+	if s == nil {
+		return
+	}
+	s.onResize = fn
+}
+
+// span returns the cells shared by the panes along the split axis.
+func (s *SplitView) span() int {
+	size := s.bounds.Width
+	if s.orientation == SplitVertical {
+		size = s.bounds.Height
+	}
+	return max(size-1, 0)
+}
+
+// clampSplit keeps the first pane size within the minimum pane sizes.
+func (s *SplitView) clampSplit(first int) int {
+	span := s.span()
+	low, high := s.minPane, span-s.minPane
+	if low > high {
+		return span / 2
+	}
+	return max(low, min(high, first))
+}
+
+// Measure returns the largest pane size.
+func (s *SplitView) Measure(constraints runtime.Constraints) runtime.Size {
+	size := constraints.MinSize()
+	for _, child := range s.ChildWidgets() {
+		childSize := child.Measure(constraints)
+		size.Width = max(size.Width, childSize.Width)
+		size.Height = max(size.Height, childSize.Height)
+	}
+	return constraints.Constrain(size)
+}
+
+// Layout positions the panes on either side of the divider.
+func (s *SplitView) Layout(bounds runtime.Rect) {
+	s.Base.Layout(bounds)
+	s.split = s.clampSplit(int(math.Round(float64(s.span()) * s.ratio)))
+	first, second := s.paneBounds()
+	if s.first != nil {
+		s.first.Layout(first)
+	}
+	if s.second != nil {
+		s.second.Layout(second)
+	}
+}
+
+func (s *SplitView) paneBounds() (first, second runtime.Rect) {
+	b := s.bounds
+	if s.orientation == SplitVertical {
+		first = runtime.Rect{X: b.X, Y: b.Y, Width: b.Width, Height: s.split}
+		second = runtime.Rect{X: b.X, Y: b.Y + s.split + 1, Width: b.Width, Height: max(b.Height-s.split-1, 0)}
+		return first, second
+	}
+	first = runtime.Rect{X: b.X, Y: b.Y, Width: s.split, Height: b.Height}
+	second = runtime.Rect{X: b.X + s.split + 1, Y: b.Y, Width: max(b.Width-s.split-1, 0), Height: b.Height}
+	return first, second
+}
+
+// divider returns the divider's bounds.
+func (s *SplitView) divider() runtime.Rect {
+	b := s.bounds
+	if s.orientation == SplitVertical {
+		return runtime.Rect{X: b.X, Y: b.Y + s.split, Width: b.Width, Height: min(1, b.Height)}
+	}
+	return runtime.Rect{X: b.X + s.split, Y: b.Y, Width: min(1, b.Width), Height: b.Height}
+}
+
+// Render draws both panes and the divider.
+func (s *SplitView) Render(ctx runtime.RenderContext) {
+	if s == nil {
+		return
+	}
+	for _, child := range s.ChildWidgets() {
+		child.Render(ctx)
+	}
+	divider := s.divider()
+	if divider.Width <= 0 || divider.Height <= 0 {
+		return
+	}
+	style := s.style
+	if s.dragging {
+		style = s.dragStyle
+	}
+	ch := '│'
+	if s.orientation == SplitVertical {
+		ch = '─'
+	}
+	ctx.Buffer.Fill(divider, ch, style)
+}
+
+// HandleMessage forwards messages to the panes, then moves the divider on
+// Alt+arrow keys and mouse drags.
+func (s *SplitView) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if s == nil {
+		return runtime.Unhandled()
+	}
+	if mouse, ok := msg.(runtime.MouseMsg); ok && s.dragging {
+		return s.handleDrag(mouse)
+	}
+	for _, child := range s.ChildWidgets() {
+		if result := child.HandleMessage(msg); result.Handled {
+			return result
+		}
+	}
+	switch msg := msg.(type) {
+	case runtime.KeyMsg:
+		if !msg.Alt {
+			return runtime.Unhandled()
+		}
+		delta := 0
+		switch {
+		case msg.Key == terminal.KeyLeft && s.orientation == SplitHorizontal,
+			msg.Key == terminal.KeyUp && s.orientation == SplitVertical:
+			delta = -1
+		case msg.Key == terminal.KeyRight && s.orientation == SplitHorizontal,
+			msg.Key == terminal.KeyDown && s.orientation == SplitVertical:
+			delta = 1
+		default:
+			return runtime.Unhandled()
+		}
+		s.moveDivider(s.split + delta)
+		return runtime.Handled()
+	case runtime.MouseMsg:
+		if msg.Action == runtime.MousePress && msg.Button == runtime.MouseLeft && s.divider().Contains(msg.X, msg.Y) {
+			s.dragging = true
+			s.Invalidate()
+			return runtime.Handled()
+		}
+	}
+	return runtime.Unhandled()
+}
+
+func (s *SplitView) handleDrag(msg runtime.MouseMsg) runtime.HandleResult {
+	switch msg.Action {
+	case runtime.MouseMove:
+		if s.orientation == SplitVertical {
+			s.moveDivider(msg.Y - s.bounds.Y)
+		} else {
+			s.moveDivider(msg.X - s.bounds.X)
+		}
+	case runtime.MouseRelease:
+		s.dragging = false
+		s.Invalidate()
+	}
+	return runtime.Handled()
+}
+
+// moveDivider puts the divider after first cells and reports the new ratio.
+func (s *SplitView) moveDivider(first int) {
+	first = s.clampSplit(first)
+	if first == s.split || s.span() == 0 {
+		return
+	}
+	s.ratio = float64(first) / float64(s.span())
+	s.Layout(s.bounds)
+	s.Invalidate()
+	if s.onResize != nil {
+		s.onResize(s.ratio)
+	}
+}
+
+// ChildWidgets returns the panes.
+func (s *SplitView) ChildWidgets() []runtime.Widget {
+	if s == nil {
+		return nil
+	}
+	children := make([]runtime.Widget, 0, 2)
+	if s.first != nil {
+		children = append(children, s.first)
+	}
+	if s.second != nil {
+		children = append(children, s.second)
+	}
+	return children
+}
@@ -0,0 +1,39 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func TestSplitView_ResizeWithKeysAndMouse(t *testing.T) {
+	left, right := NewLabel("L"), NewLabel("R")
+	split := NewSplitView(SplitHorizontal, left, right)
+	split.SetMinPaneSize(3)
+	var ratios []float64
+	split.SetOnResize(func(ratio float64) { ratios = append(ratios, ratio) })
+
+	if out := renderToString(split, 11, 1); out != "L    │R    \n" {
+		t.Fatalf("render = %q", out)
+	}
+	split.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Alt: true})
+	if left.Bounds().Width != 6 || right.Bounds().X != 7 || len(ratios) != 1 || split.Ratio() != 0.6 {
+		t.Fatalf("left = %+v, right = %+v, ratios = %v", left.Bounds(), right.Bounds(), ratios)
+	}
+	if split.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Alt: true}).Handled {
+		t.Fatal("Alt+Down should not move a horizontal divider")
+	}
+
+	split.HandleMessage(runtime.MouseMsg{X: 6, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	split.HandleMessage(runtime.MouseMsg{X: 0, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseMove})
+	split.HandleMessage(runtime.MouseMsg{X: 0, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if left.Bounds().Width != 3 {
+		t.Fatalf("left width = %d, want the minimum pane size", left.Bounds().Width)
+	}
+
+	split.SetRatio(1)
+	if right.Bounds().Width != 3 || len(ratios) != 2 {
+		t.Fatalf("right width = %d, ratios = %v", right.Bounds().Width, ratios)
+	}
+}
@@ -1,21 +1,44 @@
 package widgets
 
 import (
+	"sort"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/scroll"
 	"github.com/odvcencio/fluffy-ui/terminal"
+	"github.com/odvcencio/fluffy-ui/theme"
 )
 
 // TableColumn defines a column in a table.
 type TableColumn struct {
 	Title string
 	Width int
+	// AutoSize sizes the column to its widest cell (sampling the first
+	// tableAutoSizeSample rows). Results are cached until SetRows is called.
+	AutoSize bool
+	// MaxWidth caps auto-sized columns when positive.
+	MaxWidth int
+	// SortFunc, when set, makes the column sortable. It reports whether
+	// cell a sorts before cell b in ascending order.
+	SortFunc func(a, b string) bool
+	// Editable allows editing the column's cells when the table is editable.
+	Editable bool
+	// MinWidth is the narrowest the column can be resized to (default 3).
+	MinWidth int
 }
 
+// tableAutoSizeSample limits how many rows are scanned for auto-sized columns.
+const tableAutoSizeSample = 200
+
 // Table is a simple data grid widget.
 type Table struct {
 	FocusableBase
+	accessibility.Base
 	Columns       []TableColumn
 	Rows          [][]string
 	selected      int
@@ -23,27 +46,303 @@ type Table struct {
 	style         backend.Style
 	headerStyle   backend.Style
 	selectedStyle backend.Style
+	multiStyle    backend.Style
+	multiRole     themeRole
 	cachedWidths  []int
 	cachedTotal   int
 	cachedSig     uint32
+	autoWidths    []int
+	hideHeader    bool
+	onActivate    func(row int, cells []string)
+	onSelChange   func(row int)
+	onMultiChange func(indices []int)
+	services      runtime.Services
+	announcing    bool
+
+	activeCol     int
+	sortCol       int
+	sortAscending bool
+	sortKey       rune
+
+	// marked holds the multi-row selection; when empty only the primary
+	// selected row is selected. rangeAnchor is where Shift ranges start.
+	marked      map[int]bool
+	rangeAnchor int
+
+	editable   bool
+	onCellEdit func(row, col int, value string)
+	editor     *tableCellEditor
+
+	// userWidths holds widths set by resizing; nil until the first resize.
+	userWidths []int
+	onResize   func(col, width int)
+
+	// source, when set, supplies rows in place of Rows; rowCache holds the
+	// fetched window and cacheRows its margin around the visible range.
+	source    TableDataSource
+	rowCache  tableRowCache
+	cacheRows int
+
+	detailRenderer func(row []string, width int, ctx runtime.RenderContext) int
+	expanded       int
+	detailHeight   int
+	detailBuf      *runtime.Buffer
 }
 
 // NewTable creates a table with columns.
 func NewTable(columns ...TableColumn) *Table {
-	return &Table{
+	t := &Table{
 		Columns:       columns,
 		style:         backend.DefaultStyle(),
 		headerStyle:   backend.DefaultStyle().Bold(true),
 		selectedStyle: backend.DefaultStyle().Reverse(true),
+		multiStyle:    backend.DefaultStyle().Background(backend.ColorCyan),
+		expanded:      -1,
+		rangeAnchor:   -1,
+		sortCol:       -1,
+		sortKey:       's',
+	}
+	t.Base.Role = accessibility.RoleTable
+	return t
+}
+
+// AccessibleValue reports the selected row index and its cells joined by tabs.
+func (t *Table) AccessibleValue() *accessibility.ValueInfo {
+	if t == nil || t.selected < 0 || t.selected >= t.rowCount() {
+		return nil
+	}
+	return &accessibility.ValueInfo{
+		Min:     0,
+		Max:     float64(t.rowCount() - 1),
+		Current: float64(t.selected),
+		Text:    strings.Join(t.row(t.selected), "\t"),
 	}
 }
 
-// SetRows updates table rows.
+// Bind attaches app services.
+func (t *Table) Bind(services runtime.Services) {
+	t.services = services
+}
+
+// Unbind releases app services.
+func (t *Table) Unbind() {
+	t.services = runtime.Services{}
+	t.announcing = false
+}
+
+// announceRow reads the selected row's cells to screen readers. Announcements
+// go through the state queue, so rapid navigation between flushes produces a
+// single announcement for the final row.
+func (t *Table) announceRow() {
+	if t.services.Announcer() == nil {
+		return
+	}
+	scheduler := t.services.Scheduler()
+	if scheduler == nil {
+		t.sendRowAnnouncement()
+		return
+	}
+	if t.announcing {
+		return
+	}
+	t.announcing = true
+	scheduler.Schedule(func() {
+		t.announcing = false
+		t.sendRowAnnouncement()
+	})
+}
+
+func (t *Table) sendRowAnnouncement() {
+	announcer := t.services.Announcer()
+	value := t.AccessibleValue()
+	if announcer == nil || value == nil {
+		return
+	}
+	announcer.Announce(value.Text, accessibility.PriorityPolite)
+}
+
+// SetRows updates table rows, keeping the current sort order if any. It
+// detaches the data source of a virtual table.
 func (t *Table) SetRows(rows [][]string) {
 	if t == nil {
 		return
 	}
 	t.Rows = rows
+	t.source = nil
+	t.rowCache = tableRowCache{}
+	t.autoWidths = nil
+	t.cachedWidths = nil
+	t.clearMarked()
+	if t.sortCol >= 0 {
+		t.SortByColumn(t.sortCol, t.sortAscending)
+	}
+}
+
+// SetSortKey sets the key that sorts by the active column (default 's').
+// Left and Right choose the active column.
+func (t *Table) SetSortKey(r rune) {
+	if t == nil {
+		return
+	}
+	t.sortKey = r
+}
+
+// SortColumn returns the sorted column and direction, or -1 when unsorted.
+func (t *Table) SortColumn() (col int, ascending bool) {
+	if t == nil {
+		return -1, false
+	}
+	return t.sortCol, t.sortAscending
+}
+
+// SortByColumn sorts Rows by a column with a SortFunc. The selected and
+// expanded rows follow their data to its new position.
+func (t *Table) SortByColumn(col int, ascending bool) {
+	if t == nil || !t.canSort(col) {
+		return
+	}
+	less := t.Columns[col].SortFunc
+	cell := func(row int) string {
+		if col < len(t.Rows[row]) {
+			return t.Rows[row][col]
+		}
+		return ""
+	}
+	order := make([]int, t.rowCount())
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if ascending {
+			return less(cell(order[a]), cell(order[b]))
+		}
+		return less(cell(order[b]), cell(order[a]))
+	})
+	rows := make([][]string, t.rowCount())
+	selected, expanded, anchor := t.selected, t.expanded, t.rangeAnchor
+	var marked map[int]bool
+	if len(t.marked) > 0 {
+		marked = make(map[int]bool, len(t.marked))
+	}
+	for i, from := range order {
+		rows[i] = t.Rows[from]
+		if from == t.selected {
+			selected = i
+		}
+		if from == t.expanded {
+			expanded = i
+		}
+		if from == t.rangeAnchor {
+			anchor = i
+		}
+		if t.marked[from] {
+			marked[i] = true
+		}
+	}
+	t.Rows = rows
+	t.selected = selected
+	t.expanded = expanded
+	t.rangeAnchor = anchor
+	t.marked = marked
+	t.sortCol = col
+	t.sortAscending = ascending
+	t.activeCol = col
+	t.Invalidate()
+}
+
+// canSort reports whether a column has a SortFunc. Virtual tables leave
+// sorting to their data source.
+func (t *Table) canSort(col int) bool {
+	return t.source == nil && col >= 0 && col < len(t.Columns) && t.Columns[col].SortFunc != nil
+}
+
+// canEdit reports whether a column's cells can be edited.
+func (t *Table) canEdit(col int) bool {
+	return t.editable && col >= 0 && col < len(t.Columns) && t.Columns[col].Editable
+}
+
+// canActivate reports whether a column can be the active column.
+func (t *Table) canActivate(col int) bool {
+	return col >= 0 && col < len(t.Columns)
+}
+
+// nextColumn returns the first column after from in direction that
+// satisfies ok, or -1.
+func (t *Table) nextColumn(from, direction int, ok func(col int) bool) int {
+	for col := from + direction; col >= 0 && col < len(t.Columns); col += direction {
+		if ok(col) {
+			return col
+		}
+	}
+	return -1
+}
+
+// moveActiveColumn moves the active column in direction, reporting whether
+// it moved.
+func (t *Table) moveActiveColumn(direction int) bool {
+	col := t.nextColumn(t.activeCol, direction, t.canActivate)
+	if col < 0 {
+		return false
+	}
+	t.activeCol = col
+	t.Invalidate()
+	return true
+}
+
+// toggleSort sorts by the active column, ascending first and reversing on
+// repeated presses.
+func (t *Table) toggleSort() bool {
+	col := t.activeCol
+	if !t.canSort(col) {
+		if col = t.nextColumn(-1, 1, t.canSort); col < 0 {
+			return false
+		}
+	}
+	ascending := true
+	if t.sortCol == col {
+		ascending = !t.sortAscending
+	}
+	t.SortByColumn(col, ascending)
+	return true
+}
+
+// OnActivate registers a handler called when Enter is pressed on a row.
+func (t *Table) OnActivate(fn func(row int, cells []string)) {
+	if t == nil {
+		return
+	}
+	t.onActivate = fn
+}
+
+// OnSelectionChange registers a handler called when the highlighted row changes.
+func (t *Table) OnSelectionChange(fn func(row int)) {
+	if t == nil {
+		return
+	}
+	t.onSelChange = fn
+}
+
+// SetHeaderVisible shows or hides the header row. A hidden header gives its
+// line to data rows.
+func (t *Table) SetHeaderVisible(visible bool) {
+	if t == nil {
+		return
+	}
+	t.hideHeader = !visible
+	t.Invalidate()
+}
+
+// HeaderVisible reports whether the header row is drawn.
+func (t *Table) HeaderVisible() bool {
+	return t != nil && !t.hideHeader
+}
+
+// headerHeight returns the lines reserved for the header.
+func (t *Table) headerHeight() int {
+	if t.hideHeader {
+		return 0
+	}
+	return 1
 }
 
 // SelectedIndex returns the currently selected row index.
@@ -54,9 +353,246 @@ func (t *Table) SelectedIndex() int {
 	return t.selected
 }
 
+// ColumnTitles returns the column titles in order.
+func (t *Table) ColumnTitles() []string {
+	if t == nil {
+		return nil
+	}
+	titles := make([]string, len(t.Columns))
+	for i, col := range t.Columns {
+		titles[i] = col.Title
+	}
+	return titles
+}
+
+// SelectedIndices returns the selected row indices in ascending order. With
+// no multi-row selection it holds just the primary selected row.
+func (t *Table) SelectedIndices() []int {
+	if t == nil || t.rowCount() == 0 {
+		return nil
+	}
+	if len(t.marked) == 0 {
+		if t.selected < 0 || t.selected >= t.rowCount() {
+			return nil
+		}
+		return []int{t.selected}
+	}
+	indices := make([]int, 0, len(t.marked))
+	for row := range t.marked {
+		indices = append(indices, row)
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// SelectedRows returns the cells of the selected rows in row order.
+func (t *Table) SelectedRows() [][]string {
+	indices := t.SelectedIndices()
+	rows := make([][]string, 0, len(indices))
+	for _, index := range indices {
+		if index < t.rowCount() {
+			rows = append(rows, t.row(index))
+		}
+	}
+	return rows
+}
+
+// SelectAll selects every row.
+func (t *Table) SelectAll() {
+	if t == nil || t.rowCount() == 0 {
+		return
+	}
+	count := t.rowCount()
+	marked := make(map[int]bool, count)
+	for i := 0; i < count; i++ {
+		marked[i] = true
+	}
+	t.setMarked(marked)
+}
+
+// OnMultiSelectionChange registers a handler called with the selected row
+// indices whenever the multi-row selection changes.
+func (t *Table) OnMultiSelectionChange(fn func(indices []int)) {
+	if t == nil {
+		return
+	}
+	t.onMultiChange = fn
+}
+
+// SetMultiSelectedStyle sets the style of rows in a multi-row selection
+// other than the primary row (default cyan background).
+func (t *Table) SetMultiSelectedStyle(style backend.Style) {
+	if t == nil {
+		return
+	}
+	t.multiStyle = style
+	t.Invalidate()
+}
+
+// SetMultiSelectedRole sets the multi-row selection style to the active
+// theme's token for role.
+func (t *Table) SetMultiSelectedRole(role theme.Role) {
+	if t == nil {
+		return
+	}
+	t.SetMultiSelectedStyle(t.multiRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (t *Table) ApplyTheme() {
+	if t == nil {
+		return
+	}
+	if style, ok := t.multiRole.refresh(t.multiStyle); ok {
+		t.SetMultiSelectedStyle(style)
+	}
+}
+
+// setMarked replaces the multi-row selection and notifies the handler.
+func (t *Table) setMarked(marked map[int]bool) {
+	if len(marked) == 0 && len(t.marked) == 0 {
+		return
+	}
+	t.marked = marked
+	t.Invalidate()
+	if t.onMultiChange != nil {
+		t.onMultiChange(t.SelectedIndices())
+	}
+}
+
+// clearMarked drops the multi-row selection.
+func (t *Table) clearMarked() {
+	t.rangeAnchor = -1
+	t.setMarked(nil)
+}
+
+// extendRange moves the primary row by delta and selects the rows between
+// the range anchor and it.
+func (t *Table) extendRange(delta int) {
+	if t.rowCount() == 0 {
+		return
+	}
+	if t.rangeAnchor < 0 {
+		t.rangeAnchor = t.selected
+	}
+	t.setSelected(t.selected + delta)
+	t.markRange(t.rangeAnchor, t.selected)
+}
+
+func (t *Table) markRange(from, to int) {
+	if from > to {
+		from, to = to, from
+	}
+	marked := make(map[int]bool, to-from+1)
+	for row := from; row <= to; row++ {
+		marked[row] = true
+	}
+	t.setMarked(marked)
+}
+
+// toggleMarked adds or removes a row from the multi-row selection and makes
+// it the primary row.
+func (t *Table) toggleMarked(row int) {
+	marked := make(map[int]bool, len(t.marked)+2)
+	if len(t.marked) == 0 && t.selected >= 0 && t.selected < t.rowCount() {
+		marked[t.selected] = true
+	}
+	for index := range t.marked {
+		marked[index] = true
+	}
+	if marked[row] {
+		delete(marked, row)
+	} else {
+		marked[row] = true
+	}
+	t.rangeAnchor = row
+	t.setSelected(row)
+	t.setMarked(marked)
+}
+
+// rowAt returns the row drawn at screen line y, or -1.
+func (t *Table) rowAt(y int) int {
+	line := t.bounds.Y + t.headerHeight()
+	end := t.bounds.Y + t.bounds.Height
+	for row := max(t.offset, 0); row < t.rowCount() && line < end; row++ {
+		if y == line {
+			return row
+		}
+		line++
+		if row == t.expanded {
+			line += t.detailHeight
+		}
+	}
+	return -1
+}
+
+// handleMouse selects the clicked row. Ctrl+Click toggles the row in the
+// multi-row selection and Shift+Click selects a range.
+func (t *Table) handleMouse(mouse runtime.MouseMsg) bool {
+	if mouse.Action != runtime.MousePress || mouse.Button != runtime.MouseLeft || !t.bounds.Contains(mouse.X, mouse.Y) {
+		return false
+	}
+	row := t.rowAt(mouse.Y)
+	if row < 0 {
+		return false
+	}
+	switch {
+	case mouse.Ctrl:
+		t.toggleMarked(row)
+	case mouse.Shift:
+		if t.rangeAnchor < 0 {
+			t.rangeAnchor = t.selected
+		}
+		t.setSelected(row)
+		t.markRange(t.rangeAnchor, row)
+	default:
+		t.clearMarked()
+		t.setSelected(row)
+	}
+	t.Invalidate()
+	return true
+}
+
+// SetDetailRenderer registers a renderer for the inline row detail view.
+// The renderer draws below the expanded row and returns the number of
+// extra rows it used. Enter toggles the detail for the selected row.
+func (t *Table) SetDetailRenderer(fn func(row []string, width int, ctx runtime.RenderContext) int) {
+	if t == nil {
+		return
+	}
+	t.detailRenderer = fn
+	if fn == nil {
+		t.expanded = -1
+		t.detailHeight = 0
+	}
+	t.Invalidate()
+}
+
+// ExpandedRow returns the index of the expanded row, or -1 if none.
+func (t *Table) ExpandedRow() int {
+	if t == nil {
+		return -1
+	}
+	return t.expanded
+}
+
+// SetExpanded expands the detail view for a row, collapsing any other.
+// Pass -1 to collapse.
+func (t *Table) SetExpanded(row int) {
+	if t == nil {
+		return
+	}
+	if row < 0 || row >= t.rowCount() {
+		row = -1
+	}
+	t.expanded = row
+	t.Invalidate()
+}
+
 // Measure returns the desired size.
 func (t *Table) Measure(constraints runtime.Constraints) runtime.Size {
-	height := min(len(t.Rows)+1, constraints.MaxHeight)
+	height := min(t.rowCount()+t.headerHeight()+t.expandedHeight(), constraints.MaxHeight)
 	if height <= 0 {
 		height = constraints.MinHeight
 	}
@@ -77,43 +613,64 @@ func (t *Table) Render(ctx runtime.RenderContext) {
 	if len(widths) == 0 {
 		return
 	}
-	// Header
+	// Header stays pinned at the top while rows scroll beneath it.
 	x := bounds.X
-	for i, col := range t.Columns {
-		if x >= bounds.X+bounds.Width {
-			break
+	if !t.hideHeader {
+		showActive := t.focused && len(t.Columns) > 1
+		for i, col := range t.Columns {
+			if x >= bounds.X+bounds.Width {
+				break
+			}
+			width := widths[i]
+			title := truncateString(col.Title, width)
+			if i == t.sortCol && width > 2 {
+				arrow := "▲"
+				if !t.sortAscending {
+					arrow = "▼"
+				}
+				title = truncateString(col.Title, width-2) + " " + arrow
+			}
+			style := t.headerStyle
+			if showActive && i == t.activeCol {
+				style = style.Underline(true)
+			}
+			writePadded(ctx.Buffer, x, bounds.Y, width, title, style)
+			x += width + 1
 		}
-		width := widths[i]
-		title := truncateString(col.Title, width)
-		writePadded(ctx.Buffer, x, bounds.Y, width, title, t.headerStyle)
-		x += width + 1
 	}
 
 	// Rows
-	rowArea := bounds.Height - 1
+	top := bounds.Y + t.headerHeight()
+	rowArea := bounds.Height - t.headerHeight()
 	if rowArea <= 0 {
 		return
 	}
 	if t.selected < 0 {
 		t.selected = 0
 	}
-	if t.selected >= len(t.Rows) {
-		t.selected = len(t.Rows) - 1
-	}
-	if t.selected < t.offset {
-		t.offset = t.selected
+	if t.selected >= t.rowCount() {
+		t.selected = t.rowCount() - 1
 	}
-	if t.selected >= t.offset+rowArea {
-		t.offset = t.selected - rowArea + 1
+	if t.expanded >= t.rowCount() {
+		t.expanded = -1
 	}
-	for row := 0; row < rowArea; row++ {
-		rowIndex := t.offset + row
-		if rowIndex < 0 || rowIndex >= len(t.Rows) {
-			break
+	t.renderDetail(bounds.Width, rowArea)
+	t.ensureSelectedVisible(rowArea)
+
+	t.fetchRows(t.offset, t.offset+rowArea)
+
+	count := t.rowCount()
+	y := top
+	end := top + rowArea
+	for rowIndex := t.offset; rowIndex < count && y < end; rowIndex++ {
+		if rowIndex < 0 {
+			continue
 		}
 		style := t.style
 		if rowIndex == t.selected {
 			style = t.selectedStyle
+		} else if t.marked[rowIndex] {
+			style = t.multiStyle
 		}
 		x = bounds.X
 		for colIndex, width := range widths {
@@ -121,43 +678,169 @@ func (t *Table) Render(ctx runtime.RenderContext) {
 				break
 			}
 			cell := ""
-			if colIndex < len(t.Rows[rowIndex]) {
-				cell = t.Rows[rowIndex][colIndex]
+			if row := t.row(rowIndex); colIndex < len(row) {
+				cell = row[colIndex]
 			}
 			cell = truncateString(cell, width)
-			writePadded(ctx.Buffer, x, bounds.Y+1+row, width, cell, style)
+			writePadded(ctx.Buffer, x, y, width, cell, style)
 			x += width + 1
 		}
+		y++
+		if rowIndex == t.expanded && t.detailHeight > 0 {
+			lines := min(t.detailHeight, end-y)
+			for line := 0; line < lines; line++ {
+				for col := 0; col < bounds.Width; col++ {
+					cell := t.detailBuf.Get(col, line)
+					ctx.Buffer.Set(bounds.X+col, y+line, cell.Rune, cell.Style)
+				}
+			}
+			y += t.detailHeight
+		}
 	}
 }
 
-// HandleMessage handles row navigation.
+// renderDetail draws the expanded row detail into an offscreen buffer so its
+// height is known before rows are positioned.
+func (t *Table) renderDetail(width, height int) {
+	t.detailHeight = 0
+	if t.detailRenderer == nil || t.expanded < 0 || width <= 0 || height <= 0 {
+		return
+	}
+	if t.detailBuf == nil {
+		t.detailBuf = runtime.NewBuffer(width, height)
+	} else {
+		t.detailBuf.Resize(width, height)
+	}
+	t.detailBuf.Fill(runtime.Rect{Width: width, Height: height}, ' ', t.style)
+	ctx := runtime.RenderContext{
+		Buffer: t.detailBuf,
+		Bounds: runtime.Rect{Width: width, Height: height},
+	}
+	used := t.detailRenderer(t.row(t.expanded), width, ctx)
+	t.detailHeight = max(0, min(used, height))
+}
+
+// ensureSelectedVisible adjusts the row offset so the selected row is on
+// screen, counting the detail rows of an expanded row above it.
+func (t *Table) ensureSelectedVisible(rowArea int) {
+	if t.selected < t.offset {
+		t.offset = t.selected
+	}
+	if t.offset < 0 {
+		t.offset = 0
+	}
+	for t.offset < t.selected && t.linesBetween(t.offset, t.selected) > rowArea {
+		t.offset++
+	}
+}
+
+// linesBetween returns the screen lines used by rows from..to inclusive,
+// excluding the detail of the last row.
+func (t *Table) linesBetween(from, to int) int {
+	lines := to - from + 1
+	if t.expanded >= from && t.expanded < to {
+		lines += t.detailHeight
+	}
+	return lines
+}
+
+func (t *Table) expandedHeight() int {
+	if t == nil || t.detailRenderer == nil || t.expanded < 0 {
+		return 0
+	}
+	return t.detailHeight
+}
+
+// HandleMessage handles row navigation and selection.
 func (t *Table) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if t == nil || !t.focused {
+	if t == nil {
+		return runtime.Unhandled()
+	}
+	if mouse, ok := msg.(runtime.MouseMsg); ok {
+		if t.handleMouse(mouse) {
+			return runtime.Handled()
+		}
 		return runtime.Unhandled()
 	}
+	if !t.focused {
+		return runtime.Unhandled()
+	}
+	if _, ok := msg.(runtime.SelectAllMsg); ok {
+		t.SelectAll()
+		return runtime.Handled()
+	}
 	key, ok := msg.(runtime.KeyMsg)
 	if !ok {
 		return runtime.Unhandled()
 	}
 	switch key.Key {
-	case terminal.KeyUp:
-		t.setSelected(t.selected - 1)
-		return runtime.Handled()
-	case terminal.KeyDown:
-		t.setSelected(t.selected + 1)
+	case terminal.KeyUp, terminal.KeyDown:
+		delta := 1
+		if key.Key == terminal.KeyUp {
+			delta = -1
+		}
+		if key.Shift {
+			t.extendRange(delta)
+			return runtime.Handled()
+		}
+		t.clearMarked()
+		t.setSelected(t.selected + delta)
 		return runtime.Handled()
 	case terminal.KeyPageUp:
+		t.clearMarked()
 		t.setSelected(t.selected - t.bounds.Height)
 		return runtime.Handled()
 	case terminal.KeyPageDown:
+		t.clearMarked()
 		t.setSelected(t.selected + t.bounds.Height)
 		return runtime.Handled()
 	case terminal.KeyHome:
+		t.clearMarked()
 		t.setSelected(0)
 		return runtime.Handled()
 	case terminal.KeyEnd:
-		t.setSelected(len(t.Rows) - 1)
+		t.clearMarked()
+		t.setSelected(t.rowCount() - 1)
+		return runtime.Handled()
+	case terminal.KeyLeft, terminal.KeyRight:
+		direction := 1
+		if key.Key == terminal.KeyLeft {
+			direction = -1
+		}
+		if key.Alt {
+			if t.resizeColumn(t.activeCol, direction) {
+				return runtime.Handled()
+			}
+			break
+		}
+		if t.moveActiveColumn(direction) {
+			return runtime.Handled()
+		}
+	case terminal.KeyRune:
+		if key.Rune == t.sortKey && t.toggleSort() {
+			return runtime.Handled()
+		}
+	case terminal.KeyF2:
+		if cmd := t.startEdit(); cmd != nil {
+			return runtime.WithCommand(cmd)
+		}
+	case terminal.KeyEnter:
+		if cmd := t.startEdit(); cmd != nil {
+			return runtime.WithCommand(cmd)
+		}
+		if t.rowCount() == 0 || (t.detailRenderer == nil && t.onActivate == nil) {
+			return runtime.Unhandled()
+		}
+		if t.detailRenderer != nil {
+			if t.expanded == t.selected {
+				t.SetExpanded(-1)
+			} else {
+				t.SetExpanded(t.selected)
+			}
+		}
+		if t.onActivate != nil && t.selected >= 0 && t.selected < t.rowCount() {
+			t.onActivate(t.selected, t.row(t.selected))
+		}
 		return runtime.Handled()
 	}
 	return runtime.Unhandled()
@@ -167,17 +850,24 @@ func (t *Table) setSelected(index int) {
 	if t == nil {
 		return
 	}
-	if len(t.Rows) == 0 {
+	if t.rowCount() == 0 {
 		t.selected = 0
 		return
 	}
 	if index < 0 {
 		index = 0
 	}
-	if index >= len(t.Rows) {
-		index = len(t.Rows) - 1
+	if index >= t.rowCount() {
+		index = t.rowCount() - 1
+	}
+	if index == t.selected {
+		return
 	}
 	t.selected = index
+	if t.onSelChange != nil {
+		t.onSelChange(index)
+	}
+	t.announceRow()
 }
 
 func (t *Table) columnWidths(total int) []int {
@@ -193,10 +883,13 @@ func (t *Table) columnWidths(total int) []int {
 	}
 	fixed := 0
 	flexCount := 0
-	for _, col := range t.Columns {
-		if col.Width > 0 {
+	for i, col := range t.Columns {
+		switch {
+		case col.AutoSize:
+			fixed += t.autoWidth(i)
+		case col.Width > 0:
 			fixed += col.Width
-		} else {
+		default:
 			flexCount++
 		}
 	}
@@ -213,12 +906,21 @@ func (t *Table) columnWidths(total int) []int {
 		}
 	}
 	for i, col := range t.Columns {
-		if col.Width > 0 {
+		switch {
+		case col.AutoSize:
+			widths[i] = t.autoWidth(i)
+		case col.Width > 0:
 			widths[i] = col.Width
-		} else {
+		default:
 			widths[i] = flexWidth
 		}
 	}
+	if len(t.userWidths) == len(t.Columns) {
+		copy(widths, t.userWidths)
+		if over := sumInts(widths) - available; over > 0 {
+			t.shrinkColumns(widths, over, t.activeCol)
+		}
+	}
 	t.cachedTotal = total
 	t.cachedSig = t.columnsSignature()
 	t.cachedWidths = widths
@@ -232,13 +934,41 @@ func (t *Table) columnsSignature() uint32 {
 	var sig uint32 = uint32(len(t.Columns))
 	for _, col := range t.Columns {
 		sig = sig*31 + uint32(col.Width+1)
+		sig = sig*31 + uint32(col.MaxWidth+1)
+		if col.AutoSize {
+			sig = sig*31 + 1
+		}
 	}
 	return sig
 }
 
+// autoWidth returns the cached content width for an auto-sized column.
+func (t *Table) autoWidth(col int) int {
+	if len(t.autoWidths) != len(t.Columns) {
+		t.autoWidths = make([]int, len(t.Columns))
+		for i := range t.autoWidths {
+			t.autoWidths[i] = -1
+		}
+	}
+	width := t.autoWidths[col]
+	if width < 0 {
+		width = runewidth.StringWidth(t.Columns[col].Title)
+		for i := 0; i < min(t.rowCount(), tableAutoSizeSample); i++ {
+			if row := t.row(i); col < len(row) {
+				width = max(width, runewidth.StringWidth(row[col]))
+			}
+		}
+		t.autoWidths[col] = width
+	}
+	if maxWidth := t.Columns[col].MaxWidth; maxWidth > 0 {
+		width = min(width, maxWidth)
+	}
+	return max(width, 1)
+}
+
 // ScrollBy scrolls selection by delta.
 func (t *Table) ScrollBy(dx, dy int) {
-	if t == nil || len(t.Rows) == 0 || dy == 0 {
+	if t == nil || t.rowCount() == 0 || dy == 0 {
 		return
 	}
 	t.setSelected(t.selected + dy)
@@ -247,7 +977,7 @@ func (t *Table) ScrollBy(dx, dy int) {
 
 // ScrollTo scrolls to an absolute row index.
 func (t *Table) ScrollTo(x, y int) {
-	if t == nil || len(t.Rows) == 0 {
+	if t == nil || t.rowCount() == 0 {
 		return
 	}
 	t.setSelected(y)
@@ -256,10 +986,10 @@ func (t *Table) ScrollTo(x, y int) {
 
 // PageBy scrolls by a number of pages.
 func (t *Table) PageBy(pages int) {
-	if t == nil || len(t.Rows) == 0 {
+	if t == nil || t.rowCount() == 0 {
 		return
 	}
-	pageSize := t.bounds.Height - 1
+	pageSize := t.bounds.Height - t.headerHeight()
 	if pageSize < 1 {
 		pageSize = 1
 	}
@@ -269,7 +999,7 @@ func (t *Table) PageBy(pages int) {
 
 // ScrollToStart scrolls to the first row.
 func (t *Table) ScrollToStart() {
-	if t == nil || len(t.Rows) == 0 {
+	if t == nil || t.rowCount() == 0 {
 		return
 	}
 	t.setSelected(0)
@@ -278,10 +1008,10 @@ func (t *Table) ScrollToStart() {
 
 // ScrollToEnd scrolls to the last row.
 func (t *Table) ScrollToEnd() {
-	if t == nil || len(t.Rows) == 0 {
+	if t == nil || t.rowCount() == 0 {
 		return
 	}
-	t.setSelected(len(t.Rows) - 1)
+	t.setSelected(t.rowCount() - 1)
 	t.Invalidate()
 }
 
@@ -0,0 +1,189 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// SetEditable enables in-place editing of cells in columns marked
+// TableColumn.Editable. Left and Right choose the column; Enter or F2 edits
+// the selected row's cell.
+func (t *Table) SetEditable(editable bool) {
+	if t == nil {
+		return
+	}
+	t.editable = editable
+	t.Invalidate()
+}
+
+// Editable reports whether cell editing is enabled.
+func (t *Table) Editable() bool {
+	return t != nil && t.editable
+}
+
+// OnCellEdit registers a handler called when a cell edit is committed.
+func (t *Table) OnCellEdit(fn func(row, col int, value string)) {
+	if t == nil {
+		return
+	}
+	t.onCellEdit = fn
+}
+
+// Editing reports whether a cell editor is open.
+func (t *Table) Editing() bool {
+	return t != nil && t.editor != nil
+}
+
+// startEdit opens an editor on the selected row's active cell and returns
+// the command that pushes it, or nil when the cell is not editable.
+func (t *Table) startEdit() runtime.Command {
+	if t.editor != nil || t.selected < 0 || t.selected >= t.rowCount() {
+		return nil
+	}
+	col := t.activeCol
+	if !t.canEdit(col) {
+		if col = t.nextColumn(-1, 1, t.canEdit); col < 0 {
+			return nil
+		}
+		t.activeCol = col
+	}
+	text := ""
+	if row := t.row(t.selected); col < len(row) {
+		text = row[col]
+	}
+	editor := &tableCellEditor{table: t, row: t.selected, col: col, input: NewInput()}
+	editor.input.SetStyle(t.selectedStyle)
+	editor.input.SetFocusStyle(t.selectedStyle)
+	editor.input.SetText(text)
+	editor.input.Focus()
+	t.editor = editor
+	return runtime.PushOverlay{Widget: editor, InitialFocus: editor.input}
+}
+
+// commitEdit stores value in the edited cell, re-applies the active sort,
+// and notifies OnCellEdit. Virtual tables only notify, then reload.
+func (t *Table) commitEdit(row, col int, value string) {
+	if row < 0 || row >= t.rowCount() {
+		return
+	}
+	if t.source != nil {
+		if t.onCellEdit != nil {
+			t.onCellEdit(row, col, value)
+		}
+		t.ReloadRows()
+		return
+	}
+	for len(t.Rows[row]) <= col {
+		t.Rows[row] = append(t.Rows[row], "")
+	}
+	t.Rows[row][col] = value
+	t.autoWidths = nil
+	t.cachedWidths = nil
+	if t.onCellEdit != nil {
+		t.onCellEdit(row, col, value)
+	}
+	if t.sortCol >= 0 {
+		t.SortByColumn(t.sortCol, t.sortAscending)
+	}
+	t.Invalidate()
+}
+
+// cellRect returns the screen area of a cell, or an empty rect when the
+// cell is scrolled out of view.
+func (t *Table) cellRect(row, col int) runtime.Rect {
+	widths := t.columnWidths(t.bounds.Width)
+	if col < 0 || col >= len(widths) {
+		return runtime.Rect{}
+	}
+	y := -1
+	line := t.bounds.Y + t.headerHeight()
+	end := t.bounds.Y + t.bounds.Height
+	for index := max(t.offset, 0); index < t.rowCount() && line < end; index++ {
+		if index == row {
+			y = line
+			break
+		}
+		line++
+		if index == t.expanded {
+			line += t.detailHeight
+		}
+	}
+	if y < 0 {
+		return runtime.Rect{}
+	}
+	x := t.bounds.X
+	for i := 0; i < col; i++ {
+		x += widths[i] + 1
+	}
+	rect := runtime.Rect{X: x, Y: y, Width: widths[col], Height: 1}
+	return rect.Intersection(t.bounds)
+}
+
+// tableCellEditor is the overlay layer root holding the Input that edits a
+// table cell. It sits exactly over the cell.
+type tableCellEditor struct {
+	Base
+	table *Table
+	row   int
+	col   int
+	input *Input
+}
+
+// Measure fills the screen; only the cell area is drawn.
+func (e *tableCellEditor) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Layout places the input over the cell.
+func (e *tableCellEditor) Layout(bounds runtime.Rect) {
+	rect := e.table.cellRect(e.row, e.col).Intersection(bounds)
+	e.Base.Layout(rect)
+	e.input.Layout(rect)
+}
+
+// Render draws the input.
+func (e *tableCellEditor) Render(ctx runtime.RenderContext) {
+	if e.bounds.Width <= 0 || e.bounds.Height <= 0 {
+		return
+	}
+	e.input.Render(ctx)
+}
+
+// ChildWidgets returns the input so it can take focus.
+func (e *tableCellEditor) ChildWidgets() []runtime.Widget {
+	return []runtime.Widget{e.input}
+}
+
+// HandleMessage commits on Enter, cancels on Escape, and commits before Tab
+// moves focus on. Other keys edit the text and never reach the table.
+func (e *tableCellEditor) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok {
+		return e.input.HandleMessage(msg)
+	}
+	switch key.Key {
+	case terminal.KeyEnter:
+		e.close(true)
+		return runtime.WithCommand(runtime.PopOverlay{})
+	case terminal.KeyEscape:
+		e.close(false)
+		return runtime.WithCommand(runtime.PopOverlay{})
+	case terminal.KeyTab:
+		e.close(true)
+		var next runtime.Command = runtime.FocusNext{}
+		if key.Shift {
+			next = runtime.FocusPrev{}
+		}
+		return runtime.WithCommands(runtime.PopOverlay{}, next)
+	}
+	e.input.HandleMessage(msg)
+	return runtime.Handled()
+}
+
+func (e *tableCellEditor) close(commit bool) {
+	e.input.Blur()
+	e.table.editor = nil
+	if commit {
+		e.table.commitEdit(e.row, e.col, e.input.Text())
+	}
+}
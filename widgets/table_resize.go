@@ -0,0 +1,112 @@
+package widgets
+
+// defaultColumnMinWidth is the narrowest a column can be resized to when
+// TableColumn.MinWidth is not set.
+const defaultColumnMinWidth = 3
+
+// ColumnWidths returns the column widths for the table's current bounds.
+func (t *Table) ColumnWidths() []int {
+	if t == nil {
+		return nil
+	}
+	return append([]int(nil), t.columnWidths(t.bounds.Width)...)
+}
+
+// OnColumnResize registers a handler called with a column's new width when
+// the user resizes it, including neighbours shrunk to make room.
+func (t *Table) OnColumnResize(fn func(col, width int)) {
+	if t == nil {
+		return
+	}
+	t.onResize = fn
+}
+
+func (t *Table) minColumnWidth(col int) int {
+	if min := t.Columns[col].MinWidth; min > 0 {
+		return min
+	}
+	return defaultColumnMinWidth
+}
+
+// resizeColumn grows or shrinks a column by delta cells. Growing past the
+// available width shrinks the other columns, nearest first. Returns false
+// when the column cannot change.
+func (t *Table) resizeColumn(col, delta int) bool {
+	if col < 0 || col >= len(t.Columns) || delta == 0 {
+		return false
+	}
+	old := t.columnWidths(t.bounds.Width)
+	widths := append([]int(nil), old...)
+	available := max(t.bounds.Width-(len(t.Columns)-1), 0)
+	target := widths[col] + delta
+	if target < t.minColumnWidth(col) {
+		return false
+	}
+	widths[col] = target
+	if over := sumInts(widths) - available; over > 0 && delta > 0 {
+		if t.shrinkColumns(widths, over, col) < over {
+			return false
+		}
+	}
+	t.userWidths = widths
+	t.cachedWidths = nil
+	t.Invalidate()
+	if t.onResize != nil {
+		t.onResize(col, widths[col])
+		for i := range widths {
+			if i != col && widths[i] != old[i] {
+				t.onResize(i, widths[i])
+			}
+		}
+	}
+	return true
+}
+
+// shrinkColumns takes need cells from the columns other than keep, in
+// proportion to how far each is above its minimum width. Cells that do not
+// divide evenly come from the columns nearest keep. Returns the cells taken.
+func (t *Table) shrinkColumns(widths []int, need, keep int) int {
+	taken := 0
+	for taken < need {
+		slack := 0
+		for i, w := range widths {
+			if i != keep {
+				slack += max(w-t.minColumnWidth(i), 0)
+			}
+		}
+		if slack == 0 {
+			break
+		}
+		remaining := need - taken
+		step := 0
+		for i, w := range widths {
+			if i == keep {
+				continue
+			}
+			share := remaining * max(w-t.minColumnWidth(i), 0) / slack
+			widths[i] -= share
+			step += share
+		}
+		if step == 0 {
+			for dist := 1; dist < len(widths) && step == 0; dist++ {
+				for _, i := range []int{keep + dist, keep - dist} {
+					if i >= 0 && i < len(widths) && widths[i] > t.minColumnWidth(i) {
+						widths[i]--
+						step = 1
+						break
+					}
+				}
+			}
+		}
+		taken += step
+	}
+	return taken
+}
+
+func sumInts(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
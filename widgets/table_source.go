@@ -0,0 +1,110 @@
+package widgets
+
+// TableDataSource supplies rows to a virtual table on demand. Both methods
+// are called from the render loop, so they must return quickly; slow
+// backends should serve rows from their own cache and reload the table when
+// more data arrives.
+type TableDataSource interface {
+	RowCount() int
+	Row(index int) []string
+}
+
+// tableRowCache holds a window of rows fetched from a data source.
+type tableRowCache struct {
+	start int
+	rows  [][]string
+}
+
+func (c tableRowCache) covers(from, to int) bool {
+	return from >= c.start && to <= c.start+len(c.rows)
+}
+
+// NewVirtualTable creates a table whose rows come from source. Only the
+// rows on screen are fetched each frame. Sorting is left to the source, and
+// edited cells are reported through OnCellEdit without being stored.
+func NewVirtualTable(columns []TableColumn, source TableDataSource) *Table {
+	t := NewTable(columns...)
+	t.source = source
+	return t
+}
+
+// CacheRows keeps n rows above and below the visible range fetched, so short
+// scrolls are served without calling the data source.
+func (t *Table) CacheRows(n int) {
+	if t == nil {
+		return
+	}
+	t.cacheRows = max(n, 0)
+}
+
+// ReloadRows drops cached rows after the data source has changed.
+func (t *Table) ReloadRows() {
+	if t == nil {
+		return
+	}
+	t.rowCache = tableRowCache{}
+	t.autoWidths = nil
+	t.cachedWidths = nil
+	t.Invalidate()
+}
+
+// RowCount returns the number of rows, including rows from a data source.
+func (t *Table) RowCount() int {
+	if t == nil {
+		return 0
+	}
+	return t.rowCount()
+}
+
+// Row returns the cells of the row at index, or nil when out of range.
+func (t *Table) Row(index int) []string {
+	if t == nil || index < 0 || index >= t.rowCount() {
+		return nil
+	}
+	return t.row(index)
+}
+
+// rowCount returns the number of rows in the table.
+func (t *Table) rowCount() int {
+	if t.source != nil {
+		return t.source.RowCount()
+	}
+	return len(t.Rows)
+}
+
+// row returns the cells of a row, serving source rows from the cache when
+// they are in the fetched window.
+func (t *Table) row(index int) []string {
+	if t.source == nil {
+		return t.Rows[index]
+	}
+	if c := t.rowCache; index >= c.start && index < c.start+len(c.rows) {
+		return c.rows[index-c.start]
+	}
+	return t.source.Row(index)
+}
+
+// fetchRows makes sure rows from..to (exclusive) are cached, fetching the
+// visible range plus the CacheRows margin. Rows already cached are reused.
+func (t *Table) fetchRows(from, to int) {
+	if t.source == nil || t.rowCache.covers(from, to) {
+		return
+	}
+	start := max(from-t.cacheRows, 0)
+	end := min(to+t.cacheRows, t.source.RowCount())
+	if end <= start {
+		t.rowCache = tableRowCache{}
+		return
+	}
+	old := t.rowCache
+	rows := make([][]string, end-start)
+	for i := range rows {
+		index := start + i
+		if index >= old.start && index < old.start+len(old.rows) {
+			rows[i] = old.rows[index-old.start]
+		} else {
+			rows[i] = t.source.Row(index)
+		}
+	}
+	t.rowCache = tableRowCache{start: start, rows: rows}
+}
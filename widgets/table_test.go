@@ -0,0 +1,502 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/accessibility"
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/state"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+func newDetailTable() *Table {
+	table := NewTable(TableColumn{Title: "Name"}, TableColumn{Title: "Role"})
+	table.SetRows([][]string{
+		{"alice", "admin"},
+		{"bob", "dev"},
+		{"carol", "ops"},
+		{"dave", "qa"},
+	})
+	table.SetDetailRenderer(func(row []string, width int, ctx runtime.RenderContext) int {
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, "detail:"+row[0], backend.DefaultStyle())
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y+1, "more", backend.DefaultStyle())
+		return 2
+	})
+	table.Focus()
+	return table
+}
+
+func TestTable_DetailExpansionInsertsRows(t *testing.T) {
+	table := newDetailTable()
+	table.SetExpanded(2)
+
+	out := renderToString(table, 20, 8)
+	lines := strings.Split(out, "\n")
+	if !strings.HasPrefix(lines[3], "carol") {
+		t.Fatalf("line 3 = %q, want carol row", lines[3])
+	}
+	if !strings.HasPrefix(lines[4], "detail:carol") {
+		t.Fatalf("line 4 = %q, want detail row", lines[4])
+	}
+	if !strings.HasPrefix(lines[5], "more") {
+		t.Fatalf("line 5 = %q, want second detail row", lines[5])
+	}
+	if !strings.HasPrefix(lines[6], "dave") {
+		t.Fatalf("line 6 = %q, want dave row after detail", lines[6])
+	}
+}
+
+func TestTable_DetailScrollAccountsForHeight(t *testing.T) {
+	table := newDetailTable()
+	table.SetExpanded(0)
+	table.setSelected(2)
+
+	// Header + 3 row lines: alice + 2 detail lines leaves no room for carol.
+	out := renderToString(table, 20, 4)
+	if !strings.Contains(out, "carol") {
+		t.Fatalf("expected selected row to be visible:\n%s", out)
+	}
+	if table.offset == 0 {
+		t.Fatal("expected offset to advance past expanded detail")
+	}
+
+	size := table.Measure(runtime.Loose(20, 100))
+	if size.Height != len(table.Rows)+1+2 {
+		t.Fatalf("measured height = %d, want %d", size.Height, len(table.Rows)+3)
+	}
+}
+
+func TestTable_EnterTogglesDetail(t *testing.T) {
+	table := newDetailTable()
+	table.setSelected(1)
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if table.ExpandedRow() != 1 {
+		t.Fatalf("expanded = %d, want 1", table.ExpandedRow())
+	}
+
+	table.setSelected(2)
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if table.ExpandedRow() != 2 {
+		t.Fatalf("expanded = %d, want 2 after expanding another row", table.ExpandedRow())
+	}
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if table.ExpandedRow() != -1 {
+		t.Fatalf("expanded = %d, want collapsed", table.ExpandedRow())
+	}
+	if out := renderToString(table, 20, 8); strings.Contains(out, "detail:") {
+		t.Fatalf("expected detail to be hidden:\n%s", out)
+	}
+}
+
+func TestTable_AutoSizeColumn(t *testing.T) {
+	table := NewTable(TableColumn{Title: "ID", AutoSize: true}, TableColumn{Title: "Rest"})
+	table.SetRows([][]string{{"foo", "a"}, {"hello", "b"}, {"hi", "c"}})
+
+	if got := table.columnWidths(30)[0]; got != 5 {
+		t.Fatalf("auto width = %d, want 5", got)
+	}
+
+	table.Columns[0].MaxWidth = 4
+	if got := table.columnWidths(30)[0]; got != 4 {
+		t.Fatalf("capped width = %d, want 4", got)
+	}
+
+	table.Columns[0].MaxWidth = 0
+	table.SetRows([][]string{{"much longer", "a"}})
+	if got := table.columnWidths(30)[0]; got != 11 {
+		t.Fatalf("width after SetRows = %d, want 11", got)
+	}
+}
+
+func TestTable_HeaderPinnedWhileScrolled(t *testing.T) {
+	table := NewTable(TableColumn{Title: "Name"})
+	var rows [][]string
+	for i := 0; i < 10; i++ {
+		rows = append(rows, []string{string(rune('a' + i))})
+	}
+	table.SetRows(rows)
+	table.setSelected(8)
+
+	lines := strings.Split(renderToString(table, 10, 4), "\n")
+	if table.offset == 0 {
+		t.Fatal("expected table to scroll")
+	}
+	if !strings.HasPrefix(lines[0], "Name") {
+		t.Fatalf("line 0 = %q, want pinned header", lines[0])
+	}
+	if !strings.HasPrefix(lines[3], "i") {
+		t.Fatalf("line 3 = %q, want selected row i", lines[3])
+	}
+}
+
+func TestTable_SetHeaderVisible(t *testing.T) {
+	table := NewTable(TableColumn{Title: "Name"})
+	table.SetRows([][]string{{"a"}, {"b"}, {"c"}})
+	table.SetHeaderVisible(false)
+
+	if table.HeaderVisible() {
+		t.Fatal("HeaderVisible() = true after hiding")
+	}
+	if size := table.Measure(runtime.Loose(10, 10)); size.Height != 3 {
+		t.Fatalf("measured height = %d, want 3", size.Height)
+	}
+	lines := strings.Split(renderToString(table, 10, 3), "\n")
+	for i, want := range []string{"a", "b", "c"} {
+		if strings.TrimSpace(lines[i]) != want {
+			t.Fatalf("line %d = %q, want %q", i, lines[i], want)
+		}
+	}
+}
+
+func TestTable_ActivateAndSelectionCallbacks(t *testing.T) {
+	table := NewTable(TableColumn{Title: "Name"}, TableColumn{Title: "Role"})
+	table.SetRows([][]string{{"alice", "admin"}, {"bob", "dev"}, {"carol", "ops"}})
+
+	activated := -1
+	var cells []string
+	var changes []int
+	table.OnActivate(func(row int, rowCells []string) {
+		activated = row
+		cells = rowCells
+	})
+	table.OnSelectionChange(func(row int) {
+		changes = append(changes, row)
+	})
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if activated != -1 || len(changes) != 0 {
+		t.Fatalf("callbacks fired while unfocused: activated=%d changes=%v", activated, changes)
+	}
+
+	table.Focus()
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown}) // Already at the end.
+	if len(changes) != 2 || changes[0] != 1 || changes[1] != 2 {
+		t.Fatalf("selection changes = %v, want [1 2]", changes)
+	}
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if activated != 2 || len(cells) != 2 || cells[0] != "carol" || cells[1] != "ops" {
+		t.Fatalf("OnActivate = (%d, %v), want (2, [carol ops])", activated, cells)
+	}
+}
+
+func TestTable_SortByColumnKeepsSelection(t *testing.T) {
+	byText := func(a, b string) bool { return a < b }
+	table := NewTable(TableColumn{Title: "Name", Width: 8}, TableColumn{Title: "Role", Width: 8, SortFunc: byText})
+	table.SetRows([][]string{
+		{"alice", "ops"},
+		{"bob", "admin"},
+		{"carol", "dev"},
+	})
+	table.Focus()
+	table.setSelected(2)
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's'})
+	if col, asc := table.SortColumn(); col != 1 || !asc {
+		t.Fatalf("SortColumn() = %d, %v; want 1, ascending", col, asc)
+	}
+	if got := table.Rows[0][0] + "," + table.Rows[1][0] + "," + table.Rows[2][0]; got != "bob,carol,alice" {
+		t.Fatalf("rows sorted to %s, want bob,carol,alice", got)
+	}
+	if table.Rows[table.SelectedIndex()][0] != "carol" {
+		t.Fatalf("selected row = %v, want carol to stay selected", table.Rows[table.SelectedIndex()])
+	}
+	if out := renderToString(table, 17, 4); !strings.Contains(out, "Role ▲") {
+		t.Fatalf("header missing ascending indicator:\n%s", out)
+	}
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's'})
+	if table.Rows[0][0] != "alice" || table.Rows[table.SelectedIndex()][0] != "carol" {
+		t.Fatalf("descending sort = %v, selected %d", table.Rows, table.SelectedIndex())
+	}
+	if out := renderToString(table, 17, 4); !strings.Contains(out, "Role ▼") {
+		t.Fatalf("header missing descending indicator:\n%s", out)
+	}
+
+	table.SortByColumn(0, true)
+	if col, _ := table.SortColumn(); col != 1 {
+		t.Fatal("columns without SortFunc should not sort")
+	}
+}
+
+func TestTable_MultiRowSelection(t *testing.T) {
+	table := newDetailTable()
+	table.SetDetailRenderer(nil)
+	table.Layout(runtime.Rect{Width: 20, Height: 5})
+	var changes [][]int
+	table.OnMultiSelectionChange(func(indices []int) {
+		changes = append(changes, indices)
+	})
+	var primary []int
+	table.OnSelectionChange(func(row int) {
+		primary = append(primary, row)
+	})
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Shift: true})
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Shift: true})
+	if got := table.SelectedIndices(); len(got) != 3 || got[0] != 0 || got[2] != 2 {
+		t.Fatalf("SelectedIndices() = %v, want [0 1 2]", got)
+	}
+	if table.SelectedIndex() != 2 || len(changes) != 2 {
+		t.Fatalf("primary = %d, changes = %v", table.SelectedIndex(), changes)
+	}
+	if len(primary) != 2 || primary[0] != 1 || primary[1] != 2 {
+		t.Fatalf("OnSelectionChange rows = %v, want [1 2]", primary)
+	}
+
+	// Ctrl+Click on dave (screen line 4) adds him; on bob (line 2) removes him.
+	table.HandleMessage(runtime.MouseMsg{X: 1, Y: 4, Button: runtime.MouseLeft, Ctrl: true})
+	table.HandleMessage(runtime.MouseMsg{X: 1, Y: 2, Button: runtime.MouseLeft, Ctrl: true})
+	rows := table.SelectedRows()
+	var names []string
+	for _, row := range rows {
+		names = append(names, row[0])
+	}
+	if got := strings.Join(names, ","); got != "alice,carol,dave" {
+		t.Fatalf("SelectedRows() = %s, want alice,carol,dave", got)
+	}
+
+	buf := runtime.NewBuffer(20, 5)
+	table.Render(runtime.RenderContext{Buffer: buf})
+	if cell := buf.Get(0, 1); cell.Style.BG() != backend.ColorCyan {
+		t.Fatalf("marked row bg = %v, want cyan", cell.Style.BG())
+	}
+	if cell := buf.Get(0, 2); cell.Style.BG() == backend.ColorCyan {
+		t.Fatal("unmarked row should not use the multi-select style")
+	}
+
+	table.HandleMessage(runtime.SelectAllMsg{})
+	if got := table.SelectedIndices(); len(got) != 4 {
+		t.Fatalf("after select all SelectedIndices() = %v", got)
+	}
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if got := table.SelectedIndices(); len(got) != 1 || got[0] != 0 {
+		t.Fatalf("plain navigation should drop the range, got %v", got)
+	}
+}
+
+func TestTable_EditCell(t *testing.T) {
+	table := NewTable(
+		TableColumn{Title: "Name", Width: 6, SortFunc: func(a, b string) bool { return a < b }},
+		TableColumn{Title: "Role", Width: 5, Editable: true},
+	)
+	table.SetRows([][]string{{"alice", "admin"}, {"bob", "dev"}})
+	table.SetEditable(true)
+	table.Focus()
+	table.Layout(runtime.Rect{Width: 12, Height: 3})
+	var edited []string
+	table.OnCellEdit(func(row, col int, value string) {
+		edited = append(edited, fmt.Sprintf("%d,%d=%s", row, col, value))
+	})
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	result := table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyF2})
+	if !hasCommand[runtime.PushOverlay](result) || !table.Editing() {
+		t.Fatal("F2 should open the cell editor")
+	}
+	editor := table.editor
+	editor.Layout(runtime.Rect{Width: 40, Height: 10})
+	if got, want := editor.input.Bounds(), (runtime.Rect{X: 7, Y: 2, Width: 5, Height: 1}); got != want {
+		t.Fatalf("editor bounds = %+v, want the cell %+v", got, want)
+	}
+	if editor.input.Text() != "dev" {
+		t.Fatalf("editor text = %q, want the cell text", editor.input.Text())
+	}
+
+	editor.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's'})
+	result = editor.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if !hasCommand[runtime.PopOverlay](result) || table.Editing() {
+		t.Fatal("Enter should commit and close the editor")
+	}
+	if table.Rows[1][1] != "devs" || len(edited) != 1 || edited[0] != "1,1=devs" {
+		t.Fatalf("rows = %v, edits = %v", table.Rows, edited)
+	}
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	table.editor.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'x'})
+	table.editor.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if table.Editing() || table.Rows[1][1] != "devs" || len(edited) != 1 {
+		t.Fatalf("Escape should cancel, rows = %v", table.Rows)
+	}
+}
+
+func TestTable_EditReappliesSort(t *testing.T) {
+	table := NewTable(TableColumn{Title: "Name", Editable: true, SortFunc: func(a, b string) bool { return a < b }})
+	table.SetRows([][]string{{"b"}, {"c"}})
+	table.SetEditable(true)
+	table.SortByColumn(0, true)
+	table.Focus()
+	table.Layout(runtime.Rect{Width: 10, Height: 3})
+
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	table.editor.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	table.editor.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'z'})
+	table.editor.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if table.Rows[0][0] != "c" || table.Rows[1][0] != "z" {
+		t.Fatalf("rows = %v, want the edited row sorted last", table.Rows)
+	}
+	if table.Rows[table.SelectedIndex()][0] != "z" {
+		t.Fatal("the edited row should stay selected after the sort")
+	}
+}
+
+func TestTable_ResizeColumns(t *testing.T) {
+	table := NewTable(TableColumn{Title: "Name"}, TableColumn{Title: "Role"}, TableColumn{Title: "Team", MinWidth: 5})
+	table.SetRows([][]string{{"alice", "admin", "core"}})
+	table.Focus()
+	table.Layout(runtime.Rect{Width: 20, Height: 3})
+	resized := map[int]int{}
+	table.OnColumnResize(func(col, width int) {
+		resized[col] = width
+	})
+	grow := runtime.KeyMsg{Key: terminal.KeyRight, Alt: true}
+
+	if got := fmt.Sprint(table.ColumnWidths()); got != "[6 6 6]" {
+		t.Fatalf("initial widths = %s, want [6 6 6]", got)
+	}
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	table.HandleMessage(grow)
+	table.HandleMessage(grow)
+	table.HandleMessage(grow)
+	// The neighbours shrink to keep the total at 18 cells; Team stops at its
+	// MinWidth of 5.
+	if got := fmt.Sprint(table.ColumnWidths()); got != "[4 9 5]" {
+		t.Fatalf("widths = %s, want [4 9 5]", got)
+	}
+	if resized[0] != 4 || resized[1] != 9 || resized[2] != 5 {
+		t.Fatalf("OnColumnResize reported %v", resized)
+	}
+
+	shrink := runtime.KeyMsg{Key: terminal.KeyLeft, Alt: true}
+	for i := 0; i < 10; i++ {
+		table.HandleMessage(shrink)
+	}
+	if got := table.ColumnWidths()[1]; got != 3 {
+		t.Fatalf("column 1 width = %d, want the default minimum 3", got)
+	}
+}
+
+// countingSource is a large table data source that counts row fetches.
+type countingSource struct {
+	count int
+	calls int
+}
+
+func (s *countingSource) RowCount() int { return s.count }
+
+func (s *countingSource) Row(index int) []string {
+	s.calls++
+	return []string{fmt.Sprintf("row %d", index)}
+}
+
+func TestTable_VirtualFetchesVisibleRows(t *testing.T) {
+	source := &countingSource{count: 100000}
+	table := NewVirtualTable([]TableColumn{{Title: "Name"}}, source)
+	table.Focus()
+
+	lines := strings.Split(renderToString(table, 12, 6), "\n")
+	if source.calls != 5 {
+		t.Fatalf("first frame fetched %d rows, want the 5 visible", source.calls)
+	}
+	if !strings.HasPrefix(lines[1], "row 0") || !strings.HasPrefix(lines[5], "row 4") {
+		t.Fatalf("rows = %q", lines)
+	}
+
+	source.calls = 0
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	renderToString(table, 12, 6)
+	if source.calls != 0 {
+		t.Fatalf("redrawing the same rows fetched %d, want 0", source.calls)
+	}
+
+	source.calls = 0
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnd})
+	lines = strings.Split(renderToString(table, 12, 6), "\n")
+	if source.calls != 5 {
+		t.Fatalf("jumping to the end fetched %d rows, want 5", source.calls)
+	}
+	if !strings.HasPrefix(lines[5], "row 99999") {
+		t.Fatalf("last line = %q, want the last row", lines[5])
+	}
+}
+
+func TestTable_RowAccessors(t *testing.T) {
+	table := NewTable(TableColumn{Title: "Name"}, TableColumn{Title: "Size"})
+	table.SetRows([][]string{{"a", "1"}, {"b", "2"}})
+	if got := table.ColumnTitles(); len(got) != 2 || got[0] != "Name" || got[1] != "Size" {
+		t.Fatalf("ColumnTitles = %q", got)
+	}
+	if table.RowCount() != 2 || table.Row(1)[0] != "b" || table.Row(2) != nil {
+		t.Fatalf("RowCount = %d, Row(1) = %q, Row(2) = %q", table.RowCount(), table.Row(1), table.Row(2))
+	}
+
+	virtual := NewVirtualTable([]TableColumn{{Title: "Name"}}, &countingSource{count: 50})
+	if virtual.RowCount() != 50 || virtual.Row(49)[0] != "row 49" {
+		t.Fatalf("virtual RowCount = %d, Row(49) = %q", virtual.RowCount(), virtual.Row(49))
+	}
+}
+
+func TestTable_VirtualCacheRows(t *testing.T) {
+	source := &countingSource{count: 1000}
+	table := NewVirtualTable([]TableColumn{{Title: "Name"}}, source)
+	table.CacheRows(10)
+	table.Focus()
+
+	renderToString(table, 12, 6)
+	if source.calls != 15 {
+		t.Fatalf("first frame fetched %d rows, want 5 visible + 10 below", source.calls)
+	}
+
+	// Scrolling within the cached margin does not touch the source.
+	source.calls = 0
+	table.ScrollTo(0, 12)
+	renderToString(table, 12, 6)
+	if source.calls != 0 {
+		t.Fatalf("scrolling into the cache fetched %d rows, want 0", source.calls)
+	}
+
+	// Leaving it refetches at most one visible range plus both margins.
+	source.calls = 0
+	table.ScrollTo(0, 500)
+	renderToString(table, 12, 6)
+	if source.calls == 0 || source.calls > 25 {
+		t.Fatalf("far scroll fetched %d rows, want 1..25", source.calls)
+	}
+	if got := table.SelectedRows(); len(got) != 1 || got[0][0] != "row 500" {
+		t.Fatalf("SelectedRows = %v, want row 500", got)
+	}
+}
+
+func TestTable_AnnouncesSelectedRow(t *testing.T) {
+	announcer := &accessibility.SimpleAnnouncer{}
+	queue := state.NewQueue()
+	app := runtime.NewApp(runtime.AppConfig{Announcer: announcer, StateQueue: queue})
+	table := newDetailTable()
+	table.Bind(app.Services())
+
+	table.setSelected(1)
+	queue.Flush()
+	history := announcer.History()
+	if len(history) != 1 || history[0].Message != "bob\tdev" {
+		t.Fatalf("announcements = %+v, want the bob row", history)
+	}
+
+	// Rapid navigation before the next flush announces only the final row.
+	table.setSelected(2)
+	table.setSelected(0)
+	table.setSelected(2)
+	queue.Flush()
+	history = announcer.History()
+	if len(history) != 2 || history[1].Message != strings.Join(table.Rows[2], "\t") {
+		t.Fatalf("announcements = %+v, want one more for row 2", history)
+	}
+}
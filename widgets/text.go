@@ -5,14 +5,16 @@ import (
 
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/theme"
 )
 
 // Text is a simple text display widget.
 type Text struct {
 	Base
-	text  string
-	style backend.Style
-	lines []string // Cached line splits
+	text      string
+	style     backend.Style
+	styleRole themeRole
+	lines     []string // Cached line splits
 }
 
 // NewText creates a new text widget.
@@ -46,6 +48,23 @@ func (t *Text) WithStyle(style backend.Style) *Text {
 	return t
 }
 
+// WithRole sets the style to the active theme's token for role and returns
+// the text for chaining.
+func (t *Text) WithRole(role theme.Role) *Text {
+	return t.WithStyle(t.styleRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (t *Text) ApplyTheme() {
+	if t == nil {
+		return
+	}
+	if style, ok := t.styleRole.refresh(t.style); ok {
+		t.style = style
+	}
+}
+
 // Measure returns the size needed to display the text.
 func (t *Text) Measure(constraints runtime.Constraints) runtime.Size {
 	// Calculate width: longest line
@@ -95,7 +114,10 @@ type Label struct {
 	Base
 	text      string
 	style     backend.Style
+	styleRole themeRole
 	alignment Alignment
+	tooltip   string
+	revision  uint64
 }
 
 // Alignment specifies text alignment.
@@ -119,30 +141,76 @@ func NewLabel(text string) *Label {
 // SetText updates the label text.
 func (l *Label) SetText(text string) {
 	l.text = text
+	l.revision++
 }
 
 // SetStyle sets the label style.
 func (l *Label) SetStyle(style backend.Style) {
 	l.style = style
+	l.revision++
 }
 
 // SetAlignment sets text alignment.
 func (l *Label) SetAlignment(align Alignment) {
 	l.alignment = align
+	l.revision++
 }
 
 // WithStyle sets the style and returns for chaining.
 func (l *Label) WithStyle(style backend.Style) *Label {
-	l.style = style
+	l.SetStyle(style)
 	return l
 }
 
+// WithRole sets the style to the active theme's token for role and returns
+// the label for chaining.
+func (l *Label) WithRole(role theme.Role) *Label {
+	return l.WithStyle(l.styleRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (l *Label) ApplyTheme() {
+	if l == nil {
+		return
+	}
+	if style, ok := l.styleRole.refresh(l.style); ok {
+		l.SetStyle(style)
+	}
+}
+
 // WithAlignment sets alignment and returns for chaining.
 func (l *Label) WithAlignment(align Alignment) *Label {
-	l.alignment = align
+	l.SetAlignment(align)
+	return l
+}
+
+// SetTooltip sets the tooltip text and returns for chaining.
+func (l *Label) SetTooltip(text string) *Label {
+	if l == nil {
+		return nil
+	}
+	l.tooltip = text
 	return l
 }
 
+// Tooltip returns the tooltip text.
+func (l *Label) Tooltip() string {
+	if l == nil {
+		return ""
+	}
+	return l.tooltip
+}
+
+// CacheKey implements runtime.Cacheable; it changes whenever the text,
+// style, or alignment is set.
+func (l *Label) CacheKey() uint64 {
+	if l == nil {
+		return 0
+	}
+	return l.revision
+}
+
 // Measure returns the size needed for the label.
 func (l *Label) Measure(constraints runtime.Constraints) runtime.Size {
 	return constraints.Constrain(runtime.Size{
@@ -20,6 +20,12 @@ type TextArea struct {
 	focusStyle backend.Style
 	onChange   func(text string)
 	services   runtime.Services
+
+	// Selection runs from anchor to cursor while hasAnchor is set.
+	anchor    int
+	hasAnchor bool
+
+	history editHistory
 }
 
 // NewTextArea creates a new text area.
@@ -49,9 +55,109 @@ func (t *TextArea) SetText(text string) {
 	}
 	t.text = []rune(text)
 	t.cursor = len(t.text)
+	t.hasAnchor = false
+	t.history.reset()
 	t.syncValue()
 }
 
+// SetUndoLimit caps the number of undo steps kept; n <= 0 restores the
+// default of 100.
+func (t *TextArea) SetUndoLimit(n int) {
+	if t == nil {
+		return
+	}
+	t.history.setLimit(n)
+}
+
+// Undo reverts the last edit and reports whether there was one.
+func (t *TextArea) Undo() bool {
+	if t == nil {
+		return false
+	}
+	snap, ok := t.history.undoTo(t.snapshot())
+	if !ok {
+		return false
+	}
+	t.restore(snap)
+	return true
+}
+
+// Redo reapplies the last undone edit and reports whether there was one.
+func (t *TextArea) Redo() bool {
+	if t == nil {
+		return false
+	}
+	snap, ok := t.history.redoTo(t.snapshot())
+	if !ok {
+		return false
+	}
+	t.restore(snap)
+	return true
+}
+
+func (t *TextArea) snapshot() editSnapshot {
+	return editSnapshot{text: string(t.text), cursor: t.cursor}
+}
+
+func (t *TextArea) restore(snap editSnapshot) {
+	t.text = []rune(snap.text)
+	t.cursor = min(snap.cursor, len(t.text))
+	t.hasAnchor = false
+	t.syncValue()
+}
+
+// recordEdit adds an undo step if the text changed since before.
+func (t *TextArea) recordEdit(before editSnapshot, typing bool) {
+	if string(t.text) == before.text {
+		t.history.seal()
+		return
+	}
+	t.history.record(before, t.cursor, typing)
+}
+
+// SelectAll selects the whole text and moves the cursor to the end.
+func (t *TextArea) SelectAll() {
+	if t == nil {
+		return
+	}
+	t.anchor = 0
+	t.hasAnchor = len(t.text) > 0
+	t.cursor = len(t.text)
+}
+
+// SelectedText returns the selected text, or "" when nothing is selected.
+func (t *TextArea) SelectedText() string {
+	if t == nil {
+		return ""
+	}
+	start, end, ok := t.selection()
+	if !ok {
+		return ""
+	}
+	return string(t.text[start:end])
+}
+
+// selection returns the selected rune range, if any.
+func (t *TextArea) selection() (start, end int, ok bool) {
+	if !t.hasAnchor || t.anchor == t.cursor {
+		return 0, 0, false
+	}
+	return min(t.anchor, t.cursor), max(t.anchor, t.cursor), true
+}
+
+// deleteSelection removes the selected text without notifying and drops the
+// selection.
+func (t *TextArea) deleteSelection() bool {
+	start, end, ok := t.selection()
+	t.hasAnchor = false
+	if !ok {
+		return false
+	}
+	t.text = append(t.text[:start], t.text[end:]...)
+	t.cursor = start
+	return true
+}
+
 // Text returns the current text.
 func (t *TextArea) Text() string {
 	if t == nil {
@@ -100,6 +206,8 @@ func (t *TextArea) Render(ctx runtime.RenderContext) {
 	if col >= bounds.Width {
 		scrollX = col - bounds.Width + 1
 	}
+	selStart, selEnd, selected := t.selection()
+	selected = selected && t.focused
 
 	for row := 0; row < bounds.Height; row++ {
 		lineIndex := t.scrollY + row
@@ -116,6 +224,15 @@ func (t *TextArea) Render(ctx runtime.RenderContext) {
 			lineText = lineText[:bounds.Width]
 		}
 		writePadded(ctx.Buffer, bounds.X, bounds.Y+row, bounds.Width, lineText, style)
+		if selected {
+			first := lineStarts[lineIndex] + scrollX
+			runes := []rune(lineText)
+			from := min(max(selStart-first, 0), len(runes))
+			to := min(max(selEnd-first, 0), len(runes))
+			if from < to {
+				ctx.Buffer.SetString(bounds.X+from, bounds.Y+row, string(runes[from:to]), style.Reverse(true))
+			}
+		}
 	}
 
 	if t.focused {
@@ -139,10 +256,42 @@ func (t *TextArea) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if t == nil || !t.focused {
 		return runtime.Unhandled()
 	}
+	if _, ok := msg.(runtime.SelectAllMsg); ok {
+		t.SelectAll()
+		return runtime.Handled()
+	}
 	key, ok := msg.(runtime.KeyMsg)
 	if !ok {
 		return runtime.Unhandled()
 	}
+	switch {
+	case key.Key == terminal.KeyCtrlY, key.Key == terminal.KeyCtrlZ && key.Shift:
+		if t.Redo() {
+			return runtime.Handled()
+		}
+		return runtime.Unhandled()
+	case key.Key == terminal.KeyCtrlZ:
+		if t.Undo() {
+			return runtime.Handled()
+		}
+		return runtime.Unhandled()
+	}
+
+	before := t.snapshot()
+	_, _, selected := t.selection()
+	result := t.handleKey(key)
+	t.recordEdit(before, key.Key == terminal.KeyRune && !selected)
+	return result
+}
+
+func (t *TextArea) handleKey(key runtime.KeyMsg) runtime.HandleResult {
+	if t.hasAnchor && t.replaceSelection(key) {
+		return runtime.Handled()
+	}
+	if extendsSelection(key) && !t.hasAnchor {
+		t.anchor = t.cursor
+		t.hasAnchor = true
+	}
 
 	switch key.Key {
 	case terminal.KeyCtrlC:
@@ -201,6 +350,35 @@ func (t *TextArea) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	return runtime.Unhandled()
 }
 
+// replaceSelection applies key to an active selection; see Input.replaceSelection.
+func (t *TextArea) replaceSelection(key runtime.KeyMsg) bool {
+	switch key.Key {
+	case terminal.KeyCtrlC, terminal.KeyCtrlX, terminal.KeyCtrlV:
+		return false
+	case terminal.KeyBackspace, terminal.KeyDelete:
+		if t.deleteSelection() {
+			t.syncValue()
+			return true
+		}
+		return false
+	case terminal.KeyRune, terminal.KeyEnter:
+		t.deleteSelection()
+		return false
+	}
+	if !extendsSelection(key) {
+		t.hasAnchor = false
+	}
+	return false
+}
+
+func (t *TextArea) clearText() {
+	t.text = nil
+	t.cursor = 0
+	t.scrollY = 0
+	t.hasAnchor = false
+	t.syncValue()
+}
+
 func (t *TextArea) insertRune(r rune) {
 	t.text = append(t.text[:t.cursor], append([]rune{r}, t.text[t.cursor:]...)...)
 	t.cursor++
@@ -305,36 +483,57 @@ func (t *TextArea) syncValue() {
 	}
 }
 
-// ClipboardCopy returns the current text.
+// ClipboardCopy returns the selected text, or the whole text when nothing is
+// selected.
 func (t *TextArea) ClipboardCopy() (string, bool) {
 	if t == nil {
 		return "", false
 	}
+	if text := t.SelectedText(); text != "" {
+		return text, true
+	}
 	return t.Text(), true
 }
 
-// ClipboardCut returns the current text and clears it.
+// ClipboardCut returns the selected text and removes it, or cuts the whole
+// text when nothing is selected.
 func (t *TextArea) ClipboardCut() (string, bool) {
 	if t == nil {
 		return "", false
 	}
-	text := t.Text()
-	t.text = nil
-	t.cursor = 0
-	t.scrollY = 0
-	t.syncValue()
+	before := t.snapshot()
+	text := t.cut()
+	t.recordEdit(before, false)
 	return text, true
 }
 
-// ClipboardPaste inserts text at the cursor.
+// ClipboardPaste inserts text at the cursor, replacing the selection.
 func (t *TextArea) ClipboardPaste(text string) bool {
 	if t == nil || text == "" {
 		return false
 	}
-	t.insertText(text)
+	before := t.snapshot()
+	t.paste(text)
+	t.recordEdit(before, false)
 	return true
 }
 
+func (t *TextArea) cut() string {
+	if text := t.SelectedText(); text != "" {
+		t.deleteSelection()
+		t.syncValue()
+		return text
+	}
+	text := t.Text()
+	t.clearText()
+	return text
+}
+
+func (t *TextArea) paste(text string) {
+	t.deleteSelection()
+	t.insertText(text)
+}
+
 func (t *TextArea) copyToClipboard() bool {
 	cb := t.services.Clipboard()
 	if cb == nil || !cb.Available() {
@@ -353,11 +552,7 @@ func (t *TextArea) cutToClipboard() bool {
 	if cb == nil || !cb.Available() {
 		return false
 	}
-	text, ok := t.ClipboardCut()
-	if !ok {
-		return false
-	}
-	_ = cb.Write(text)
+	_ = cb.Write(t.cut())
 	return true
 }
 
@@ -370,7 +565,8 @@ func (t *TextArea) pasteFromClipboard() bool {
 	if err != nil || text == "" {
 		return false
 	}
-	return t.ClipboardPaste(text)
+	t.paste(text)
+	return true
 }
 
 var _ clipboard.Target = (*TextArea)(nil)
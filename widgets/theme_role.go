@@ -0,0 +1,32 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/theme"
+)
+
+// themeRole remembers the theme role a style was set from, so ApplyTheme
+// can look the role up again after the active theme changes.
+type themeRole struct {
+	role  theme.Role
+	style backend.Style // style the role gave when last resolved
+	bound bool
+}
+
+// set binds role and returns its style in the active theme.
+func (r *themeRole) set(role theme.Role) backend.Style {
+	*r = themeRole{role: role, style: theme.Style(role), bound: true}
+	return r.style
+}
+
+// refresh returns the bound role's style in the active theme. ok is false
+// when no role is bound or current no longer holds the role's style, which
+// means the style was set directly since and should be kept.
+func (r *themeRole) refresh(current backend.Style) (style backend.Style, ok bool) {
+	if !r.bound || current != r.style {
+		r.bound = false
+		return current, false
+	}
+	r.style = theme.Style(r.role)
+	return r.style, true
+}
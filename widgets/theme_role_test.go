@@ -0,0 +1,33 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/theme"
+)
+
+func TestApplyTheme_FollowsActiveTheme(t *testing.T) {
+	t.Cleanup(func() { theme.Set(nil) })
+
+	button := NewButton("OK")
+	button.SetRole(theme.RolePrimary)
+	label := NewLabel("status").WithRole(theme.RolePrimary)
+	override := backend.DefaultStyle().Bold(true)
+	label.SetStyle(override)
+
+	theme.Set(theme.HighContrast())
+	want := theme.Style(theme.RolePrimary)
+	if button.style == want {
+		t.Fatal("expected high-contrast accent to differ from the default theme")
+	}
+
+	button.ApplyTheme()
+	label.ApplyTheme()
+	if button.style != want {
+		t.Fatalf("button style = %v, want %v", button.style, want)
+	}
+	if label.style != override {
+		t.Fatalf("expected direct label style to be kept, got %v", label.style)
+	}
+}
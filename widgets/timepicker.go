@@ -0,0 +1,213 @@
+package widgets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// TimePicker edits the hour, minute, and second of a time in columns. Up/Down
+// change the active column and Tab or Left/Right move between columns.
+type TimePicker struct {
+	FocusableBase
+
+	value    time.Time
+	column   int
+	locale   DateLocale
+	onChange func(value time.Time)
+
+	style       backend.Style
+	activeStyle backend.Style
+}
+
+// NewTimePicker creates a time picker. A zero initial uses the current time.
+func NewTimePicker(initial time.Time) *TimePicker {
+	if initial.IsZero() {
+		initial = time.Now()
+	}
+	return &TimePicker{
+		value:       initial,
+		locale:      LocaleEnUS,
+		style:       backend.DefaultStyle(),
+		activeStyle: backend.DefaultStyle().Reverse(true),
+	}
+}
+
+// SetLocale sets the locale; Hour12 locales show 1-12 hours and an AM/PM
+// column.
+func (p *TimePicker) SetLocale(locale DateLocale) {
+	if p == nil {
+		return
+	}
+	p.locale = locale
+	p.column = min(p.column, p.columns()-1)
+	p.Invalidate()
+}
+
+// Value returns the current time.
+func (p *TimePicker) Value() time.Time {
+	if p == nil {
+		return time.Time{}
+	}
+	return p.value
+}
+
+// SetValue sets the current time.
+func (p *TimePicker) SetValue(value time.Time) {
+	if p == nil {
+		return
+	}
+	p.value = value
+	p.Invalidate()
+}
+
+// Text returns the time in RFC 3339 format, as submitted by Form.
+func (p *TimePicker) Text() string {
+	if p == nil {
+		return ""
+	}
+	return p.value.Format(time.RFC3339)
+}
+
+// OnChange registers a handler called when the user changes the time.
+func (p *TimePicker) OnChange(fn func(value time.Time)) {
+	if p == nil {
+		return
+	}
+	p.onChange = fn
+}
+
+// columns returns the number of editable columns.
+func (p *TimePicker) columns() int {
+	if p.locale.Hour12 {
+		return 4
+	}
+	return 3
+}
+
+// labels returns the text of each column.
+func (p *TimePicker) labels() []string {
+	hour := p.value.Hour()
+	if !p.locale.Hour12 {
+		return []string{fmt.Sprintf("%02d", hour), fmt.Sprintf("%02d", p.value.Minute()), fmt.Sprintf("%02d", p.value.Second())}
+	}
+	period := "AM"
+	if hour >= 12 {
+		period = "PM"
+	}
+	hour %= 12
+	if hour == 0 {
+		hour = 12
+	}
+	return []string{fmt.Sprintf("%2d", hour), fmt.Sprintf("%02d", p.value.Minute()), fmt.Sprintf("%02d", p.value.Second()), period}
+}
+
+// Measure returns the width of HH:MM:SS plus the AM/PM column.
+func (p *TimePicker) Measure(constraints runtime.Constraints) runtime.Size {
+	width := 8
+	if p.locale.Hour12 {
+		width = 11
+	}
+	return constraints.Constrain(runtime.Size{Width: width, Height: 1})
+}
+
+// Render draws the columns, highlighting the active one when focused.
+func (p *TimePicker) Render(ctx runtime.RenderContext) {
+	if p == nil {
+		return
+	}
+	bounds := p.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	ctx.Buffer.Fill(runtime.Rect{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: 1}, ' ', p.style)
+	x := bounds.X
+	for i, label := range p.labels() {
+		if i > 0 {
+			sep := ":"
+			if i == 3 {
+				sep = " "
+			}
+			ctx.Buffer.SetString(x, bounds.Y, sep, p.style)
+			x++
+		}
+		style := p.style
+		if i == p.column && p.focused {
+			style = p.activeStyle
+		}
+		if x >= bounds.X+bounds.Width {
+			break
+		}
+		ctx.Buffer.SetString(x, bounds.Y, truncateString(label, bounds.X+bounds.Width-x), style)
+		x += len(label)
+	}
+}
+
+// HandleMessage edits the active column.
+func (p *TimePicker) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if p == nil || !p.focused {
+		return runtime.Unhandled()
+	}
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok {
+		return runtime.Unhandled()
+	}
+	switch key.Key {
+	case terminal.KeyUp:
+		p.adjust(1)
+	case terminal.KeyDown:
+		p.adjust(-1)
+	case terminal.KeyLeft:
+		p.column = max(p.column-1, 0)
+	case terminal.KeyRight:
+		p.column = min(p.column+1, p.columns()-1)
+	case terminal.KeyTab:
+		// Tab leaves the picker from its last column, Shift+Tab from its first.
+		if key.Shift {
+			if p.column == 0 {
+				return runtime.WithCommand(runtime.FocusPrev{})
+			}
+			p.column--
+		} else {
+			if p.column == p.columns()-1 {
+				return runtime.WithCommand(runtime.FocusNext{})
+			}
+			p.column++
+		}
+	case terminal.KeyEnter:
+		return runtime.WithCommand(runtime.Submit{Text: p.Text()})
+	default:
+		return runtime.Unhandled()
+	}
+	p.Invalidate()
+	return runtime.Handled()
+}
+
+// adjust changes the active column by delta, wrapping within the column so
+// other columns are unchanged.
+func (p *TimePicker) adjust(delta int) {
+	v := p.value
+	hour, minute, second := v.Hour(), v.Minute(), v.Second()
+	switch p.column {
+	case 0:
+		if p.locale.Hour12 {
+			// Wrap within the same half of the day.
+			hour = hour/12*12 + (hour%12+delta+12)%12
+		} else {
+			hour = (hour + delta + 24) % 24
+		}
+	case 1:
+		minute = (minute + delta + 60) % 60
+	case 2:
+		second = (second + delta + 60) % 60
+	case 3:
+		hour = (hour + 12) % 24
+	}
+	p.value = time.Date(v.Year(), v.Month(), v.Day(), hour, minute, second, v.Nanosecond(), v.Location())
+	if p.onChange != nil {
+		p.onChange(p.value)
+	}
+}
@@ -0,0 +1,428 @@
+package widgets
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/toast"
+	"github.com/oklog/ulid/v2"
+)
+
+// DefaultMaxVisibleToasts is how many toasts a ToastQueue shows at once.
+const DefaultMaxVisibleToasts = 3
+
+// ToastPosition selects the screen corner a ToastQueue stacks toasts in.
+type ToastPosition int
+
+const (
+	ToastTopRight ToastPosition = iota
+	ToastTopLeft
+	ToastBottomRight
+	ToastBottomLeft
+)
+
+// ToastAction is an optional button on a queued toast, such as "Undo".
+// OnAction runs on the UI loop when the button is clicked.
+type ToastAction struct {
+	Label    string
+	OnAction func()
+}
+
+// ToastMsg describes a notification shown by ToastQueue.Show.
+type ToastMsg struct {
+	Level    toast.ToastLevel
+	Title    string
+	Message  string
+	Duration time.Duration // Defaults to toast.DefaultToastDuration
+	Action   *ToastAction
+}
+
+type queuedToast struct {
+	toast  *toast.Toast
+	action *ToastAction
+	timer  *time.Timer
+}
+
+// ToastQueue shows notifications in a floating passive layer that never
+// takes focus. Toasts beyond the visible limit wait in the queue, and each
+// auto-dismisses after its duration once shown. Show, Dismiss and
+// DismissAll are safe to call from any goroutine.
+type ToastQueue struct {
+	mu         sync.Mutex
+	position   ToastPosition
+	maxVisible int
+	active     []*queuedToast
+	pending    []*queuedToast
+	app        *runtime.App
+	scheduled  bool
+	styles     [4]backend.Style // info, success, warning, error
+	overlay    *toastOverlay
+}
+
+// NewToastQueue creates a toast queue stacking toasts at position.
+// Call Attach to show its toasts in an app.
+func NewToastQueue(position ToastPosition) *ToastQueue {
+	white := backend.ColorWhite
+	black := backend.ColorBlack
+	q := &ToastQueue{
+		position:   position,
+		maxVisible: DefaultMaxVisibleToasts,
+		styles: [4]backend.Style{
+			backend.DefaultStyle().Background(backend.ColorBlue).Foreground(white),
+			backend.DefaultStyle().Background(backend.ColorGreen).Foreground(black),
+			backend.DefaultStyle().Background(backend.ColorYellow).Foreground(black),
+			backend.DefaultStyle().Background(backend.ColorRed).Foreground(white),
+		},
+	}
+	q.overlay = &toastOverlay{queue: q}
+	return q
+}
+
+// Attach shows the queue's toasts on the app's screen.
+func (q *ToastQueue) Attach(app *runtime.App) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	q.app = app
+	q.mu.Unlock()
+	q.schedule()
+}
+
+// SetMaxVisible sets how many toasts are shown at once.
+func (q *ToastQueue) SetMaxVisible(n int) {
+	if q == nil {
+		return
+	}
+	if n <= 0 {
+		n = DefaultMaxVisibleToasts
+	}
+	q.mu.Lock()
+	q.maxVisible = n
+	q.promoteLocked()
+	q.mu.Unlock()
+	q.schedule()
+}
+
+// SetLevelStyles configures the toast styles by level. The background
+// color of each style fills the whole toast.
+func (q *ToastQueue) SetLevelStyles(info, success, warn, err backend.Style) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	q.styles = [4]backend.Style{info, success, warn, err}
+	q.mu.Unlock()
+	q.schedule()
+}
+
+// Show enqueues a toast and returns its ID.
+func (q *ToastQueue) Show(msg ToastMsg) string {
+	if q == nil {
+		return ""
+	}
+	if msg.Duration <= 0 {
+		msg.Duration = toast.DefaultToastDuration
+	}
+	if msg.Level == "" {
+		msg.Level = toast.ToastInfo
+	}
+	entry := &queuedToast{
+		toast: &toast.Toast{
+			ID:       ulid.Make().String(),
+			Level:    msg.Level,
+			Title:    strings.TrimSpace(msg.Title),
+			Message:  strings.TrimSpace(msg.Message),
+			Duration: msg.Duration,
+		},
+		action: msg.Action,
+	}
+	q.mu.Lock()
+	q.pending = append(q.pending, entry)
+	q.promoteLocked()
+	q.mu.Unlock()
+	q.schedule()
+	return entry.toast.ID
+}
+
+// Dismiss removes the toast with the given ID, shown or queued.
+func (q *ToastQueue) Dismiss(id string) bool {
+	if q == nil {
+		return false
+	}
+	q.mu.Lock()
+	found := false
+	for i, entry := range q.active {
+		if entry.toast.ID == id {
+			entry.timer.Stop()
+			q.active = append(q.active[:i], q.active[i+1:]...)
+			found = true
+			break
+		}
+	}
+	if !found {
+		for i, entry := range q.pending {
+			if entry.toast.ID == id {
+				q.pending = append(q.pending[:i], q.pending[i+1:]...)
+				found = true
+				break
+			}
+		}
+	}
+	if found {
+		q.promoteLocked()
+	}
+	q.mu.Unlock()
+	if found {
+		q.schedule()
+	}
+	return found
+}
+
+// DismissAll removes every shown and queued toast.
+func (q *ToastQueue) DismissAll() {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	for _, entry := range q.active {
+		entry.timer.Stop()
+	}
+	q.active = nil
+	q.pending = nil
+	q.mu.Unlock()
+	q.schedule()
+}
+
+// Toasts returns the toasts currently shown, oldest first.
+func (q *ToastQueue) Toasts() []*toast.Toast {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	toasts := make([]*toast.Toast, len(q.active))
+	for i, entry := range q.active {
+		toasts[i] = entry.toast
+	}
+	return toasts
+}
+
+// Pending returns the number of toasts waiting to be shown.
+func (q *ToastQueue) Pending() int {
+	if q == nil {
+		return 0
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// promoteLocked moves queued toasts into free visible slots and starts
+// their dismiss timers.
+func (q *ToastQueue) promoteLocked() {
+	for len(q.active) < q.maxVisible && len(q.pending) > 0 {
+		entry := q.pending[0]
+		q.pending = q.pending[1:]
+		entry.toast.CreatedAt = time.Now()
+		id := entry.toast.ID
+		entry.timer = time.AfterFunc(entry.toast.Duration, func() {
+			q.Dismiss(id)
+		})
+		q.active = append(q.active, entry)
+	}
+}
+
+// schedule syncs the overlay layer on the UI loop. Repeated calls before
+// the loop runs are coalesced.
+func (q *ToastQueue) schedule() {
+	q.mu.Lock()
+	app := q.app
+	if app == nil || q.scheduled {
+		q.mu.Unlock()
+		return
+	}
+	scheduler := app.StateScheduler()
+	if scheduler == nil {
+		q.mu.Unlock()
+		return
+	}
+	q.scheduled = true
+	q.mu.Unlock()
+	scheduler.Schedule(func() {
+		q.sync(app.Screen())
+	})
+}
+
+// sync re-pushes the overlay layer so the screen lays it out and rebuilds
+// hit testing for the current toasts.
+func (q *ToastQueue) sync(screen *runtime.Screen) {
+	q.mu.Lock()
+	q.scheduled = false
+	entries := append([]*queuedToast(nil), q.active...)
+	styles := q.styles
+	q.mu.Unlock()
+	if screen == nil {
+		return
+	}
+	q.overlay.entries = entries
+	q.overlay.styles = styles
+	screen.RemoveLayer(q.overlay)
+	if len(entries) > 0 {
+		screen.PushPassiveLayer(q.overlay)
+	}
+}
+
+// toastOverlay is the passive layer root of a ToastQueue. Its bounds cover
+// only the stacked toasts so clicks elsewhere reach the app.
+type toastOverlay struct {
+	Base
+	queue   *ToastQueue
+	entries []*queuedToast
+	styles  [4]backend.Style
+	cards   []toastCard
+}
+
+type toastCard struct {
+	entry  *queuedToast
+	bounds runtime.Rect
+	lines  []string
+	button runtime.Rect
+}
+
+// Measure fills the available space.
+func (o *toastOverlay) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Layout stacks the toasts in the queue's corner of bounds, newest nearest
+// the corner.
+func (o *toastOverlay) Layout(bounds runtime.Rect) {
+	o.cards = o.cards[:0]
+	maxWidth := min(toastMaxWidth, bounds.Width-2*toastMargin)
+	if maxWidth <= 0 {
+		o.Base.Layout(runtime.Rect{})
+		return
+	}
+	top := o.queue.position == ToastTopRight || o.queue.position == ToastTopLeft
+	left := o.queue.position == ToastTopLeft || o.queue.position == ToastBottomLeft
+	y := bounds.Y + toastMargin
+	if !top {
+		y = bounds.Y + bounds.Height - toastMargin
+	}
+	var union runtime.Rect
+	for i := len(o.entries) - 1; i >= 0; i-- {
+		entry := o.entries[i]
+		lines, buttonWidth := queuedToastLines(entry, maxWidth-2*toastPaddingX)
+		width := 0
+		for _, line := range lines {
+			width = max(width, runewidth.StringWidth(line))
+		}
+		width = min(max(width+2*toastPaddingX, toastMinWidth), maxWidth)
+		card := toastCard{entry: entry, lines: lines}
+		card.bounds = runtime.Rect{X: bounds.X + bounds.Width - width - toastMargin, Y: y, Width: width, Height: len(lines)}
+		if left {
+			card.bounds.X = bounds.X + toastMargin
+		}
+		if top {
+			y += card.bounds.Height + toastSpacing
+		} else {
+			card.bounds.Y = y - card.bounds.Height
+			y = card.bounds.Y - toastSpacing
+		}
+		if card.bounds.Y < bounds.Y || card.bounds.Y+card.bounds.Height > bounds.Y+bounds.Height {
+			break
+		}
+		if buttonWidth > 0 {
+			end := card.bounds.X + toastPaddingX + runewidth.StringWidth(lines[1])
+			card.button = runtime.Rect{X: end - buttonWidth, Y: card.bounds.Y + 1, Width: buttonWidth, Height: 1}
+		}
+		o.cards = append(o.cards, card)
+		union = unionRect(union, card.bounds)
+	}
+	o.Base.Layout(union)
+}
+
+// Render draws each toast filled with its level style.
+func (o *toastOverlay) Render(ctx runtime.RenderContext) {
+	for _, card := range o.cards {
+		style := levelStyle(card.entry.toast.Level, o.styles[0], o.styles[1], o.styles[2], o.styles[3])
+		ctx.Buffer.Fill(card.bounds, ' ', style)
+		for row, line := range card.lines {
+			ctx.Buffer.SetString(card.bounds.X+toastPaddingX, card.bounds.Y+row, line, style)
+		}
+		if card.button.Width > 0 {
+			label := "[" + strings.TrimSpace(card.entry.action.Label) + "]"
+			ctx.Buffer.SetString(card.button.X, card.button.Y, label, style.Reverse(true))
+		}
+	}
+}
+
+// HandleMessage runs the action of a clicked button and dismisses the
+// clicked toast.
+func (o *toastOverlay) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	mouse, ok := msg.(runtime.MouseMsg)
+	if !ok || mouse.Action != runtime.MouseRelease || mouse.Button != runtime.MouseLeft {
+		return runtime.Unhandled()
+	}
+	for _, card := range o.cards {
+		if !card.bounds.Contains(mouse.X, mouse.Y) {
+			continue
+		}
+		if card.button.Contains(mouse.X, mouse.Y) && card.entry.action.OnAction != nil {
+			card.entry.action.OnAction()
+		}
+		o.queue.Dismiss(card.entry.toast.ID)
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// queuedToastLines returns the title line and optional message line of a
+// toast, plus the width of its action button at the end of the message
+// line. The message is truncated so the button always fits.
+func queuedToastLines(entry *queuedToast, maxWidth int) ([]string, int) {
+	if entry == nil {
+		return nil, 0
+	}
+	t := entry.toast
+	title := t.Title
+	if title == "" {
+		title = levelLabel(t.Level)
+	}
+	prefix := levelIcon(t.Level) + " "
+	lines := []string{prefix + truncateString(title, maxWidth-len(prefix))}
+
+	button := ""
+	if entry.action != nil && strings.TrimSpace(entry.action.Label) != "" {
+		button = "[" + strings.TrimSpace(entry.action.Label) + "]"
+	}
+	buttonWidth := runewidth.StringWidth(button)
+	if buttonWidth > maxWidth {
+		button, buttonWidth = "", 0
+	}
+	switch {
+	case button != "" && t.Message != "":
+		message := truncateString(t.Message, maxWidth-buttonWidth-1)
+		lines = append(lines, message+" "+button)
+	case button != "":
+		lines = append(lines, button)
+	case t.Message != "":
+		lines = append(lines, truncateString(t.Message, maxWidth))
+	}
+	return lines, buttonWidth
+}
+
+func unionRect(a, b runtime.Rect) runtime.Rect {
+	if a.Width <= 0 || a.Height <= 0 {
+		return b
+	}
+	x0, y0 := min(a.X, b.X), min(a.Y, b.Y)
+	x1 := max(a.X+a.Width, b.X+b.Width)
+	y1 := max(a.Y+a.Height, b.Y+b.Height)
+	return runtime.Rect{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
@@ -0,0 +1,131 @@
+package widgets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/toast"
+)
+
+func TestToastQueue_QueuesBeyondVisibleLimit(t *testing.T) {
+	q := NewToastQueue(ToastBottomRight)
+	q.SetMaxVisible(2)
+	first := q.Show(ToastMsg{Title: "One", Duration: time.Hour})
+	q.Show(ToastMsg{Title: "Two", Duration: time.Hour})
+	q.Show(ToastMsg{Title: "Three", Duration: time.Hour})
+
+	if got := len(q.Toasts()); got != 2 || q.Pending() != 1 {
+		t.Fatalf("shown = %d, pending = %d, want 2 and 1", got, q.Pending())
+	}
+	if !q.Dismiss(first) {
+		t.Fatal("expected Dismiss to find the first toast")
+	}
+	if toasts := q.Toasts(); len(toasts) != 2 || toasts[1].Title != "Three" || q.Pending() != 0 {
+		t.Fatalf("toasts = %v, want the queued toast promoted", toasts)
+	}
+	q.DismissAll()
+	if len(q.Toasts()) != 0 || q.Pending() != 0 {
+		t.Fatal("expected DismissAll to clear shown and queued toasts")
+	}
+}
+
+func TestToastQueue_OverlayStacksAndKeepsFocus(t *testing.T) {
+	input := NewInput()
+	screen := runtime.NewScreen(40, 12)
+	screen.SetAutoRegisterFocus(true)
+	screen.SetRoot(input)
+
+	undone := false
+	q := NewToastQueue(ToastTopRight)
+	q.Show(ToastMsg{Level: toast.ToastError, Title: "Failed", Duration: time.Hour})
+	q.Show(ToastMsg{Level: toast.ToastSuccess, Title: "Deleted", Message: "3 files", Duration: time.Hour,
+		Action: &ToastAction{Label: "Undo", OnAction: func() { undone = true }}})
+	q.sync(screen)
+
+	if screen.LayerCount() != 2 || screen.FocusScope().Current() != input {
+		t.Fatalf("layers = %d, want the toasts pushed without taking focus", screen.LayerCount())
+	}
+	cards := q.overlay.cards
+	if len(cards) != 2 || cards[0].bounds.Y != 1 || cards[1].bounds.Y != 4 {
+		t.Fatalf("cards = %+v, want the newest toast at the top", cards)
+	}
+	if cards[0].bounds.X+cards[0].bounds.Width != 39 {
+		t.Fatalf("card bounds = %+v, want it right-aligned", cards[0].bounds)
+	}
+	screen.Render()
+	if _, bg, _ := screen.Buffer().Get(cards[1].bounds.X, cards[1].bounds.Y).Style.Decompose(); bg != backend.ColorRed {
+		t.Fatalf("background = %v, want the error level color", bg)
+	}
+
+	button := cards[0].button
+	if screen.WidgetAt(button.X, button.Y) != q.overlay || screen.WidgetAt(0, 11) == q.overlay {
+		t.Fatal("expected hit testing to cover only the toasts")
+	}
+	screen.HandleMessage(runtime.MouseMsg{X: button.X, Y: button.Y, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if !undone || len(q.Toasts()) != 1 {
+		t.Fatal("expected the action button to run and dismiss its toast")
+	}
+
+	q.DismissAll()
+	q.sync(screen)
+	if screen.LayerCount() != 1 {
+		t.Fatalf("layers = %d, want the overlay removed", screen.LayerCount())
+	}
+}
+
+func TestToastQueue_AutoDismissFromBackground(t *testing.T) {
+	q := NewToastQueue(ToastBottomLeft)
+	probeTime := time.Unix(1, 0)
+	reply := make(chan int, 1)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend: sim.New(40, 10),
+		Root:    NewLabel("root"),
+		Update: func(app *runtime.App, msg runtime.Message) bool {
+			if tick, ok := msg.(runtime.TickMsg); ok && tick.Time.Equal(probeTime) {
+				reply <- app.Screen().LayerCount()
+				return false
+			}
+			return runtime.DefaultUpdate(app, msg)
+		},
+	})
+	q.Attach(app)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+
+	layers := func() int {
+		app.Post(runtime.TickMsg{Time: probeTime})
+		select {
+		case n := <-reply:
+			return n
+		case <-time.After(time.Second):
+			t.Fatal("probe timed out")
+			return 0
+		}
+	}
+
+	go q.Show(ToastMsg{Title: "Saved", Duration: 50 * time.Millisecond})
+	deadline := time.Now().Add(time.Second)
+	for layers() != 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("toast layer never appeared")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	for layers() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("toast layer was not auto-dismissed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,309 @@
+package widgets
+
+import (
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// DefaultTooltipDelay is how long WithTooltip waits before showing.
+const DefaultTooltipDelay = 500 * time.Millisecond
+
+// TooltipPlacement selects which side of its trigger a tooltip appears on.
+type TooltipPlacement int
+
+const (
+	TooltipBelow TooltipPlacement = iota
+	TooltipAbove
+	TooltipLeft
+	TooltipRight
+)
+
+// TooltipHost wraps a trigger widget and shows a floating tooltip next to
+// it after the trigger has been focused or hovered for a delay. The tooltip
+// is a bordered panel in a passive layer, so it never takes focus.
+type TooltipHost struct {
+	Base
+	trigger   runtime.Widget
+	delay     time.Duration
+	placement TooltipPlacement
+	panel     *Panel
+	layer     *tooltipLayer
+	services  runtime.Services
+	removers  []func()
+	focused   bool
+	hovered   bool
+	shown     bool
+	gen       int
+}
+
+// Tooltip wraps trigger so content is shown after delay while trigger is
+// focused or hovered.
+func Tooltip(content runtime.Widget, trigger runtime.Widget, delay time.Duration) *TooltipHost {
+	h := &TooltipHost{
+		trigger: trigger,
+		delay:   delay,
+		panel:   NewPanel(&tooltipPadding{child: content}).WithBorder(backend.DefaultStyle()),
+	}
+	h.layer = &tooltipLayer{host: h}
+	return h
+}
+
+// WithTooltip wraps w with a text tooltip shown after DefaultTooltipDelay.
+func WithTooltip(w runtime.Widget, text string) *TooltipHost {
+	return Tooltip(NewLabel(text), w, DefaultTooltipDelay)
+}
+
+// SetPlacement chooses the side the tooltip prefers. It flips to the
+// opposite side when there is not enough room on screen.
+func (h *TooltipHost) SetPlacement(p TooltipPlacement) {
+	if h == nil {
+		return
+	}
+	h.placement = p
+}
+
+// Trigger returns the wrapped widget.
+func (h *TooltipHost) Trigger() runtime.Widget {
+	if h == nil {
+		return nil
+	}
+	return h.trigger
+}
+
+// Visible reports whether the tooltip is showing.
+func (h *TooltipHost) Visible() bool {
+	return h != nil && h.shown
+}
+
+// Bind follows focus changes and mouse movement.
+func (h *TooltipHost) Bind(services runtime.Services) {
+	if h == nil {
+		return
+	}
+	h.Unbind()
+	h.services = services
+	h.removers = append(h.removers,
+		services.OnFocusChange(func(prev, next runtime.Focusable) {
+			was := h.active()
+			h.focused = next != nil && runtime.Widget(next) == h.trigger
+			h.update(was)
+		}),
+		services.ObserveMessages(func(msg runtime.Message) {
+			if mouse, ok := msg.(runtime.MouseMsg); ok {
+				was := h.active()
+				h.hovered = h.bounds.Contains(mouse.X, mouse.Y)
+				h.update(was)
+			}
+		}),
+	)
+}
+
+// Unbind hides the tooltip and stops following focus and the mouse.
+func (h *TooltipHost) Unbind() {
+	if h == nil {
+		return
+	}
+	for _, remove := range h.removers {
+		remove()
+	}
+	h.removers = nil
+	was := h.active()
+	h.focused = false
+	h.hovered = false
+	h.update(was)
+	h.services = runtime.Services{}
+}
+
+func (h *TooltipHost) active() bool {
+	return h.focused || h.hovered
+}
+
+// update schedules the tooltip after the delay when the trigger just became
+// active, and hides it when the trigger is neither focused nor hovered.
+func (h *TooltipHost) update(was bool) {
+	active := h.active()
+	switch {
+	case active && !was && !h.shown:
+		h.gen++
+		gen := h.gen
+		if h.delay <= 0 {
+			h.show()
+			return
+		}
+		scheduler := h.services.Scheduler()
+		if scheduler == nil {
+			return
+		}
+		time.AfterFunc(h.delay, func() {
+			scheduler.Schedule(func() {
+				if gen == h.gen && h.active() {
+					h.show()
+				}
+			})
+		})
+	case !active:
+		h.gen++
+		if h.shown {
+			h.shown = false
+			if screen := h.services.Screen(); screen != nil {
+				screen.RemoveLayer(h.layer)
+			}
+			h.services.Invalidate()
+		}
+	}
+}
+
+func (h *TooltipHost) show() {
+	screen := h.services.Screen()
+	if screen == nil || h.shown {
+		return
+	}
+	h.shown = true
+	screen.PushPassiveLayer(h.layer)
+	h.services.Invalidate()
+}
+
+// Measure returns the trigger's size.
+func (h *TooltipHost) Measure(constraints runtime.Constraints) runtime.Size {
+	if h.trigger == nil {
+		return constraints.MinSize()
+	}
+	return h.trigger.Measure(constraints)
+}
+
+// Layout positions the trigger.
+func (h *TooltipHost) Layout(bounds runtime.Rect) {
+	h.Base.Layout(bounds)
+	if h.trigger != nil {
+		h.trigger.Layout(bounds)
+	}
+}
+
+// Render draws the trigger.
+func (h *TooltipHost) Render(ctx runtime.RenderContext) {
+	if h.trigger != nil {
+		h.trigger.Render(ctx)
+	}
+}
+
+// HandleMessage delegates to the trigger.
+func (h *TooltipHost) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if h.trigger != nil {
+		return h.trigger.HandleMessage(msg)
+	}
+	return runtime.Unhandled()
+}
+
+// ChildWidgets returns the trigger.
+func (h *TooltipHost) ChildWidgets() []runtime.Widget {
+	if h.trigger == nil {
+		return nil
+	}
+	return []runtime.Widget{h.trigger}
+}
+
+// tooltipLayer is the passive layer root of a TooltipHost. It places the
+// tooltip panel next to the trigger.
+type tooltipLayer struct {
+	Base
+	host *TooltipHost
+}
+
+// Measure fills the screen; only the panel is drawn.
+func (l *tooltipLayer) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Layout places the panel on the preferred side of the trigger, flipping
+// to the opposite side when it would leave the screen.
+func (l *tooltipLayer) Layout(bounds runtime.Rect) {
+	panel := l.host.panel
+	size := panel.Measure(runtime.Constraints{MaxWidth: bounds.Width, MaxHeight: bounds.Height})
+	w := min(size.Width, bounds.Width)
+	h := min(size.Height, bounds.Height)
+	anchor := l.host.bounds
+	right := bounds.X + bounds.Width
+	bottom := bounds.Y + bounds.Height
+
+	x, y := anchor.X, anchor.Y
+	switch l.host.placement {
+	case TooltipAbove, TooltipBelow:
+		above := anchor.Y - h
+		below := anchor.Y + anchor.Height
+		y = below
+		if l.host.placement == TooltipAbove {
+			y = above
+		}
+		if y < bounds.Y {
+			y = below
+		} else if y+h > bottom {
+			y = above
+		}
+	case TooltipLeft, TooltipRight:
+		left := anchor.X - w
+		beside := anchor.X + anchor.Width
+		x = beside
+		if l.host.placement == TooltipLeft {
+			x = left
+		}
+		if x < bounds.X {
+			x = beside
+		} else if x+w > right {
+			x = left
+		}
+	}
+	x = max(min(x, right-w), bounds.X)
+	y = max(min(y, bottom-h), bounds.Y)
+	rect := runtime.Rect{X: x, Y: y, Width: w, Height: h}
+	l.Base.Layout(rect)
+	panel.Layout(rect)
+}
+
+// Render draws the panel.
+func (l *tooltipLayer) Render(ctx runtime.RenderContext) {
+	l.host.panel.Render(ctx)
+}
+
+// ChildWidgets returns the panel.
+func (l *tooltipLayer) ChildWidgets() []runtime.Widget {
+	return []runtime.Widget{l.host.panel}
+}
+
+// tooltipPadding adds one column of space on each side of the content.
+type tooltipPadding struct {
+	Base
+	child runtime.Widget
+}
+
+func (p *tooltipPadding) Measure(constraints runtime.Constraints) runtime.Size {
+	if p.child == nil {
+		return runtime.Size{Width: 2}
+	}
+	size := p.child.Measure(runtime.Constraints{
+		MaxWidth:  max(0, constraints.MaxWidth-2),
+		MaxHeight: constraints.MaxHeight,
+	})
+	return runtime.Size{Width: size.Width + 2, Height: size.Height}
+}
+
+func (p *tooltipPadding) Layout(bounds runtime.Rect) {
+	p.Base.Layout(bounds)
+	if p.child != nil {
+		p.child.Layout(bounds.Inset(0, 1, 0, 1))
+	}
+}
+
+func (p *tooltipPadding) Render(ctx runtime.RenderContext) {
+	if p.child != nil {
+		p.child.Render(ctx)
+	}
+}
+
+func (p *tooltipPadding) ChildWidgets() []runtime.Widget {
+	if p.child == nil {
+		return nil
+	}
+	return []runtime.Widget{p.child}
+}
@@ -0,0 +1,117 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/theme"
+)
+
+// TooltipRegion is a one-line area, typically a status line, that shows the
+// tooltip of the focused widget. Widgets provide tooltips by implementing
+// runtime.DescribedWidget.
+type TooltipRegion struct {
+	Base
+	label     *Label
+	styleRole themeRole
+	services  runtime.Services
+	remove    func()
+}
+
+// NewTooltipRegion creates an empty tooltip region.
+func NewTooltipRegion() *TooltipRegion {
+	return &TooltipRegion{label: NewLabel("")}
+}
+
+// SetStyle sets the text style.
+func (t *TooltipRegion) SetStyle(style backend.Style) {
+	if t == nil {
+		return
+	}
+	t.label.SetStyle(style)
+	t.Invalidate()
+}
+
+// SetRole sets the text style to the active theme's token for role.
+func (t *TooltipRegion) SetRole(role theme.Role) {
+	if t == nil {
+		return
+	}
+	t.SetStyle(t.styleRole.set(role))
+}
+
+// ApplyTheme implements runtime.ThemeAdapter: a style set by role follow the
+// new active theme.
+func (t *TooltipRegion) ApplyTheme() {
+	if t == nil {
+		return
+	}
+	if style, ok := t.styleRole.refresh(t.label.style); ok {
+		t.SetStyle(style)
+	}
+}
+
+// Text returns the tooltip currently shown.
+func (t *TooltipRegion) Text() string {
+	if t == nil {
+		return ""
+	}
+	return t.label.text
+}
+
+// Bind subscribes to focus changes.
+func (t *TooltipRegion) Bind(services runtime.Services) {
+	if t == nil {
+		return
+	}
+	if t.remove != nil {
+		t.remove()
+	}
+	t.services = services
+	t.remove = services.OnFocusChange(func(prev, next runtime.Focusable) {
+		t.showTooltipOf(next)
+	})
+}
+
+// Unbind stops following focus changes.
+func (t *TooltipRegion) Unbind() {
+	if t == nil {
+		return
+	}
+	if t.remove != nil {
+		t.remove()
+		t.remove = nil
+	}
+	t.services = runtime.Services{}
+}
+
+func (t *TooltipRegion) showTooltipOf(w runtime.Focusable) {
+	text := ""
+	if described, ok := w.(runtime.DescribedWidget); ok && described != nil {
+		text = described.Tooltip()
+	}
+	if text == t.label.text {
+		return
+	}
+	t.label.SetText(text)
+	t.Invalidate()
+	t.services.Invalidate()
+}
+
+// Measure fills the available width on a single row.
+func (t *TooltipRegion) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.Constrain(runtime.Size{Width: constraints.MaxWidth, Height: 1})
+}
+
+// Layout positions the region.
+func (t *TooltipRegion) Layout(bounds runtime.Rect) {
+	t.Base.Layout(bounds)
+	t.label.Layout(bounds)
+}
+
+// Render draws the tooltip text.
+func (t *TooltipRegion) Render(ctx runtime.RenderContext) {
+	if t == nil {
+		return
+	}
+	t.label.Render(ctx)
+}
@@ -0,0 +1,69 @@
+package widgets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+func TestTooltipRegion_FollowsFocus(t *testing.T) {
+	region := NewTooltipRegion()
+	save := NewButton("Save").SetTooltip("Write changes to disk")
+	name := NewInput().SetTooltip("Your display name")
+	plain := NewButton("Plain")
+	// Ticks with sentinel times ask the loop to report the region text,
+	// optionally after moving focus to the next widget.
+	probeTime := time.Unix(1, 0)
+	nextTime := time.Unix(2, 0)
+	reply := make(chan string, 1)
+	root := runtime.VBox(runtime.Fixed(save), runtime.Fixed(name), runtime.Fixed(plain), runtime.Fixed(region))
+
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:           sim.New(30, 5),
+		Root:              root,
+		FocusRegistration: runtime.FocusRegistrationAuto,
+		Update: func(app *runtime.App, msg runtime.Message) bool {
+			if tick, ok := msg.(runtime.TickMsg); ok && (tick.Time.Equal(probeTime) || tick.Time.Equal(nextTime)) {
+				if tick.Time.Equal(nextTime) {
+					app.Screen().FocusScope().FocusNext()
+				}
+				reply <- region.Text()
+				return false
+			}
+			return runtime.DefaultUpdate(app, msg)
+		},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+
+	probe := func(at time.Time) string {
+		app.Post(runtime.TickMsg{Time: at})
+		select {
+		case text := <-reply:
+			return text
+		case <-time.After(time.Second):
+			t.Fatal("probe timed out")
+			return ""
+		}
+	}
+
+	if got := probe(probeTime); got != "Write changes to disk" {
+		t.Fatalf("tooltip = %q, want the save button tooltip", got)
+	}
+	if got := probe(nextTime); got != "Your display name" {
+		t.Fatalf("tooltip = %q, want the input tooltip", got)
+	}
+	if got := probe(nextTime); got != "" {
+		t.Fatalf("tooltip = %q, want empty for a widget without one", got)
+	}
+
+	cancel()
+	<-done
+}
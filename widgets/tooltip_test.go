@@ -0,0 +1,111 @@
+package widgets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffy-ui/backend/sim"
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+func TestTooltip_PlacementFlipsAtEdges(t *testing.T) {
+	screen := runtime.Rect{Width: 40, Height: 10}
+	tests := []struct {
+		name      string
+		placement TooltipPlacement
+		anchor    runtime.Rect
+		want      runtime.Rect
+	}{
+		{"below", TooltipBelow, runtime.Rect{X: 5, Y: 2, Width: 6, Height: 1}, runtime.Rect{X: 5, Y: 3, Width: 7, Height: 3}},
+		{"below flips up", TooltipBelow, runtime.Rect{X: 5, Y: 8, Width: 6, Height: 1}, runtime.Rect{X: 5, Y: 5, Width: 7, Height: 3}},
+		{"above flips down", TooltipAbove, runtime.Rect{X: 5, Y: 0, Width: 6, Height: 1}, runtime.Rect{X: 5, Y: 1, Width: 7, Height: 3}},
+		{"right", TooltipRight, runtime.Rect{X: 5, Y: 2, Width: 6, Height: 1}, runtime.Rect{X: 11, Y: 2, Width: 7, Height: 3}},
+		{"right flips left", TooltipRight, runtime.Rect{X: 30, Y: 2, Width: 6, Height: 1}, runtime.Rect{X: 23, Y: 2, Width: 7, Height: 3}},
+		{"left flips right", TooltipLeft, runtime.Rect{X: 2, Y: 2, Width: 6, Height: 1}, runtime.Rect{X: 8, Y: 2, Width: 7, Height: 3}},
+		{"clamped to screen", TooltipBelow, runtime.Rect{X: 36, Y: 2, Width: 4, Height: 1}, runtime.Rect{X: 33, Y: 3, Width: 7, Height: 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := WithTooltip(NewButton("Save"), "Tip")
+			host.SetPlacement(tt.placement)
+			host.Layout(tt.anchor)
+			host.layer.Layout(screen)
+			if got := host.panel.Bounds(); got != tt.want {
+				t.Fatalf("tooltip bounds = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTooltip_ShowsOnFocusAndHover(t *testing.T) {
+	save := NewButton("Save")
+	other := NewButton("Other")
+	host := Tooltip(NewLabel("Write to disk"), save, 10*time.Millisecond)
+	root := runtime.VBox(runtime.Fixed(host), runtime.Fixed(other))
+
+	type probe struct {
+		visible bool
+		layers  int
+	}
+	probeTime := time.Unix(1, 0)
+	nextTime := time.Unix(2, 0)
+	reply := make(chan probe, 1)
+	app := runtime.NewApp(runtime.AppConfig{
+		Backend:           sim.New(30, 8),
+		Root:              root,
+		FocusRegistration: runtime.FocusRegistrationAuto,
+		Update: func(app *runtime.App, msg runtime.Message) bool {
+			if tick, ok := msg.(runtime.TickMsg); ok && (tick.Time.Equal(probeTime) || tick.Time.Equal(nextTime)) {
+				if tick.Time.Equal(nextTime) {
+					app.Screen().FocusScope().FocusNext()
+				}
+				reply <- probe{visible: host.Visible(), layers: app.Screen().LayerCount()}
+				return false
+			}
+			return runtime.DefaultUpdate(app, msg)
+		},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+
+	ask := func(at time.Time) probe {
+		app.Post(runtime.TickMsg{Time: at})
+		select {
+		case p := <-reply:
+			return p
+		case <-time.After(time.Second):
+			t.Fatal("probe timed out")
+			return probe{}
+		}
+	}
+	waitFor := func(visible bool, what string) {
+		deadline := time.Now().Add(time.Second)
+		for ask(probeTime).visible != visible {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for the tooltip to be %s", what)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	waitFor(true, "shown on focus")
+	if p := ask(probeTime); p.layers != 2 {
+		t.Fatalf("layers = %d, want the tooltip layer pushed", p.layers)
+	}
+	if p := ask(nextTime); p.visible || p.layers != 1 {
+		t.Fatal("expected the tooltip to hide when focus leaves")
+	}
+
+	app.Post(runtime.MouseMsg{X: 1, Y: 0, Action: runtime.MouseMove})
+	waitFor(true, "shown on hover")
+	app.Post(runtime.MouseMsg{X: 1, Y: 6, Action: runtime.MouseMove})
+	waitFor(false, "hidden when the mouse leaves")
+
+	cancel()
+	<-done
+}
@@ -1,6 +1,7 @@
 package widgets
 
 import (
+	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend"
 	"github.com/odvcencio/fluffy-ui/runtime"
 	"github.com/odvcencio/fluffy-ui/scroll"
@@ -12,11 +13,17 @@ type TreeNode struct {
 	Label    string
 	Children []*TreeNode
 	Expanded bool
+
+	// lazy and check link a node embedded in a LazyTreeNode or
+	// CheckableTreeNode back to it.
+	lazy  *LazyTreeNode
+	check *CheckableTreeNode
 }
 
 // Tree renders a hierarchical tree.
 type Tree struct {
 	FocusableBase
+	accessibility.Base
 	Root          *TreeNode
 	selectedIndex int
 	offset        int
@@ -26,11 +33,14 @@ type Tree struct {
 	flatCache     []treeRow
 	flatDirty     bool
 	rootRef       *TreeNode
+	services      runtime.Services
+	onExpand      func(node *LazyTreeNode)
+	checkable     bool
 }
 
 // NewTree creates a tree widget.
 func NewTree(root *TreeNode) *Tree {
-	return &Tree{
+	t := &Tree{
 		Root:          root,
 		selectedIndex: 0,
 		style:         backend.DefaultStyle(),
@@ -38,6 +48,25 @@ func NewTree(root *TreeNode) *Tree {
 		flatDirty:     true,
 		rootRef:       root,
 	}
+	t.Base.Role = accessibility.RoleTree
+	return t
+}
+
+// AccessibleValue reports the label of the selected node.
+func (t *Tree) AccessibleValue() *accessibility.ValueInfo {
+	if t == nil {
+		return nil
+	}
+	rows := t.flatten()
+	if t.selectedIndex < 0 || t.selectedIndex >= len(rows) {
+		return nil
+	}
+	return &accessibility.ValueInfo{
+		Min:     0,
+		Max:     float64(len(rows) - 1),
+		Current: float64(t.selectedIndex),
+		Text:    rows[t.selectedIndex].node.Label,
+	}
 }
 
 // SetRoot updates the tree root and clears cached rows.
@@ -97,7 +126,7 @@ func (t *Tree) Render(ctx runtime.RenderContext) {
 			style = t.selectedStyle
 		}
 		prefix := ""
-		if len(row.node.Children) > 0 {
+		if t.hasChildren(row.node) {
 			if row.node.Expanded {
 				prefix = "- "
 			} else {
@@ -107,7 +136,7 @@ func (t *Tree) Render(ctx runtime.RenderContext) {
 			prefix = "  "
 		}
 		indent := t.indent(row.depth)
-		line := indent + prefix + row.node.Label
+		line := indent + prefix + t.checkMarker(row.node) + row.node.Label
 		line = truncateString(line, bounds.Width)
 		writePadded(ctx.Buffer, bounds.X, bounds.Y+i, bounds.Width, line, style)
 	}
@@ -137,15 +166,28 @@ func (t *Tree) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		}
 		return runtime.Handled()
 	case terminal.KeyRight:
-		if row := t.selectedRow(rows); row != nil && len(row.node.Children) > 0 {
-			row.node.Expanded = true
-			t.flatDirty = true
+		if row := t.selectedRow(rows); row != nil {
+			if cmd := t.expand(row.node); cmd != nil {
+				return runtime.WithCommand(cmd)
+			}
 		}
 		return runtime.Handled()
+	case terminal.KeyRune:
+		if row := t.selectedRow(rows); key.Rune == ' ' && row != nil && t.toggleCheck(row.node) {
+			return runtime.Handled()
+		}
 	case terminal.KeyEnter:
-		if row := t.selectedRow(rows); row != nil && len(row.node.Children) > 0 {
-			row.node.Expanded = !row.node.Expanded
+		row := t.selectedRow(rows)
+		if row == nil {
+			return runtime.Handled()
+		}
+		if row.node.Expanded {
+			row.node.Expanded = false
 			t.flatDirty = true
+			return runtime.Handled()
+		}
+		if cmd := t.expand(row.node); cmd != nil {
+			return runtime.WithCommand(cmd)
 		}
 		return runtime.Handled()
 	}
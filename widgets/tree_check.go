@@ -0,0 +1,159 @@
+package widgets
+
+// CheckState is the check state of a checkable tree node.
+type CheckState int
+
+const (
+	// Unchecked means neither the node nor any descendant is checked.
+	Unchecked CheckState = iota
+	// Checked means the node and all its descendants are checked.
+	Checked
+	// Indeterminate means some but not all descendants are checked.
+	Indeterminate
+)
+
+// CheckableTreeNode is a tree node with a check box.
+type CheckableTreeNode struct {
+	TreeNode
+	CheckState CheckState
+}
+
+// NewCheckableTreeNode creates a checkable node with children.
+func NewCheckableTreeNode(label string, children ...*CheckableTreeNode) *CheckableTreeNode {
+	node := &CheckableTreeNode{TreeNode: TreeNode{Label: label}}
+	node.check = node
+	for _, child := range children {
+		if child == nil {
+			continue
+		}
+		child.check = child
+		node.Children = append(node.Children, &child.TreeNode)
+	}
+	return node
+}
+
+// SetCheckable shows check boxes before checkable nodes. Space toggles the
+// selected node, checking or unchecking all of its descendants.
+func (t *Tree) SetCheckable(enabled bool) {
+	if t == nil {
+		return
+	}
+	t.checkable = enabled
+	t.Invalidate()
+}
+
+// Checkable reports whether check boxes are shown.
+func (t *Tree) Checkable() bool {
+	return t != nil && t.checkable
+}
+
+// CheckedNodes returns every checked node in depth-first order, including
+// collapsed ones.
+func (t *Tree) CheckedNodes() []*CheckableTreeNode {
+	if t == nil {
+		return nil
+	}
+	var nodes []*CheckableTreeNode
+	var walk func(node *TreeNode)
+	walk = func(node *TreeNode) {
+		if node == nil {
+			return
+		}
+		if node.check != nil && node.check.CheckState == Checked {
+			nodes = append(nodes, node.check)
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(t.Root)
+	return nodes
+}
+
+// checkMarker returns the check box drawn before a node, or "".
+func (t *Tree) checkMarker(node *TreeNode) string {
+	if !t.checkable || node.check == nil {
+		return ""
+	}
+	switch node.check.CheckState {
+	case Checked:
+		return "[x] "
+	case Indeterminate:
+		return "[-] "
+	default:
+		return "[ ] "
+	}
+}
+
+// toggleCheck checks an unchecked node or unchecks a checked one, applies
+// the state to its descendants, and updates its ancestors.
+func (t *Tree) toggleCheck(node *TreeNode) bool {
+	if !t.checkable || node.check == nil {
+		return false
+	}
+	state := Checked
+	if node.check.CheckState == Checked {
+		state = Unchecked
+	}
+	setSubtreeCheck(node, state)
+	path := treePath(t.Root, node)
+	for i := len(path) - 2; i >= 0; i-- {
+		if parent := path[i]; parent.check != nil {
+			parent.check.CheckState = childrenCheckState(parent)
+		}
+	}
+	t.Invalidate()
+	return true
+}
+
+func setSubtreeCheck(node *TreeNode, state CheckState) {
+	if node.check != nil {
+		node.check.CheckState = state
+	}
+	for _, child := range node.Children {
+		setSubtreeCheck(child, state)
+	}
+}
+
+// childrenCheckState derives a parent's state from its checkable children.
+func childrenCheckState(parent *TreeNode) CheckState {
+	checked, total := 0, 0
+	for _, child := range parent.Children {
+		if child.check == nil {
+			continue
+		}
+		total++
+		switch child.check.CheckState {
+		case Checked:
+			checked++
+		case Indeterminate:
+			return Indeterminate
+		}
+	}
+	switch {
+	case total == 0:
+		return parent.check.CheckState
+	case checked == total:
+		return Checked
+	case checked == 0:
+		return Unchecked
+	default:
+		return Indeterminate
+	}
+}
+
+// treePath returns the nodes from root down to target, or nil.
+func treePath(root, target *TreeNode) []*TreeNode {
+	if root == nil {
+		return nil
+	}
+	if root == target {
+		return []*TreeNode{root}
+	}
+	for _, child := range root.Children {
+		if path := treePath(child, target); path != nil {
+			return append([]*TreeNode{root}, path...)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package widgets
+
+import (
+	"context"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+)
+
+// Labels of the placeholder child shown while a lazy node loads.
+const (
+	treeLoadingLabel = "Loading..."
+	treeErrorPrefix  = "Error: "
+)
+
+// LazyTreeNode is a tree node whose children are loaded the first time it is
+// expanded.
+type LazyTreeNode struct {
+	TreeNode
+	// LoadChildren fetches the node's children. It runs in a background
+	// effect and should return promptly once ctx is cancelled.
+	LoadChildren func(ctx context.Context) ([]*LazyTreeNode, error)
+
+	loaded  bool
+	loadCtx context.Context
+	cancel  context.CancelFunc
+}
+
+// Loading reports whether the node's children are being loaded.
+func (n *LazyTreeNode) Loading() bool {
+	return n != nil && n.loadCtx != nil
+}
+
+// Loaded reports whether the node's children have been loaded.
+func (n *LazyTreeNode) Loaded() bool {
+	return n != nil && n.loaded
+}
+
+// NewLazyTree creates a tree whose root and loaded descendants load their
+// children on demand.
+func NewLazyTree(root *LazyTreeNode) *Tree {
+	if root == nil {
+		return NewTree(nil)
+	}
+	root.lazy = root
+	return NewTree(&root.TreeNode)
+}
+
+// SetOnExpand registers a hook called when a lazy node is expanded, before
+// its children start loading. The hook may call CancelLoad to stop the load.
+func (t *Tree) SetOnExpand(fn func(node *LazyTreeNode)) {
+	if t == nil {
+		return
+	}
+	t.onExpand = fn
+}
+
+// CancelLoad stops loading a node's children and collapses it.
+func (t *Tree) CancelLoad(node *LazyTreeNode) {
+	if t == nil || !node.Loading() {
+		return
+	}
+	node.cancel()
+	node.loadCtx = nil
+	node.cancel = nil
+	node.Children = nil
+	node.Expanded = false
+	t.flatDirty = true
+	t.Invalidate()
+}
+
+// Bind attaches app services.
+func (t *Tree) Bind(services runtime.Services) {
+	t.services = services
+}
+
+// Unbind releases app services.
+func (t *Tree) Unbind() {
+	t.services = runtime.Services{}
+}
+
+// hasChildren reports whether a node can be expanded.
+func (t *Tree) hasChildren(node *TreeNode) bool {
+	if len(node.Children) > 0 {
+		return true
+	}
+	lazy := node.lazy
+	return lazy != nil && lazy.LoadChildren != nil && !lazy.loaded
+}
+
+// expand opens a node. Lazy nodes that have not loaded show a placeholder
+// child and return the effect that loads them; without an app scheduler the
+// load runs inline.
+func (t *Tree) expand(node *TreeNode) runtime.Command {
+	if !t.hasChildren(node) {
+		return nil
+	}
+	node.Expanded = true
+	t.flatDirty = true
+	t.Invalidate()
+	lazy := node.lazy
+	if lazy == nil {
+		return nil
+	}
+	if lazy.LoadChildren == nil || lazy.loaded || lazy.Loading() {
+		if t.onExpand != nil {
+			t.onExpand(lazy)
+		}
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	lazy.loadCtx = ctx
+	lazy.cancel = cancel
+	node.Children = []*TreeNode{{Label: treeLoadingLabel}}
+	if t.onExpand != nil {
+		t.onExpand(lazy)
+	}
+	if lazy.loadCtx != ctx {
+		return nil
+	}
+	load := lazy.LoadChildren
+	scheduler := t.services.Scheduler()
+	if scheduler == nil {
+		children, err := load(ctx)
+		t.finishLoad(lazy, ctx, children, err)
+		return nil
+	}
+	return runtime.Effect{Run: func(appCtx context.Context, post runtime.PostFunc) {
+		stop := context.AfterFunc(appCtx, cancel)
+		defer stop()
+		children, err := load(ctx)
+		scheduler.Schedule(func() {
+			t.finishLoad(lazy, ctx, children, err)
+		})
+	}}
+}
+
+// finishLoad replaces the placeholder with the loaded children or an error
+// label. Results of cancelled or superseded loads are dropped. A failed
+// load is retried the next time the node is expanded.
+func (t *Tree) finishLoad(lazy *LazyTreeNode, ctx context.Context, children []*LazyTreeNode, err error) {
+	if lazy.loadCtx != ctx {
+		return
+	}
+	lazy.cancel()
+	lazy.loadCtx = nil
+	lazy.cancel = nil
+	if err != nil {
+		lazy.Children = []*TreeNode{{Label: treeErrorPrefix + err.Error()}}
+	} else {
+		lazy.loaded = true
+		lazy.Children = make([]*TreeNode, 0, len(children))
+		for _, child := range children {
+			if child == nil {
+				continue
+			}
+			child.lazy = child
+			lazy.Children = append(lazy.Children, &child.TreeNode)
+		}
+	}
+	t.flatDirty = true
+	t.Invalidate()
+	t.services.Invalidate()
+}
@@ -0,0 +1,141 @@
+package widgets
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/state"
+	"github.com/odvcencio/fluffy-ui/terminal"
+)
+
+// runLoad runs the effect returned by expanding a lazy node and flushes the
+// queue its result is scheduled on.
+func runLoad(t *testing.T, result runtime.HandleResult, queue *state.Queue) {
+	t.Helper()
+	if len(result.Commands) != 1 {
+		t.Fatalf("commands = %v, want one load effect", result.Commands)
+	}
+	effect, ok := result.Commands[0].(runtime.Effect)
+	if !ok {
+		t.Fatalf("command = %T, want runtime.Effect", result.Commands[0])
+	}
+	effect.Run(context.Background(), func(runtime.Message) bool { return true })
+	queue.Flush()
+}
+
+func TestTree_LazyChildrenLoadInEffect(t *testing.T) {
+	queue := state.NewQueue()
+	app := runtime.NewApp(runtime.AppConfig{StateQueue: queue})
+	calls := 0
+	root := &LazyTreeNode{TreeNode: TreeNode{Label: "root"}}
+	root.LoadChildren = func(ctx context.Context) ([]*LazyTreeNode, error) {
+		calls++
+		return []*LazyTreeNode{
+			{TreeNode: TreeNode{Label: "a"}},
+			{TreeNode: TreeNode{Label: "b"}},
+		}, nil
+	}
+	tree := NewLazyTree(root)
+	tree.Bind(app.Services())
+	tree.Focus()
+	var expanded []string
+	tree.SetOnExpand(func(node *LazyTreeNode) {
+		expanded = append(expanded, node.Label)
+	})
+
+	if out := renderToString(tree, 12, 3); !strings.HasPrefix(out, "+ root") {
+		t.Fatalf("render = %q, want an expandable root", out)
+	}
+	result := tree.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if !root.Loading() || !strings.Contains(renderToString(tree, 16, 3), treeLoadingLabel) {
+		t.Fatal("expected a loading placeholder while the effect runs")
+	}
+	runLoad(t, result, queue)
+	if out := renderToString(tree, 12, 3); out != "- root      \n    a       \n    b       \n" {
+		t.Fatalf("render = %q, want the loaded children", out)
+	}
+	if !root.Loaded() || calls != 1 || len(expanded) != 1 {
+		t.Fatalf("loaded = %v, calls = %d, expansions = %v", root.Loaded(), calls, expanded)
+	}
+
+	// Collapsing and expanding again reuses the loaded children.
+	tree.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	if result := tree.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight}); len(result.Commands) != 0 || calls != 1 {
+		t.Fatalf("re-expanding loaded node returned %v after %d loads", result.Commands, calls)
+	}
+}
+
+func TestTree_LazyLoadErrorAndCancel(t *testing.T) {
+	queue := state.NewQueue()
+	app := runtime.NewApp(runtime.AppConfig{StateQueue: queue})
+	root := &LazyTreeNode{TreeNode: TreeNode{Label: "root"}}
+	root.LoadChildren = func(ctx context.Context) ([]*LazyTreeNode, error) {
+		return nil, errors.New("offline")
+	}
+	tree := NewLazyTree(root)
+	tree.Bind(app.Services())
+	tree.Focus()
+
+	runLoad(t, tree.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight}), queue)
+	if out := renderToString(tree, 20, 2); !strings.Contains(out, "Error: offline") {
+		t.Fatalf("render = %q, want the error label", out)
+	}
+
+	tree.SetOnExpand(func(node *LazyTreeNode) {
+		tree.CancelLoad(node)
+	})
+	tree.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	result := tree.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if len(result.Commands) != 0 || root.Loading() || root.Expanded {
+		t.Fatalf("cancelled expansion left commands %v, loading %v, expanded %v", result.Commands, root.Loading(), root.Expanded)
+	}
+}
+
+func TestTree_CheckablePropagation(t *testing.T) {
+	a := NewCheckableTreeNode("a", NewCheckableTreeNode("a1"), NewCheckableTreeNode("a2"))
+	a.Expanded = true
+	root := NewCheckableTreeNode("root", a, NewCheckableTreeNode("b"))
+	root.Expanded = true
+	tree := NewTree(&root.TreeNode)
+	tree.SetCheckable(true)
+	tree.Focus()
+	space := runtime.KeyMsg{Key: terminal.KeyRune, Rune: ' '}
+	down := runtime.KeyMsg{Key: terminal.KeyDown}
+	labels := func() string {
+		var names []string
+		for _, node := range tree.CheckedNodes() {
+			names = append(names, node.Label)
+		}
+		return strings.Join(names, " ")
+	}
+
+	tree.HandleMessage(down)
+	tree.HandleMessage(down)
+	tree.HandleMessage(space)
+	if a.CheckState != Indeterminate || root.CheckState != Indeterminate {
+		t.Fatalf("states = %v, %v, want both indeterminate", a.CheckState, root.CheckState)
+	}
+	if out := renderToString(tree, 14, 5); out != "- [-] root    \n  - [-] a     \n      [x] a1  \n      [ ] a2  \n    [ ] b     \n" {
+		t.Fatalf("render = %q", out)
+	}
+
+	tree.HandleMessage(down)
+	tree.HandleMessage(space)
+	if a.CheckState != Checked || root.CheckState != Indeterminate {
+		t.Fatalf("states = %v, %v, want a checked and root indeterminate", a.CheckState, root.CheckState)
+	}
+	tree.HandleMessage(down)
+	tree.HandleMessage(space)
+	if got := labels(); got != "root a a1 a2 b" {
+		t.Fatalf("checked = %q, want every node once all leaves are checked", got)
+	}
+
+	tree.ScrollToStart()
+	tree.HandleMessage(space)
+	if got := labels(); got != "" {
+		t.Fatalf("checked = %q, want none after unchecking the root", got)
+	}
+}
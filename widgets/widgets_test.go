@@ -1,10 +1,15 @@
 package widgets
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/odvcencio/fluffy-ui/accessibility"
 	"github.com/odvcencio/fluffy-ui/backend"
+	"github.com/odvcencio/fluffy-ui/clipboard"
 	"github.com/odvcencio/fluffy-ui/runtime"
+	"github.com/odvcencio/fluffy-ui/state"
 	"github.com/odvcencio/fluffy-ui/terminal"
 )
 
@@ -100,6 +105,61 @@ func TestInput_HandleMessage_Typing(t *testing.T) {
 	}
 }
 
+func TestInput_FocusCallbacks(t *testing.T) {
+	input := NewInput()
+	input.SetText("draft")
+	focused, blurred := 0, 0
+	var blurText string
+	input.OnFocus(func() { focused++ })
+	input.OnBlur(func(text string) {
+		blurred++
+		blurText = text
+	})
+
+	input.Blur()
+	if blurred != 0 {
+		t.Fatalf("OnBlur fired without focus: %d", blurred)
+	}
+	input.Focus()
+	input.Focus()
+	if focused != 1 {
+		t.Fatalf("OnFocus calls = %d, want 1", focused)
+	}
+	input.Blur()
+	input.Blur()
+	if blurred != 1 || blurText != "draft" {
+		t.Fatalf("OnBlur calls = %d text = %q, want 1 and draft", blurred, blurText)
+	}
+}
+
+func TestInput_MultiByteCursor(t *testing.T) {
+	input := NewInput()
+	input.Focus()
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '🎉'})
+	if len(input.runes) != 1 || input.CursorPos() != 1 {
+		t.Fatalf("runes = %d, cursor = %d, want 1 and 1", len(input.runes), input.CursorPos())
+	}
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	if input.CursorPos() != 0 {
+		t.Fatalf("cursor after Left = %d, want 0", input.CursorPos())
+	}
+
+	input.SetText("héllo wörld")
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft, Ctrl: true})
+	if input.CursorPos() != 6 {
+		t.Fatalf("cursor after Ctrl+Left = %d, want 6", input.CursorPos())
+	}
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	if input.Text() != "héllowörld" {
+		t.Fatalf("Text = %q, want héllowörld", input.Text())
+	}
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'ß'})
+	if input.Text() != "hélloßwörld" {
+		t.Fatalf("Text = %q, want hélloßwörld", input.Text())
+	}
+}
+
 func TestInput_HandleMessage_Unfocused(t *testing.T) {
 	input := NewInput()
 	// Not focused
@@ -146,6 +206,23 @@ func TestPanel_Render(t *testing.T) {
 	}
 }
 
+func TestPanel_WithBoxStyle(t *testing.T) {
+	panel := NewPanel(NewLabel("Hi")).
+		WithBorder(backend.DefaultStyle()).
+		WithBoxStyle(runtime.BoxStyleDouble)
+	panel.Layout(runtime.Rect{X: 0, Y: 0, Width: 10, Height: 5})
+
+	buf := runtime.NewBuffer(10, 5)
+	panel.Render(runtime.RenderContext{Buffer: buf})
+
+	if got := buf.Get(0, 0).Rune; got != '╔' {
+		t.Errorf("Top-left corner = %c, want ╔", got)
+	}
+	if got := buf.Get(0, 2).Rune; got != '║' {
+		t.Errorf("Left edge = %c, want ║", got)
+	}
+}
+
 func TestBox_PassesThrough(t *testing.T) {
 	label := NewLabel("Hi")
 	box := NewBox(label)
@@ -268,6 +345,246 @@ func TestInput_OnChange(t *testing.T) {
 	}
 }
 
+func TestInput_SelectAllThenCopy(t *testing.T) {
+	cb := &clipboard.MemoryClipboard{}
+	app := runtime.NewApp(runtime.AppConfig{Clipboard: cb})
+	input := NewInput()
+	input.Bind(app.Services())
+	input.SetText("hello world")
+	input.Focus()
+
+	if result := input.HandleMessage(runtime.SelectAllMsg{}); !result.Handled {
+		t.Fatal("expected SelectAllMsg to be handled")
+	}
+	if input.SelectedText() != input.Text() {
+		t.Fatalf("SelectedText() = %q, want %q", input.SelectedText(), input.Text())
+	}
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlC})
+	if got, _ := cb.Read(); got != "hello world" {
+		t.Fatalf("clipboard = %q, want full text", got)
+	}
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'x'})
+	if input.Text() != "x" || input.SelectedText() != "" {
+		t.Fatalf("typing over selection: text = %q, selected = %q", input.Text(), input.SelectedText())
+	}
+}
+
+func TestInput_SelectAllIgnoredWhenUnfocused(t *testing.T) {
+	input := NewInput()
+	input.SetText("hello")
+	if result := input.HandleMessage(runtime.SelectAllMsg{}); result.Handled {
+		t.Fatal("unfocused input should ignore SelectAllMsg")
+	}
+	if input.SelectedText() != "" {
+		t.Fatalf("SelectedText() = %q, want empty", input.SelectedText())
+	}
+}
+
+func TestInput_ShiftSelection(t *testing.T) {
+	cb := &clipboard.MemoryClipboard{}
+	app := runtime.NewApp(runtime.AppConfig{Clipboard: cb})
+	input := NewInput()
+	input.Bind(app.Services())
+	input.SetText("hello world")
+	input.Focus()
+
+	for i := 0; i < 5; i++ {
+		input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft, Shift: true})
+	}
+	if got := input.SelectedText(); got != "world" {
+		t.Fatalf("SelectedText() = %q, want %q", got, "world")
+	}
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlC})
+	if got, _ := cb.Read(); got != "world" {
+		t.Fatalf("clipboard = %q, want the selection", got)
+	}
+
+	input.Layout(runtime.Rect{Width: 20, Height: 1})
+	buf := runtime.NewBuffer(20, 1)
+	input.Render(runtime.RenderContext{Buffer: buf})
+	if cell := buf.Get(4, 0); cell.Style.Attributes()&backend.AttrReverse != 0 {
+		t.Fatal("unselected text should not be reversed")
+	}
+	if cell := buf.Get(7, 0); cell.Rune != 'o' || cell.Style.Attributes()&backend.AttrReverse == 0 {
+		t.Fatalf("selected cell = %q, want reversed 'o'", cell.Rune)
+	}
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'X'})
+	if input.Text() != "hello X" || input.SelectedText() != "" {
+		t.Fatalf("typing over selection: text = %q, selected = %q", input.Text(), input.SelectedText())
+	}
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyHome, Shift: true})
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	if input.SelectedText() != "" {
+		t.Fatalf("plain movement should drop the selection, got %q", input.SelectedText())
+	}
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnd, Shift: true})
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	if input.Text() != "" {
+		t.Fatalf("Backspace over selection left %q", input.Text())
+	}
+}
+
+func TestInput_Mask(t *testing.T) {
+	input := NewInput()
+	input.SetMask('*')
+	input.SetText("secret")
+	input.Focus()
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+
+	input.Layout(runtime.Rect{Width: 10, Height: 1})
+	buf := runtime.NewBuffer(10, 1)
+	input.Render(runtime.RenderContext{Buffer: buf})
+	for x := 0; x < 6; x++ {
+		if cell := buf.Get(x, 0); cell.Rune != '*' {
+			t.Fatalf("cell %d = %q, want '*'", x, cell.Rune)
+		}
+	}
+	if cell := buf.Get(5, 0); cell.Style.Attributes()&backend.AttrReverse == 0 {
+		t.Fatal("cursor should sit on the last masked character")
+	}
+
+	if text, ok := input.ClipboardCopy(); ok || text != "" {
+		t.Fatalf("ClipboardCopy() = %q, %v; want refusal", text, ok)
+	}
+	if !input.ClipboardPaste("!") || input.Text() != "secre!t" {
+		t.Fatalf("Text() = %q, want paste at the cursor", input.Text())
+	}
+}
+
+func TestTextArea_ShiftSelection(t *testing.T) {
+	area := NewTextArea()
+	area.SetText("one\ntwo")
+	area.Focus()
+
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp, Shift: true})
+	if got := area.SelectedText(); got != "\ntwo" {
+		t.Fatalf("SelectedText() = %q, want %q", got, "\ntwo")
+	}
+	if text, _ := area.ClipboardCopy(); text != "\ntwo" {
+		t.Fatalf("ClipboardCopy() = %q, want the selection", text)
+	}
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '!'})
+	if area.Text() != "one!" {
+		t.Fatalf("Text() = %q, want %q", area.Text(), "one!")
+	}
+}
+
+func TestTextArea_UndoRedo(t *testing.T) {
+	area := NewTextArea()
+	area.Focus()
+	typeText := func(text string) {
+		for _, r := range text {
+			area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+		}
+	}
+
+	typeText("hello")
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	typeText("world")
+	area.ClipboardPaste("!!")
+
+	steps := []string{"hello\nworld", "hello\n", "hello", ""}
+	for _, want := range steps {
+		if !area.Undo() {
+			t.Fatalf("Undo() = false, want text %q", want)
+		}
+		if area.Text() != want {
+			t.Fatalf("after undo Text() = %q, want %q", area.Text(), want)
+		}
+	}
+	if area.Undo() {
+		t.Fatal("Undo() with empty history should report false")
+	}
+
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlY})
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlZ, Shift: true})
+	if area.Text() != "hello\n" {
+		t.Fatalf("after redo Text() = %q, want %q", area.Text(), "hello\n")
+	}
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlZ})
+	if area.Text() != "hello" {
+		t.Fatalf("after Ctrl+Z Text() = %q, want %q", area.Text(), "hello")
+	}
+
+	area.SetText("reset")
+	if area.Undo() || area.Redo() {
+		t.Fatal("SetText should clear the history")
+	}
+}
+
+func TestTextArea_UndoLimit(t *testing.T) {
+	area := NewTextArea()
+	area.Focus()
+	area.SetUndoLimit(2)
+	for i := 0; i < 4; i++ {
+		area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	}
+	undone := 0
+	for area.Undo() {
+		undone++
+	}
+	if undone != 2 || area.Text() != "\n\n" {
+		t.Fatalf("undid %d steps to %q, want 2 steps to %q", undone, area.Text(), "\n\n")
+	}
+}
+
+func TestMultilineInput_UndoRedo(t *testing.T) {
+	m := NewMultilineInput()
+	m.Focus()
+	for _, r := range "abc" {
+		m.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	m.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	if !m.Undo() || m.Text() != "abc" {
+		t.Fatalf("Text() = %q, want %q", m.Text(), "abc")
+	}
+	if !m.Undo() || m.Text() != "" {
+		t.Fatalf("Text() = %q, want typed run undone", m.Text())
+	}
+	if !m.Redo() || m.Text() != "abc" {
+		t.Fatalf("Text() = %q, want %q", m.Text(), "abc")
+	}
+}
+
+func TestMultilineInput_UndoBatchesNonASCII(t *testing.T) {
+	m := NewMultilineInput()
+	m.SetText("x ")
+	m.Focus()
+	for _, r := range "héllo wörld" {
+		m.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if !m.Undo() || m.Text() != "x " {
+		t.Fatalf("Text() = %q, want the typed run undone in one step", m.Text())
+	}
+	if !m.Redo() || m.Text() != "x héllo wörld" {
+		t.Fatalf("Text() = %q, want %q", m.Text(), "x héllo wörld")
+	}
+}
+
+func TestMultilineInput_ShiftSelection(t *testing.T) {
+	m := NewMultilineInput()
+	m.SetText("one\ntwo")
+	m.Focus()
+
+	m.HandleMessage(runtime.KeyMsg{Key: terminal.KeyHome, Shift: true})
+	m.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft, Shift: true})
+	if got := m.SelectedText(); got != "\ntwo" {
+		t.Fatalf("SelectedText() = %q, want %q", got, "\ntwo")
+	}
+	m.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	if m.Text() != "one" {
+		t.Fatalf("Text() = %q, want %q", m.Text(), "one")
+	}
+	m.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '!'})
+	if m.Text() != "one!" {
+		t.Fatalf("Text() = %q, want %q", m.Text(), "one!")
+	}
+}
+
 func TestInput_Measure(t *testing.T) {
 	input := NewInput()
 
@@ -709,6 +1026,18 @@ func TestLabel_SetText(t *testing.T) {
 	}
 }
 
+func TestLabel_CacheKey(t *testing.T) {
+	label := NewLabel("initial")
+	key := label.CacheKey()
+	if label.CacheKey() != key {
+		t.Fatal("cache key should be stable while the label is unchanged")
+	}
+	label.SetText("updated")
+	if label.CacheKey() == key {
+		t.Fatal("cache key should change after SetText")
+	}
+}
+
 func TestLabel_SetStyle(t *testing.T) {
 	label := NewLabel("test")
 	style := backend.DefaultStyle().Bold(true)
@@ -872,3 +1201,249 @@ func TestBase_HandleMessage(t *testing.T) {
 		t.Error("Base should not handle messages")
 	}
 }
+
+func newSuggestionInput() *Input {
+	input := NewInput()
+	input.Focus()
+	input.SetSuggestion(func(text string) string {
+		if text == "he" {
+			return "llo"
+		}
+		return ""
+	})
+	input.Layout(runtime.Rect{X: 0, Y: 0, Width: 10, Height: 1})
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'h'})
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'e'})
+	return input
+}
+
+func TestInput_SuggestionRendersGhostText(t *testing.T) {
+	input := newSuggestionInput()
+
+	buf := runtime.NewBuffer(10, 1)
+	input.Render(runtime.RenderContext{Buffer: buf})
+
+	if got := buf.SnapshotText(); !strings.HasPrefix(got, "hello") {
+		t.Fatalf("expected ghost text after cursor, got %q", got)
+	}
+	if buf.Get(3, 0).Style.Attributes()&backend.AttrDim == 0 {
+		t.Fatal("expected ghost text to be dim")
+	}
+	if input.Text() != "he" {
+		t.Fatalf("expected text unchanged, got %q", input.Text())
+	}
+}
+
+func TestInput_SuggestionTabAccepts(t *testing.T) {
+	input := newSuggestionInput()
+
+	result := input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyTab})
+	if !result.Handled || len(result.Commands) != 0 {
+		t.Fatalf("expected Tab to accept suggestion without focus change, got %+v", result)
+	}
+	if input.Text() != "hello" || input.CursorPos() != 5 {
+		t.Fatalf("expected accepted text with cursor at end, got %q at %d", input.Text(), input.CursorPos())
+	}
+	if input.Suggestion() != "" {
+		t.Fatalf("expected suggestion cleared, got %q", input.Suggestion())
+	}
+}
+
+func TestInput_SuggestionDismissedByTyping(t *testing.T) {
+	input := newSuggestionInput()
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'x'})
+	if input.Suggestion() != "" {
+		t.Fatalf("expected typing to clear suggestion, got %q", input.Suggestion())
+	}
+
+	buf := runtime.NewBuffer(10, 1)
+	input.Render(runtime.RenderContext{Buffer: buf})
+	if got := strings.TrimSpace(buf.SnapshotText()); got != "hex" {
+		t.Fatalf("expected no ghost text, got %q", got)
+	}
+}
+
+func TestFocusScope_RegistersFocusableBaseWidgets(t *testing.T) {
+	first := NewButton("One")
+	second := NewInput()
+	third := NewButton("Three")
+	root := runtime.VBox(runtime.Fixed(first), runtime.Fixed(runtime.HBox(runtime.Fixed(second))), runtime.Fixed(third))
+
+	scope := runtime.NewFocusScope()
+	scope.RegisterAll(root)
+
+	if scope.Count() != 3 {
+		t.Fatalf("Count() = %d, want 3", scope.Count())
+	}
+	order := scope.TabOrder()
+	if order[0] != first || order[1] != second || order[2] != third {
+		t.Fatalf("unexpected tab order: %v", order)
+	}
+}
+
+func TestDataWidgets_AccessibleRoles(t *testing.T) {
+	table := NewTable(TableColumn{Title: "A"}, TableColumn{Title: "B"})
+	table.SetRows([][]string{{"1", "2"}, {"3", "4"}})
+	table.setSelected(1)
+	list := NewList(NewSliceAdapter([]int{10, 20}, nil))
+	tree := NewTree(&TreeNode{Label: "root", Expanded: true, Children: []*TreeNode{{Label: "child"}}})
+	tree.setSelected(1, 2)
+	menu := NewMenu(&MenuItem{Title: "Open"}, &MenuItem{Title: "Save"})
+
+	tests := []struct {
+		name   string
+		widget accessibility.Accessible
+		role   accessibility.Role
+		value  string
+	}{
+		{"table", table, accessibility.RoleTable, "3\t4"},
+		{"list", list, accessibility.RoleList, "10"},
+		{"tree", tree, accessibility.RoleTree, "child"},
+		{"menu", menu, accessibility.RoleMenu, "Open"},
+	}
+	for _, tt := range tests {
+		if got := tt.widget.AccessibleRole(); got != tt.role {
+			t.Errorf("%s role = %q, want %q", tt.name, got, tt.role)
+		}
+		value := tt.widget.AccessibleValue()
+		if value == nil || value.Text != tt.value {
+			t.Errorf("%s value = %+v, want %q", tt.name, value, tt.value)
+		}
+	}
+	if table.AccessibleValue().Current != 1 {
+		t.Errorf("table index = %v, want 1", table.AccessibleValue().Current)
+	}
+}
+
+func TestSparkline_UnicodeFallback(t *testing.T) {
+	data := state.NewSignal([]float64{0, 1})
+	spark := NewSparkline(data)
+	spark.Layout(runtime.Rect{Width: 2, Height: 1})
+
+	buf := runtime.NewBuffer(2, 1)
+	spark.Render(runtime.RenderContext{Buffer: buf})
+	if got := buf.Get(1, 0).Rune; got != '@' {
+		t.Fatalf("ASCII peak = %q, want '@'", got)
+	}
+
+	app := runtime.NewApp(runtime.AppConfig{Capabilities: &terminal.Capabilities{Unicode: true}})
+	spark.Bind(app.Services())
+	spark.Render(runtime.RenderContext{Buffer: buf})
+	if got := buf.Get(1, 0).Rune; got != '█' {
+		t.Fatalf("Unicode peak = %q, want '█'", got)
+	}
+}
+
+func TestList_Header(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	list := NewList(NewSliceAdapter(items, func(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item, backend.DefaultStyle())
+	}))
+	list.SetHeader("Name", backend.DefaultStyle().Bold(true))
+
+	if size := list.Measure(runtime.Constraints{MaxWidth: 10, MaxHeight: 10}); size.Height != 6 {
+		t.Fatalf("Measure height = %d, want 6", size.Height)
+	}
+
+	out := renderToString(list, 4, 3)
+	if want := "Name\na   \nb   \n"; out != want {
+		t.Fatalf("render = %q, want %q", out, want)
+	}
+
+	list.Focus()
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	out = renderToString(list, 4, 3)
+	if want := "Name\nb   \nc   \n"; out != want {
+		t.Fatalf("render after Down = %q, want %q", out, want)
+	}
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageDown})
+	if list.SelectedIndex() != 4 {
+		t.Fatalf("selected after PageDown = %d, want 4", list.SelectedIndex())
+	}
+
+	list.ClearHeader()
+	if size := list.Measure(runtime.Constraints{MaxWidth: 10, MaxHeight: 10}); size.Height != 5 {
+		t.Fatalf("Measure height without header = %d, want 5", size.Height)
+	}
+}
+
+func TestList_ItemHeight(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	heights := map[int]int{}
+	list := NewList(NewSliceAdapter(items, func(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
+		heights[index] = ctx.Bounds.Height
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item, backend.DefaultStyle())
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y+1, "~", backend.DefaultStyle())
+	}))
+	list.SetItemHeight(2)
+
+	if size := list.Measure(runtime.Constraints{MaxWidth: 5, MaxHeight: 10}); size.Height != 6 {
+		t.Fatalf("Measure height = %d, want 6", size.Height)
+	}
+	if out := renderToString(list, 1, 6); out != "a\n~\nb\n~\nc\n~\n" {
+		t.Fatalf("render = %q", out)
+	}
+	if heights[0] != 2 || heights[2] != 2 {
+		t.Fatalf("item bounds heights = %v, want 2", heights)
+	}
+
+	// Two items fit in four rows; moving to the third scrolls by one item.
+	list.Focus()
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if list.SelectedIndex() != 1 {
+		t.Fatalf("selected = %d, want 1", list.SelectedIndex())
+	}
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if out := renderToString(list, 1, 4); out != "b\n~\nc\n~\n" {
+		t.Fatalf("render after scrolling = %q, want the last item fully visible", out)
+	}
+}
+
+func TestList_MultiSelect(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	rendered := map[int]bool{}
+	list := NewList(NewSliceAdapter(items, func(item string, index int, selected, checked bool, ctx runtime.RenderContext) {
+		rendered[index] = checked
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item, backend.DefaultStyle())
+	}))
+	list.SetMultiSelect(true)
+	list.Focus()
+	var events []string
+	list.OnCheck(func(index int, item string, checked bool) {
+		events = append(events, fmt.Sprintf("%s=%v", item, checked))
+	})
+	space := runtime.KeyMsg{Key: terminal.KeyRune, Rune: ' '}
+
+	list.HandleMessage(space)
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	list.HandleMessage(space)
+	if list.SelectedIndex() != 2 {
+		t.Fatalf("selected = %d, want Space to leave the selection at 2", list.SelectedIndex())
+	}
+	if got := fmt.Sprint(list.CheckedIndices(), list.CheckedItems()); got != "[0 2] [a c]" {
+		t.Fatalf("checked = %s, want [0 2] [a c]", got)
+	}
+	if out := renderToString(list, 5, 3); out != "[x] a\n[ ] b\n[x] c\n" {
+		t.Fatalf("render = %q", out)
+	}
+	if !rendered[0] || rendered[1] || !rendered[2] {
+		t.Fatalf("render func checked = %v", rendered)
+	}
+
+	list.HandleMessage(space)
+	if got := strings.Join(events, " "); got != "a=true c=true c=false" {
+		t.Fatalf("OnCheck events = %q", got)
+	}
+
+	list.SetAllChecked(true)
+	if got := len(list.CheckedIndices()); got != 3 {
+		t.Fatalf("checked after SetAllChecked(true) = %d, want 3", got)
+	}
+	list.SetAllChecked(false)
+	if list.CheckedIndices() != nil {
+		t.Fatalf("checked after SetAllChecked(false) = %v", list.CheckedIndices())
+	}
+}